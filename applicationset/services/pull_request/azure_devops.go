@@ -156,3 +156,63 @@ func buildURL(url, organization string) string {
 	devOpsURL := fmt.Sprintf("%s%s%s", url, separator, organization)
 	return devOpsURL
 }
+
+var _ PullRequestCreator = (*AzureDevOpsService)(nil)
+
+func (a *AzureDevOpsService) CreateOrUpdate(ctx context.Context, branch, targetBranch, title, description string) (string, error) {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	sourceRefName := "refs/heads/" + branch
+	targetRefName := "refs/heads/" + targetBranch
+	azurePullRequests, err := client.GetPullRequests(ctx, git.GetPullRequestsArgs{
+		RepositoryId: &a.repo,
+		Project:      &a.project,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{
+			SourceRefName: &sourceRefName,
+			TargetRefName: &targetRefName,
+			Status:        &git.PullRequestStatusValues.Active,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get pull requests of %s/%s: %w", a.project, a.repo, err)
+	}
+
+	var pr *git.GitPullRequest
+	if azurePullRequests != nil && len(*azurePullRequests) > 0 && (*azurePullRequests)[0].PullRequestId != nil {
+		pr, err = client.UpdatePullRequest(ctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: &git.GitPullRequest{Title: &title, Description: &description},
+			RepositoryId:           &a.repo,
+			PullRequestId:          (*azurePullRequests)[0].PullRequestId,
+			Project:                &a.project,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to update pull request %d of %s/%s: %w", *(*azurePullRequests)[0].PullRequestId, a.project, a.repo, err)
+		}
+	} else {
+		labels := make([]core.WebApiTagDefinition, 0, len(a.labels))
+		for _, label := range a.labels {
+			labels = append(labels, core.WebApiTagDefinition{Name: &label})
+		}
+		pr, err = client.CreatePullRequest(ctx, git.CreatePullRequestArgs{
+			GitPullRequestToCreate: &git.GitPullRequest{
+				SourceRefName: &sourceRefName,
+				TargetRefName: &targetRefName,
+				Title:         &title,
+				Description:   &description,
+				Labels:        &labels,
+			},
+			RepositoryId: &a.repo,
+			Project:      &a.project,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to create pull request for %s/%s: %w", a.project, a.repo, err)
+		}
+	}
+	if pr.PullRequestId == nil || pr.Repository == nil || pr.Repository.WebUrl == nil {
+		return "", fmt.Errorf("pull request of %s/%s is missing its ID or repository", a.project, a.repo)
+	}
+	return fmt.Sprintf("%s/pullrequest/%d", *pr.Repository.WebUrl, *pr.PullRequestId), nil
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/ktrysmt/go-bitbucket"
@@ -167,3 +169,51 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 
 	return pullRequests, nil
 }
+
+var _ PullRequestCreator = (*BitbucketCloudService)(nil)
+
+func (b *BitbucketCloudService) CreateOrUpdate(ctx context.Context, branch, targetBranch, title, description string) (string, error) {
+	pullRequests, err := b.List(ctx)
+	if err != nil {
+		return "", err
+	}
+	opts := &bitbucket.PullRequestsOptions{
+		Owner:             b.owner,
+		RepoSlug:          b.repositorySlug,
+		Title:             title,
+		Description:       description,
+		SourceBranch:      branch,
+		DestinationBranch: targetBranch,
+	}
+	var response any
+	if i := slices.IndexFunc(pullRequests, func(pr *PullRequest) bool {
+		return pr.Branch == branch && pr.TargetBranch == targetBranch
+	}); i >= 0 {
+		opts.ID = strconv.FormatInt(pullRequests[i].Number, 10)
+		response, err = b.client.Repositories.PullRequests.Update(opts.WithContext(ctx))
+		if err != nil {
+			return "", fmt.Errorf("error updating pull request %s for %s/%s: %w", opts.ID, b.owner, b.repositorySlug, err)
+		}
+	} else {
+		response, err = b.client.Repositories.PullRequests.Create(opts.WithContext(ctx))
+		if err != nil {
+			return "", fmt.Errorf("error creating pull request for %s/%s: %w", b.owner, b.repositorySlug, err)
+		}
+	}
+
+	jsonStr, err := json.Marshal(response)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling response body to json: %w", err)
+	}
+	var pr struct {
+		Links struct {
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+		} `json:"links"`
+	}
+	if err := json.Unmarshal(jsonStr, &pr); err != nil {
+		return "", fmt.Errorf("error unmarshalling json to pull request: %w", err)
+	}
+	return pr.Links.HTML.Href, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
 	log "github.com/sirupsen/logrus"
@@ -100,3 +101,61 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+var _ PullRequestCreator = (*BitbucketService)(nil)
+
+func (b *BitbucketService) CreateOrUpdate(_ context.Context, branch, targetBranch, title, description string) (string, error) {
+	response, err := b.client.DefaultApi.GetPullRequestsPage(b.projectKey, b.repositorySlug, map[string]any{
+		"at":        "refs/heads/" + targetBranch,
+		"direction": "INCOMING",
+		"state":     "OPEN",
+		"limit":     100,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error listing pull requests for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	pulls, err := bitbucketv1.GetPullRequestsResponse(response)
+	if err != nil {
+		return "", fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	for _, pull := range pulls {
+		if pull.FromRef.DisplayID != branch {
+			continue
+		}
+		response, err = b.client.DefaultApi.UpdatePullRequest(b.projectKey, b.repositorySlug, &bitbucketv1.EditPullRequestOptions{
+			ID:          int64(pull.ID),
+			Version:     strconv.Itoa(int(pull.Version)),
+			Title:       title,
+			Description: description,
+		})
+		if err != nil {
+			return "", fmt.Errorf("error updating pull request %d for %s/%s: %w", pull.ID, b.projectKey, b.repositorySlug, err)
+		}
+		return getBitbucketPullRequestURL(response)
+	}
+
+	repository := bitbucketv1.Repository{Slug: b.repositorySlug, Project: &bitbucketv1.Project{Key: b.projectKey}}
+	response, err = b.client.DefaultApi.CreatePullRequest(b.projectKey, b.repositorySlug, bitbucketv1.PullRequest{
+		Title:       title,
+		Description: description,
+		State:       "OPEN",
+		Open:        true,
+		FromRef:     bitbucketv1.PullRequestRef{ID: "refs/heads/" + branch, Repository: repository},
+		ToRef:       bitbucketv1.PullRequestRef{ID: "refs/heads/" + targetBranch, Repository: repository},
+	})
+	if err != nil {
+		return "", fmt.Errorf("error creating pull request for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	return getBitbucketPullRequestURL(response)
+}
+
+func getBitbucketPullRequestURL(response *bitbucketv1.APIResponse) (string, error) {
+	pull, err := bitbucketv1.GetPullRequestResponse(response)
+	if err != nil {
+		return "", fmt.Errorf("error parsing pull request response: %w", err)
+	}
+	if len(pull.Links.Self) == 0 {
+		return "", fmt.Errorf("pull request %d has no link", pull.ID)
+	}
+	return pull.Links.Self[0].Href, nil
+}
@@ -3,9 +3,11 @@ package pull_request
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/cookiejar"
 	"os"
+	"slices"
 
 	"code.gitea.io/sdk/gitea"
 )
@@ -103,3 +105,58 @@ func getGiteaPRLabelNames(giteaLabels []*gitea.Label) []string {
 	}
 	return labelNames
 }
+
+var _ PullRequestCreator = (*GiteaService)(nil)
+
+func (g *GiteaService) CreateOrUpdate(ctx context.Context, branch, targetBranch, title, description string) (string, error) {
+	g.client.SetContext(ctx)
+	prs, _, err := g.client.ListRepoPullRequests(g.owner, g.repo, gitea.ListPullRequestsOptions{State: gitea.StateOpen})
+	if err != nil {
+		return "", fmt.Errorf("error listing pull requests for %s/%s: %w", g.owner, g.repo, err)
+	}
+	for _, pr := range prs {
+		if pr.Head == nil || pr.Base == nil || pr.Head.Ref != branch || pr.Base.Ref != targetBranch {
+			continue
+		}
+		pr, _, err = g.client.EditPullRequest(g.owner, g.repo, pr.Index, gitea.EditPullRequestOption{Title: title, Body: &description})
+		if err != nil {
+			return "", fmt.Errorf("error updating pull request %d for %s/%s: %w", pr.Index, g.owner, g.repo, err)
+		}
+		return pr.HTMLURL, nil
+	}
+	labelIDs, err := g.getLabelIDs()
+	if err != nil {
+		return "", err
+	}
+	pr, _, err := g.client.CreatePullRequest(g.owner, g.repo, gitea.CreatePullRequestOption{
+		Head:   branch,
+		Base:   targetBranch,
+		Title:  title,
+		Body:   description,
+		Labels: labelIDs,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+	}
+	return pr.HTMLURL, nil
+}
+
+// getLabelIDs returns the IDs of the labels of the service, since Gitea pull requests are labeled by ID
+func (g *GiteaService) getLabelIDs() ([]int64, error) {
+	if len(g.labels) == 0 {
+		return nil, nil
+	}
+	labels, _, err := g.client.ListRepoLabels(g.owner, g.repo, gitea.ListLabelsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing labels for %s/%s: %w", g.owner, g.repo, err)
+	}
+	var ids []int64
+	for _, name := range g.labels {
+		i := slices.IndexFunc(labels, func(label *gitea.Label) bool { return label.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("label %s not found in %s/%s", name, g.owner, g.repo)
+		}
+		ids = append(ids, labels[i].ID)
+	}
+	return ids, nil
+}
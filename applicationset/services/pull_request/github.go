@@ -121,3 +121,38 @@ func getGithubPRLabelNames(gitHubLabels []*github.Label) []string {
 	}
 	return labelNames
 }
+
+var _ PullRequestCreator = (*GithubService)(nil)
+
+func (g *GithubService) CreateOrUpdate(ctx context.Context, branch, targetBranch, title, description string) (string, error) {
+	pulls, _, err := g.client.PullRequests.List(ctx, g.owner, g.repo, &github.PullRequestListOptions{
+		State: "open",
+		Head:  g.owner + ":" + branch,
+		Base:  targetBranch,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error listing pull requests for %s/%s: %w", g.owner, g.repo, err)
+	}
+	if len(pulls) > 0 {
+		pull, _, err := g.client.PullRequests.Edit(ctx, g.owner, g.repo, pulls[0].GetNumber(), &github.PullRequest{Title: &title, Body: &description})
+		if err != nil {
+			return "", fmt.Errorf("error updating pull request %d for %s/%s: %w", pulls[0].GetNumber(), g.owner, g.repo, err)
+		}
+		return pull.GetHTMLURL(), nil
+	}
+	pull, _, err := g.client.PullRequests.Create(ctx, g.owner, g.repo, &github.NewPullRequest{
+		Title: &title,
+		Head:  &branch,
+		Base:  &targetBranch,
+		Body:  &description,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+	}
+	if len(g.labels) > 0 {
+		if _, _, err := g.client.Issues.AddLabelsToIssue(ctx, g.owner, g.repo, pull.GetNumber(), g.labels); err != nil {
+			return "", fmt.Errorf("error adding labels to pull request %d for %s/%s: %w", pull.GetNumber(), g.owner, g.repo, err)
+		}
+	}
+	return pull.GetHTMLURL(), nil
+}
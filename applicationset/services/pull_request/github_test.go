@@ -1,6 +1,7 @@
 package pull_request
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -111,3 +112,61 @@ func TestGitHubListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitHubCreateOrUpdate(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc("GET /api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "owner:hydrated-next", r.URL.Query().Get("head"))
+			assert.Equal(t, "hydrated", r.URL.Query().Get("base"))
+			_, _ = w.Write([]byte(`[]`))
+		})
+		mux.HandleFunc("POST /api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+			pull := &github.NewPullRequest{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(pull))
+			assert.Equal(t, "hydrated-next", pull.GetHead())
+			assert.Equal(t, "hydrated", pull.GetBase())
+			assert.Equal(t, "title", pull.GetTitle())
+			assert.Equal(t, "description", pull.GetBody())
+			_, _ = w.Write([]byte(`{"number": 1, "html_url": "https://github.com/owner/repo/pull/1"}`))
+		})
+		mux.HandleFunc("POST /api/v3/repos/owner/repo/issues/1/labels", func(w http.ResponseWriter, r *http.Request) {
+			var labels []string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&labels))
+			assert.Equal(t, []string{"hydrated"}, labels)
+			_, _ = w.Write([]byte(`[]`))
+		})
+
+		svc, err := NewGithubService("", server.URL, "owner", "repo", []string{"hydrated"}, nil)
+		require.NoError(t, err)
+		url, err := svc.(PullRequestCreator).CreateOrUpdate(t.Context(), "hydrated-next", "hydrated", "title", "description")
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/owner/repo/pull/1", url)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc("GET /api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"number": 2}]`))
+		})
+		mux.HandleFunc("PATCH /api/v3/repos/owner/repo/pulls/2", func(w http.ResponseWriter, r *http.Request) {
+			pull := &github.PullRequest{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(pull))
+			assert.Equal(t, "title", pull.GetTitle())
+			assert.Equal(t, "description", pull.GetBody())
+			_, _ = w.Write([]byte(`{"number": 2, "html_url": "https://github.com/owner/repo/pull/2"}`))
+		})
+
+		svc, err := NewGithubService("", server.URL, "owner", "repo", []string{"hydrated"}, nil)
+		require.NoError(t, err)
+		url, err := svc.(PullRequestCreator).CreateOrUpdate(t.Context(), "hydrated-next", "hydrated", "title", "description")
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/owner/repo/pull/2", url)
+	})
+}
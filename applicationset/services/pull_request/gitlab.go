@@ -105,3 +105,41 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+var _ PullRequestCreator = (*GitLabService)(nil)
+
+func (g *GitLabService) CreateOrUpdate(ctx context.Context, branch, targetBranch, title, description string) (string, error) {
+	mrs, _, err := g.client.MergeRequests.ListProjectMergeRequests(g.project, &gitlab.ListProjectMergeRequestsOptions{
+		State:        new("opened"),
+		SourceBranch: &branch,
+		TargetBranch: &targetBranch,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("error listing merge requests for project '%s': %w", g.project, err)
+	}
+	if len(mrs) > 0 {
+		mr, _, err := g.client.MergeRequests.UpdateMergeRequest(g.project, mrs[0].IID, &gitlab.UpdateMergeRequestOptions{
+			Title:       &title,
+			Description: &description,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", fmt.Errorf("error updating merge request %d for project '%s': %w", mrs[0].IID, g.project, err)
+		}
+		return mr.WebURL, nil
+	}
+	opts := &gitlab.CreateMergeRequestOptions{
+		Title:        &title,
+		Description:  &description,
+		SourceBranch: &branch,
+		TargetBranch: &targetBranch,
+	}
+	if len(g.labels) > 0 {
+		labels := gitlab.LabelOptions(g.labels)
+		opts.Labels = &labels
+	}
+	mr, _, err := g.client.MergeRequests.CreateMergeRequest(g.project, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("error creating merge request for project '%s': %w", g.project, err)
+	}
+	return mr.WebURL, nil
+}
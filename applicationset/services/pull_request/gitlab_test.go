@@ -2,6 +2,7 @@ package pull_request
 
 import (
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"io"
 	"net/http"
@@ -216,3 +217,57 @@ func TestGitLabListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitLabCreateOrUpdate(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc("GET /api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "opened", r.URL.Query().Get("state"))
+			assert.Equal(t, "hydrated-next", r.URL.Query().Get("source_branch"))
+			assert.Equal(t, "hydrated", r.URL.Query().Get("target_branch"))
+			_, _ = w.Write([]byte(`[]`))
+		})
+		mux.HandleFunc("POST /api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "hydrated-next", body["source_branch"])
+			assert.Equal(t, "hydrated", body["target_branch"])
+			assert.Equal(t, "title", body["title"])
+			assert.Equal(t, "description", body["description"])
+			assert.Equal(t, "hydrated", body["labels"])
+			_, _ = w.Write([]byte(`{"iid": 1, "web_url": "https://gitlab.com/group/repo/-/merge_requests/1"}`))
+		})
+
+		svc, err := NewGitLabService("", server.URL, "278964", []string{"hydrated"}, "", "", false, nil)
+		require.NoError(t, err)
+		url, err := svc.(PullRequestCreator).CreateOrUpdate(t.Context(), "hydrated-next", "hydrated", "title", "description")
+		require.NoError(t, err)
+		assert.Equal(t, "https://gitlab.com/group/repo/-/merge_requests/1", url)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc("GET /api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"iid": 2}]`))
+		})
+		mux.HandleFunc("PUT /api/v4/projects/278964/merge_requests/2", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "title", body["title"])
+			assert.Equal(t, "description", body["description"])
+			_, _ = w.Write([]byte(`{"iid": 2, "web_url": "https://gitlab.com/group/repo/-/merge_requests/2"}`))
+		})
+
+		svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil)
+		require.NoError(t, err)
+		url, err := svc.(PullRequestCreator).CreateOrUpdate(t.Context(), "hydrated-next", "hydrated", "title", "description")
+		require.NoError(t, err)
+		assert.Equal(t, "https://gitlab.com/group/repo/-/merge_requests/2", url)
+	})
+}
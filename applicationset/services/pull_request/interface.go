@@ -33,3 +33,11 @@ type Filter struct {
 	TargetBranchMatch *regexp.Regexp
 	TitleMatch        *regexp.Regexp
 }
+
+// PullRequestCreator is implemented by the services which can also open pull requests.
+type PullRequestCreator interface {
+	// CreateOrUpdate opens a pull request from the branch to the target branch, or updates the title and description
+	// of the open pull request between them, and returns the URL of the pull request. The labels of the service are
+	// added to the pull requests it opens.
+	CreateOrUpdate(ctx context.Context, branch, targetBranch, title, description string) (string, error)
+}
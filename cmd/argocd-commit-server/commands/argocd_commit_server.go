@@ -16,12 +16,14 @@ import (
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/commitserver"
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/commit"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/askpass"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/healthz"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
@@ -33,6 +35,9 @@ func NewCommand() *cobra.Command {
 		listenPort  int
 		metricsPort int
 		metricsHost string
+
+		signingKeyPath   string
+		signingKeyFormat string
 	)
 	command := &cobra.Command{
 		Use:   common.CommandCommitServer,
@@ -57,7 +62,13 @@ func NewCommand() *cobra.Command {
 			askPassServer := askpass.NewServer(askpass.CommitServerSocketPath)
 			go func() { errors.CheckError(askPassServer.Run()) }()
 
-			server := commitserver.NewServer(askPassServer, metricsServer)
+			commitSigning, err := commit.NewCommitSigningOpts(signingKeyFormat, signingKeyPath)
+			errors.CheckError(err)
+			if commitSigning != nil {
+				log.Infof("Signing hydrated commits with the %s key %s", commitSigning.Format, signingKeyPath)
+			}
+
+			server := commitserver.NewServer(askPassServer, metricsServer, commitSigning)
 			grpc := server.CreateGRPC()
 			ctx := cmd.Context()
 
@@ -112,6 +123,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortCommitServer, "Listen on given port for incoming connections")
 	command.Flags().StringVar(&metricsHost, "metrics-address", env.StringFromEnv("ARGOCD_COMMIT_SERVER_METRICS_LISTEN_ADDRESS", common.DefaultAddressCommitServerMetrics), "Listen on given address for metrics")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortCommitServerMetrics, "Start metrics server on given port")
+	command.Flags().StringVar(&signingKeyPath, "signing-key-path", env.StringFromEnv("ARGOCD_COMMIT_SERVER_SIGNING_KEY_PATH", ""), "Path to the private key to sign hydrated commits with. Commits are not signed if empty")
+	command.Flags().StringVar(&signingKeyFormat, "signing-key-format", env.StringFromEnv("ARGOCD_COMMIT_SERVER_SIGNING_KEY_FORMAT", string(git.CommitSigningFormatOpenPGP)), "Format of the signing key. One of: openpgp|ssh")
 
 	return command
 }
@@ -27,6 +27,10 @@ func NewCommand() *cobra.Command {
 			if len(os.Args) != 2 {
 				errors.CheckError(fmt.Errorf("expected 1 argument, got %d", len(os.Args)-1))
 			}
+			// when used as a git credential helper, there is nothing to do when git stores or erases credentials
+			if os.Args[1] == "store" || os.Args[1] == "erase" {
+				return
+			}
 			nonce := os.Getenv(askpass.ASKPASS_NONCE_ENV)
 			if nonce == "" {
 				errors.CheckError(fmt.Errorf("%s is not set", askpass.ASKPASS_NONCE_ENV))
@@ -43,6 +47,8 @@ func NewCommand() *cobra.Command {
 				fmt.Println(creds.Username)
 			case strings.HasPrefix(os.Args[1], "Password"):
 				fmt.Println(creds.Password)
+			case os.Args[1] == "get":
+				fmt.Printf("username=%s\npassword=%s\n", creds.Username, creds.Password)
 			default:
 				errors.CheckError(fmt.Errorf("unknown credential type '%s'", os.Args[1]))
 			}
@@ -1,12 +1,14 @@
 package commands
 
 import (
+	"crypto"
 	"fmt"
 	"math"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime/debug"
 	"sync"
 	"syscall"
@@ -29,6 +31,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/askpass"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/cli"
+	cryptoutil "github.com/argoproj/argo-cd/v3/util/crypto"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
@@ -78,6 +81,11 @@ func NewCommand() *cobra.Command {
 		cmpUseManifestGeneratePaths        bool
 		ociMediaTypes                      []string
 		enableBuiltinGitConfig             bool
+		provenanceSigningKeyPath           string
+		diskCacheDir                       string
+		diskCacheMaxSize                   string
+		schedulerRepoWeights               map[string]string
+		schedulerProjectWeights            map[string]string
 	)
 	command := cobra.Command{
 		Use:               common.CommandRepoServer,
@@ -132,11 +140,34 @@ func NewCommand() *cobra.Command {
 			helmRegistryMaxIndexSizeQuantity, err := resource.ParseQuantity(helmRegistryMaxIndexSize)
 			errors.CheckError(err)
 
+			var provenanceSigningKey crypto.Signer
+			if provenanceSigningKeyPath != "" {
+				keyData, err := os.ReadFile(provenanceSigningKeyPath)
+				errors.CheckError(err)
+				provenanceSigningKey, err = cryptoutil.ParsePrivateKey(keyData)
+				errors.CheckError(err)
+			}
+
+			repoWeights, err := repository.ParseSchedulerWeights(schedulerRepoWeights)
+			errors.CheckError(err)
+			projectWeights, err := repository.ParseSchedulerWeights(schedulerProjectWeights)
+			errors.CheckError(err)
+
 			askPassServer := askpass.NewServer(askpass.SocketPath)
 			metricsServer := metrics.NewMetricsServer()
 			cacheutil.CollectMetrics(redisClient, metricsServer, nil)
+			if diskCacheDir != "" {
+				diskCacheMaxSizeQuantity, err := resource.ParseQuantity(diskCacheMaxSize)
+				errors.CheckError(err)
+				diskCache, err := cacheutil.NewDiskCache(filepath.Join(diskCacheDir, "manifests.db"), 0, diskCacheMaxSizeQuantity.Value())
+				errors.CheckError(err)
+				defer utilio.Close(diskCache)
+				cache.SetDiskCache(diskCache, metricsServer)
+			}
 			server, err := reposerver.NewServer(metricsServer, cache, tlsConfigCustomizer, repository.RepoServerInitConstants{
-				ParallelismLimit: parallelismLimit,
+				ParallelismLimit:                             parallelismLimit,
+				SchedulerRepoWeights:                         repoWeights,
+				SchedulerProjectWeights:                      projectWeights,
 				PauseGenerationAfterFailedGenerationAttempts: pauseGenerationAfterFailedGenerationAttempts,
 				PauseGenerationOnFailureForMinutes:           pauseGenerationOnFailureForMinutes,
 				PauseGenerationOnFailureForRequests:          pauseGenerationOnFailureForRequests,
@@ -155,6 +186,7 @@ func NewCommand() *cobra.Command {
 				OCIMediaTypes:                                ociMediaTypes,
 				EnableBuiltinGitConfig:                       enableBuiltinGitConfig,
 				HelmUserAgent:                                helmUserAgent,
+				ProvenanceSigningKey:                         provenanceSigningKey,
 			}, askPassServer)
 			errors.CheckError(err)
 
@@ -243,6 +275,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&cmdutil.LogFormat, "logformat", env.StringFromEnv("ARGOCD_REPO_SERVER_LOGFORMAT", "json"), "Set the logging format. One of: json|text")
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_REPO_SERVER_LOGLEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().Int64Var(&parallelismLimit, "parallelismlimit", int64(env.ParseNumFromEnv("ARGOCD_REPO_SERVER_PARALLELISM_LIMIT", 0, 0, math.MaxInt32)), "Limit on number of concurrent manifests generate requests. Any value less the 1 means no limit.")
+	command.Flags().StringToStringVar(&schedulerRepoWeights, "scheduler-repo-weights", env.ParseStringToStringFromEnv("ARGOCD_REPO_SERVER_SCHEDULER_REPO_WEIGHTS", map[string]string{}, ","), "Weights of repositories when scheduling manifest generation requests once the parallelism limit is reached, as comma-separated repository URL and weight pairs (e.g. https://github.com/org/monorepo.git=0.5). Repositories have a weight of 1 by default")
+	command.Flags().StringToStringVar(&schedulerProjectWeights, "scheduler-project-weights", env.ParseStringToStringFromEnv("ARGOCD_REPO_SERVER_SCHEDULER_PROJECT_WEIGHTS", map[string]string{}, ","), "Weights of projects when scheduling manifest generation requests once the parallelism limit is reached, as comma-separated project and weight pairs (e.g. production=2). Projects have a weight of 1 by default")
 	command.Flags().StringVar(&listenHost, "address", env.StringFromEnv("ARGOCD_REPO_SERVER_LISTEN_ADDRESS", common.DefaultAddressRepoServer), "Listen on given address for incoming connections")
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortRepoServer, "Listen on given port for incoming connections")
 	command.Flags().StringVar(&metricsHost, "metrics-address", env.StringFromEnv("ARGOCD_REPO_SERVER_METRICS_LISTEN_ADDRESS", common.DefaultAddressRepoServerMetrics), "Listen on given address for metrics")
@@ -266,6 +300,9 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&cmpUseManifestGeneratePaths, "plugin-use-manifest-generate-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_PLUGIN_USE_MANIFEST_GENERATE_PATHS", false), "Pass the resources described in argocd.argoproj.io/manifest-generate-paths value to the cmpserver to generate the application manifests.")
 	command.Flags().StringSliceVar(&ociMediaTypes, "oci-layer-media-types", env.StringsFromEnv("ARGOCD_REPO_SERVER_OCI_LAYER_MEDIA_TYPES", []string{"application/vnd.oci.image.layer.v1.tar", "application/vnd.oci.image.layer.v1.tar+gzip", "application/vnd.cncf.helm.chart.content.v1.tar+gzip"}, ","), "Comma separated list of allowed media types for OCI media types. This only accounts for media types within layers.")
 	command.Flags().BoolVar(&enableBuiltinGitConfig, "enable-builtin-git-config", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_ENABLE_BUILTIN_GIT_CONFIG", true), "Enable builtin git configuration options that are required for correct argocd-repo-server operation.")
+	command.Flags().StringVar(&provenanceSigningKeyPath, "provenance-signing-key-path", env.StringFromEnv("ARGOCD_REPO_SERVER_PROVENANCE_SIGNING_KEY_PATH", ""), "Path to a PEM-encoded ed25519, ECDSA or RSA private key signing the provenance of generated manifests")
+	command.Flags().StringVar(&diskCacheDir, "disk-cache-dir", env.StringFromEnv("ARGOCD_REPO_SERVER_DISK_CACHE_DIR", ""), "Directory of a persistent cache of generated manifests, e.g. on a persistent volume, which survives a flush of Redis or a restart of the repo server. It cannot be shared between replicas. Disabled if empty")
+	command.Flags().StringVar(&diskCacheMaxSize, "disk-cache-max-size", env.StringFromEnv("ARGOCD_REPO_SERVER_DISK_CACHE_MAX_SIZE", "1G"), "Maximum size of the cached manifests in the disk cache, beyond which the oldest manifests are evicted")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
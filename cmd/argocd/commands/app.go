@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"crypto"
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
@@ -57,6 +58,7 @@ import (
 	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v3/util/cli"
+	cryptoutil "github.com/argoproj/argo-cd/v3/util/crypto"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/grpc"
@@ -98,6 +100,9 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationApproveOpCommand(clientOpts))
+	command.AddCommand(NewApplicationRejectOpCommand(clientOpts))
+	command.AddCommand(NewApplicationDryRunReportCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationGetResourceCommand(clientOpts))
@@ -237,6 +242,27 @@ func getInfos(infos []string) []*argoappv1.Info {
 	return sliceInfos
 }
 
+// getSyncBatches parses batches formatted as NAME:SELECTOR[:ANALYSIS_HOOK]
+func getSyncBatches(batches []string) ([]argoappv1.SyncBatch, error) {
+	syncBatches := make([]argoappv1.SyncBatch, 0, len(batches))
+	for _, batch := range batches {
+		parts := strings.Split(batch, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+			return nil, fmt.Errorf("batch %q must be formatted as NAME:SELECTOR[:ANALYSIS_HOOK]", batch)
+		}
+		selector, err := metav1.ParseToLabelSelector(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of batch %q: %w", parts[0], err)
+		}
+		syncBatch := argoappv1.SyncBatch{Name: parts[0], Selector: *selector}
+		if len(parts) == 3 {
+			syncBatch.AnalysisHook = parts[2]
+		}
+		syncBatches = append(syncBatches, syncBatch)
+	}
+	return syncBatches, nil
+}
+
 func getRefreshType(refresh bool, hardRefresh bool) *string {
 	if hardRefresh {
 		refreshType := string(argoappv1.RefreshTypeHard)
@@ -318,6 +344,10 @@ func printHeader(ctx context.Context, acdClient argocdclient.Client, app *argoap
 		_ = w.Flush()
 		fmt.Println()
 	}
+	if len(app.Spec.DependsOn) > 0 {
+		fmt.Println()
+		printAppDependencies(ctx, acdClient, app)
+	}
 	if showOperation && app.Status.OperationState != nil {
 		fmt.Println()
 		printOperationResult(app.Status.OperationState)
@@ -327,6 +357,17 @@ func printHeader(ctx context.Context, acdClient argocdclient.Client, app *argoap
 	}
 }
 
+// printAppDependencies prints the graph of the applications the given application depends on
+func printAppDependencies(ctx context.Context, acdClient argocdclient.Client, app *argoappv1.Application) {
+	conn, appIf := acdClient.NewApplicationClientOrDie()
+	defer utilio.Close(conn)
+	tree, err := appIf.ResourceTree(ctx, &application.ResourcesQuery{ApplicationName: &app.Name, AppNamespace: &app.Namespace})
+	errors.CheckError(err)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	printDependencyTreeView(w, app, tree.Dependencies)
+	_ = w.Flush()
+}
+
 // getSourceNameToPositionMap returns a map of source name to position
 func getSourceNameToPositionMap(app *argoappv1.Application) map[string]int64 {
 	sourceNameToPosition := make(map[string]int64)
@@ -724,6 +765,17 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 		syncPolicy = "Manual"
 	}
 	fmt.Printf(printOpFmtStr, "Sync Policy:", syncPolicy)
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.SyncSchedule != nil {
+		schedule := app.Spec.SyncPolicy.SyncSchedule
+		syncSchedule := schedule.Schedule
+		if schedule.TimeZone != "" {
+			syncSchedule += " (" + schedule.TimeZone + ")"
+		}
+		if schedule.Prune {
+			syncSchedule += " (Prune)"
+		}
+		fmt.Printf(printOpFmtStr, "Sync Schedule:", syncSchedule)
+	}
 	syncStatusStr := string(app.Status.Sync.Status)
 	switch app.Status.Sync.Status {
 	case argoappv1.SyncStatusCodeSynced:
@@ -737,6 +789,13 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 	fmt.Printf(printOpFmtStr, "Sync Status:", syncStatusStr)
 	healthStr := string(app.Status.Health.Status)
 	fmt.Printf(printOpFmtStr, "Health Status:", healthStr)
+	if len(app.Spec.DependsOn) > 0 {
+		var dependencies []string
+		for _, dependency := range app.Spec.DependsOn {
+			dependencies = append(dependencies, dependency.QualifiedName(app.Namespace))
+		}
+		fmt.Printf(printOpFmtStr, "Depends On:", strings.Join(dependencies, ","))
+	}
 }
 
 func printAppSourceDetails(appSrc *argoappv1.ApplicationSource) {
@@ -751,6 +810,12 @@ func printAppSourceDetails(appSrc *argoappv1.ApplicationSource) {
 	if appSrc.Helm != nil && len(appSrc.Helm.ValueFiles) > 0 {
 		fmt.Printf(printOpFmtStr, "  Helm Values:", strings.Join(appSrc.Helm.ValueFiles, ","))
 	}
+	if appSrc.Helmfile != nil && appSrc.Helmfile.Environment != "" {
+		fmt.Printf(printOpFmtStr, "  Helmfile Environment:", appSrc.Helmfile.Environment)
+	}
+	if appSrc.Cue != nil && appSrc.Cue.Package != "" {
+		fmt.Printf(printOpFmtStr, "  CUE Package:", appSrc.Cue.Package)
+	}
 	if appSrc.Kustomize != nil && appSrc.Kustomize.NamePrefix != "" {
 		fmt.Printf(printOpFmtStr, "  Name Prefix:", appSrc.Kustomize.NamePrefix)
 	}
@@ -821,6 +886,9 @@ func printParams(app *argoappv1.Application, sourcePosition int) {
 	if source.Helm != nil {
 		printHelmParams(source.Helm)
 	}
+	if source.Cue != nil {
+		printCueTags(source.Cue)
+	}
 }
 
 func printHelmParams(helm *argoappv1.ApplicationSourceHelm) {
@@ -837,6 +905,17 @@ func printHelmParams(helm *argoappv1.ApplicationSourceHelm) {
 	_ = w.Flush()
 }
 
+func printCueTags(cue *argoappv1.ApplicationSourceCue) {
+	paramLenLimit := 80
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "TAG\tVALUE\n")
+	for _, t := range cue.Tags {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", t.Name, truncateString(t.Value, paramLenLimit))
+	}
+	_ = w.Flush()
+}
+
 func getServer(app *argoappv1.Application) string {
 	if app.Spec.Destination.Server == "" {
 		return app.Spec.Destination.Name
@@ -947,6 +1026,7 @@ type unsetOpts struct {
 	valuesLiteral           bool
 	ignoreMissingValueFiles bool
 	pluginEnvs              []string
+	cueTags                 []string
 	passCredentials         bool
 	ref                     bool
 }
@@ -1066,6 +1146,7 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	command.Flags().StringArrayVar(&opts.kustomizeReplicas, "kustomize-replica", []string{}, "Kustomize replicas name (e.g. --kustomize-replica my-deployment --kustomize-replica my-statefulset)")
 	command.Flags().BoolVar(&opts.ignoreMissingComponents, "ignore-missing-components", false, "Unset the kustomize ignore-missing-components option (revert to false)")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Unset plugin env variables (e.g --plugin-env name)")
+	command.Flags().StringArrayVar(&opts.cueTags, "cue-tag", []string{}, "Unset CUE tag values (e.g. --cue-tag env)")
 	command.Flags().BoolVar(&opts.passCredentials, "pass-credentials", false, "Unset passCredentials")
 	command.Flags().BoolVar(&opts.ref, "ref", false, "Unset ref on the source")
 	command.Flags().IntVar(&sourcePosition, "source-position", -1, "Position of the source from the list of sources of the app. Counting starts at 1.")
@@ -1187,6 +1268,17 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 			}
 		}
 	}
+
+	if source.Cue != nil {
+		if len(opts.cueTags) == 0 {
+			return updated, !needToUnsetRef
+		}
+		for _, name := range opts.cueTags {
+			if source.Cue.RemoveTag(name) {
+				updated = true
+			}
+		}
+	}
 	return updated, false
 }
 
@@ -2143,6 +2235,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		dryRun                    bool
 		timeout                   uint
 		strategy                  string
+		batches                   []string
 		force                     bool
 		replace                   bool
 		serverSideApply           bool
@@ -2153,6 +2246,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		retryBackoffDuration      time.Duration
 		retryBackoffMaxDuration   time.Duration
 		retryBackoffFactor        int64
+		retryResourceLimit        int64
 		local                     string
 		localRepoRoot             string
 		infos                     []string
@@ -2193,7 +2287,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app sync my-app --resource apps:Deployment:my-service --resource :Service:my-service
   argocd app sync my-app --resource '!*:Service:*'
   # Specify namespace if the application has resources with the same name in different namespaces
-  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout`,
+  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout
+
+  # Sync resources labelled canary=true first, then run the smoke-test PostSync hook before syncing the other resources
+  argocd app sync my-app --strategy batch --batch canary:canary=true:smoke-test`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 && selector == "" && len(projects) == 0 {
@@ -2420,6 +2517,11 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				case "", "hook":
 					syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{}}
 					syncReq.Strategy.Hook.Force = force
+				case "batch":
+					syncBatches, err := getSyncBatches(batches)
+					errors.CheckError(err)
+					syncReq.Strategy = &argoappv1.SyncStrategy{Batch: &argoappv1.SyncStrategyBatch{Batches: syncBatches}}
+					syncReq.Strategy.Batch.Force = force
 				default:
 					log.Fatalf("Unknown sync strategy: '%s'", strategy)
 				}
@@ -2434,6 +2536,12 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 						},
 					}
 				}
+				if retryResourceLimit != 0 {
+					if syncReq.RetryStrategy == nil {
+						syncReq.RetryStrategy = &argoappv1.RetryStrategy{}
+					}
+					syncReq.RetryStrategy.Resources = &argoappv1.ResourceRetryStrategy{Limit: retryResourceLimit}
+				}
 				if diffChanges {
 					resources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{
 						ApplicationName: &appName,
@@ -2499,7 +2607,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().DurationVar(&retryBackoffDuration, "retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&retryBackoffMaxDuration, "retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&retryBackoffFactor, "retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed retry")
-	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
+	command.Flags().Int64Var(&retryResourceLimit, "retry-resource-limit", 0, "Max number of allowed retries of a resource failing to sync with a transient error, before the sync fails")
+	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook|batch)")
+	command.Flags().StringArrayVar(&batches, "batch", []string{}, "Batch of resources to sync with the batch strategy, formatted as NAME:SELECTOR[:ANALYSIS_HOOK]. Batches are synced in the order given, followed by the remaining resources. This option may be specified repeatedly.")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
 	command.Flags().BoolVar(&replace, "replace", false, "Use a kubectl create/replace instead apply")
 	command.Flags().BoolVar(&serverSideApply, "server-side", false, "Use server-side apply while syncing the application")
@@ -3179,6 +3289,24 @@ func printOperationResult(opState *argoappv1.OperationState) {
 		duration = time.Second * time.Duration(time.Now().UTC().Unix()-opState.StartedAt.Unix())
 	}
 	fmt.Printf(printOpFmtStr, "Duration:", duration)
+	if len(opState.SyncBatches) > 0 {
+		batches := make([]string, len(opState.SyncBatches))
+		for i, batch := range opState.SyncBatches {
+			phase := batch.Phase
+			if phase == "" {
+				phase = "Pending"
+			}
+			batches[i] = fmt.Sprintf("%s (%s)", batch.Name, phase)
+		}
+		fmt.Printf(printOpFmtStr, "Batches:", strings.Join(batches, ", "))
+	}
+	if len(opState.Operation.Approvals) > 0 {
+		approvers := make([]string, len(opState.Operation.Approvals))
+		for i, approval := range opState.Operation.Approvals {
+			approvers[i] = approval.Username
+		}
+		fmt.Printf(printOpFmtStr, "Approved By:", strings.Join(approvers, ", "))
+	}
 	if opState.Message != "" {
 		fmt.Printf(printOpFmtStr, "Message:", opState.Message)
 	}
@@ -3194,6 +3322,9 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 		sourceNames     []string
 		local           string
 		localRepoRoot   string
+		verify          bool
+		verifyKey       string
+		allowUnverified bool
 	)
 	command := &cobra.Command{
 		Use:   "manifests APPNAME",
@@ -3210,6 +3341,9 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 
   # Get manifests for a multi-source application at specific revisions for specific sources
   argocd app manifests my-app --revisions 0.0.1 --source-positions 1 --revisions 0.0.2 --source-positions 2
+
+  # Get manifests for an application and verify their provenance
+  argocd app manifests my-app --verify
   		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -3237,6 +3371,13 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				}
 			}
 
+			if verify && (source != "git" || local != "") {
+				errors.Fatal(errors.ErrorGeneric, "--verify can only be used with manifests generated from git by the repo server")
+			}
+			if allowUnverified && !verify {
+				errors.Fatal(errors.ErrorGeneric, "--allow-unverified-secrets can only be used with --verify")
+			}
+
 			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
 			clientset := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := clientset.NewApplicationClientOrDie()
@@ -3293,13 +3434,17 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 					}
 					res, err := appIf.GetManifests(ctx, &q)
 					errors.CheckError(err)
+					if verify {
+						verifyManifests(ctx, clientset, res, verifyKey, allowUnverified)
+					}
 
 					for _, mfst := range res.Manifests {
 						obj, err := argoappv1.UnmarshalToUnstructured(mfst)
 						errors.CheckError(err)
 						unstructureds = append(unstructureds, obj)
 					}
-				case revision != "":
+				case revision != "" || verify:
+					// the manifests of the managed resources have no provenance, so they are generated again to be verified
 					q := application.ApplicationManifestQuery{
 						Name:         &appName,
 						AppNamespace: &appNs,
@@ -3307,6 +3452,9 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 					}
 					res, err := appIf.GetManifests(ctx, &q)
 					errors.CheckError(err)
+					if verify {
+						verifyManifests(ctx, clientset, res, verifyKey, allowUnverified)
+					}
 
 					for _, mfst := range res.Manifests {
 						obj, err := argoappv1.UnmarshalToUnstructured(mfst)
@@ -3340,9 +3488,98 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
 	command.Flags().StringVar(&local, "local", "", "If set, show locally-generated manifests. Value is the absolute path to app manifests within the manifest repo. Example: '/home/username/apps/env/app-1'.")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", ".", "Path to the local repository root. Used together with --local allows setting the repository root. Example: '/home/username/apps'.")
+	command.Flags().BoolVar(&verify, "verify", false, "Verify the signed provenance of the manifests generated by the repo server")
+	command.Flags().StringVar(&verifyKey, "verify-key", "", "Path to the PEM-encoded public key verifying the provenance of the manifests. Defaults to the manifests.provenance.publicKey setting of argocd-cm")
+	command.Flags().BoolVar(&allowUnverified, "allow-unverified-secrets", false, "Do not fail the verification of the manifests because of Secrets, which cannot be verified since their data is hidden by the API server")
 	return command
 }
 
+// verifyManifests verifies the provenance of the given manifests with the given public key, or with the public key of
+// the Argo CD settings, and exits if the manifests cannot be verified
+func verifyManifests(ctx context.Context, clientset argocdclient.Client, res *repoapiclient.ManifestResponse, keyPath string, allowUnverifiedSecrets bool) {
+	var keyData []byte
+	if keyPath != "" {
+		var err error
+		keyData, err = os.ReadFile(keyPath)
+		errors.CheckError(err)
+	} else {
+		settingsConn, settingsIf := clientset.NewSettingsClientOrDie()
+		defer utilio.Close(settingsConn)
+		argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
+		errors.CheckError(err)
+		if argoSettings.ManifestProvenancePublicKey == "" {
+			errors.Fatal(errors.ErrorGeneric, "No public key to verify the manifests with. Set --verify-key or the manifests.provenance.publicKey setting of argocd-cm.")
+		}
+		keyData = []byte(argoSettings.ManifestProvenancePublicKey)
+	}
+	key, err := cryptoutil.ParsePublicKey(keyData)
+	errors.CheckError(err)
+
+	warnings, err := verifyManifestProvenance(res, key, allowUnverifiedSecrets)
+	if err != nil {
+		errors.Fatal(errors.ErrorGeneric, "Manifests verification failed: "+err.Error())
+	}
+	for _, warning := range warnings {
+		log.Warn(warning)
+	}
+	provenances := getManifestProvenances(res)
+	for i, provenance := range provenances {
+		fmt.Fprintf(os.Stderr, "Verified %d manifests of source %d generated from %s at %s with key %s\n", len(provenance.ManifestDigests), i+1, provenance.RepoURL, provenance.Revision, provenance.KeyID)
+	}
+}
+
+// getManifestProvenances returns the provenance of each source of the given manifests
+func getManifestProvenances(res *repoapiclient.ManifestResponse) []*repoapiclient.ManifestProvenance {
+	if len(res.SourceProvenances) > 0 {
+		return res.SourceProvenances
+	}
+	if res.Provenance != nil {
+		return []*repoapiclient.ManifestProvenance{res.Provenance}
+	}
+	return nil
+}
+
+// verifyManifestProvenance verifies the provenance of each source of the given manifests with the given key, and that
+// the manifests match the digests recorded by their provenance. The manifests of each source follow those of the
+// previous source. Secrets whose data is hidden by the API server cannot be verified: they fail the verification, unless
+// unverified secrets are allowed, in which case they are returned as warnings.
+func verifyManifestProvenance(res *repoapiclient.ManifestResponse, key crypto.PublicKey, allowUnverifiedSecrets bool) ([]string, error) {
+	provenances := getManifestProvenances(res)
+	if len(provenances) == 0 {
+		return nil, stderrors.New("manifests have no provenance")
+	}
+	var warnings []string
+	offset := 0
+	for i, provenance := range provenances {
+		if err := provenance.Verify(key); err != nil {
+			return nil, fmt.Errorf("source %d: %w", i+1, err)
+		}
+		if offset+len(provenance.ManifestDigests) > len(res.Manifests) {
+			return nil, fmt.Errorf("source %d: provenance records more manifests than received", i+1)
+		}
+		for j, digest := range provenance.ManifestDigests {
+			manifest := res.Manifests[offset+j]
+			if repoapiclient.ManifestDigest(manifest) == digest {
+				continue
+			}
+			obj, err := argoappv1.UnmarshalToUnstructured(manifest)
+			if err == nil && obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+				if !allowUnverifiedSecrets {
+					return nil, fmt.Errorf("source %d: Secret %s/%s cannot be verified because its data is hidden, use --allow-unverified-secrets to skip it", i+1, obj.GetNamespace(), obj.GetName())
+				}
+				warnings = append(warnings, fmt.Sprintf("Secret %s/%s of source %d cannot be verified because its data is hidden", obj.GetNamespace(), obj.GetName(), i+1))
+				continue
+			}
+			return nil, fmt.Errorf("source %d: manifest %d does not match its provenance", i+1, j+1)
+		}
+		offset += len(provenance.ManifestDigests)
+	}
+	if offset != len(res.Manifests) {
+		return nil, fmt.Errorf("received %d manifests, but their provenance records %d", len(res.Manifests), offset)
+	}
+	return warnings, nil
+}
+
 // NewApplicationTerminateOpCommand returns a new instance of an `argocd app terminate-op` command
 func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
@@ -3369,6 +3606,151 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	return command
 }
 
+// NewApplicationApproveOpCommand returns a new instance of an `argocd app approve-op` command
+func NewApplicationApproveOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		message      string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "approve-op APPNAME",
+		Short: "Approve the sync operation of an application that is waiting for approvals",
+		Example: `  # Approve the sync operation of an application, referencing a change request
+  argocd app approve-op my-app --message "CHG-1234"`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			_, err := appIf.ApproveOperation(ctx, &application.OperationApprovalRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Message:      &message,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' operation approved\n", appName)
+		},
+	}
+	command.Flags().StringVarP(&message, "message", "m", "", "Comment recorded with the approval")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+// NewApplicationRejectOpCommand returns a new instance of an `argocd app reject-op` command
+func NewApplicationRejectOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		message      string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "reject-op APPNAME",
+		Short: "Reject the sync operation of an application that is waiting for approvals",
+		Example: `  # Reject the sync operation of an application, giving the reason
+  argocd app reject-op my-app --message "outside of the change window"`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			_, err := appIf.RejectOperation(ctx, &application.OperationApprovalRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Message:      &message,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' operation rejected\n", appName)
+		},
+	}
+	command.Flags().StringVarP(&message, "message", "m", "", "Comment recorded with the rejection")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+// NewApplicationDryRunReportCommand returns a new instance of an `argocd app dry-run-report` command
+func NewApplicationDryRunReportCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output       string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "dry-run-report APPNAME",
+		Short: "Show the report of the last dry-run sync of an application",
+		Example: `  # Run a dry-run sync and show its report
+  argocd app sync my-app --dry-run
+  argocd app dry-run-report my-app
+
+  # Show the report in JSON format, e.g. to attach it to a change request
+  argocd app dry-run-report my-app -o json`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			report, err := appIf.GetDryRunReport(ctx, &application.ApplicationDryRunReportQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(report, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printDryRunReport(report)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only show the report of the application in namespace")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func printDryRunReport(report *argoappv1.DryRunReport) {
+	fmt.Printf(printOpFmtStr, "Generated At:", report.GeneratedAt)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if len(report.Resources) > 0 {
+		fmt.Println()
+		_, _ = fmt.Fprint(w, "GROUP\tKIND\tNAMESPACE\tNAME\tACTION\tSYNC WAVE\tMESSAGE\n")
+		for _, res := range report.Resources {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\n", res.Group, res.Kind, res.Namespace, res.Name, res.Action, res.SyncWave, dryRunResultMessage(res))
+		}
+		_ = w.Flush()
+	}
+	if len(report.Hooks) > 0 {
+		fmt.Println()
+		_, _ = fmt.Fprint(w, "KIND\tNAMESPACE\tNAME\tHOOK TYPE\tSYNC PHASE\tSYNC WAVE\tMESSAGE\n")
+		for _, res := range report.Hooks {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\n", res.Kind, res.Namespace, res.Name, res.HookType, res.SyncPhase, res.SyncWave, dryRunResultMessage(res))
+		}
+		_ = w.Flush()
+	}
+}
+
+func dryRunResultMessage(res argoappv1.DryRunResourceResult) string {
+	if res.ValidationError != "" {
+		return "error: " + res.ValidationError
+	}
+	return res.Message
+}
+
 func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var appNamespace string
 	command := &cobra.Command{
@@ -2,6 +2,8 @@ package commands
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -79,6 +81,23 @@ func Test_getInfos(t *testing.T) {
 	}
 }
 
+func Test_getSyncBatches(t *testing.T) {
+	batches, err := getSyncBatches([]string{"canary:canary=true:smoke-test", "zone-a:zone in (a)"})
+	require.NoError(t, err)
+	require.Len(t, batches, 2)
+	assert.Equal(t, "canary", batches[0].Name)
+	assert.Equal(t, map[string]string{"canary": "true"}, batches[0].Selector.MatchLabels)
+	assert.Equal(t, "smoke-test", batches[0].AnalysisHook)
+	assert.Equal(t, "zone-a", batches[1].Name)
+	assert.Len(t, batches[1].Selector.MatchExpressions, 1)
+	assert.Empty(t, batches[1].AnalysisHook)
+
+	_, err = getSyncBatches([]string{"canary"})
+	require.Error(t, err)
+	_, err = getSyncBatches([]string{"canary:foo in bar"})
+	require.Error(t, err)
+}
+
 func Test_getRefreshType(t *testing.T) {
 	refreshTypeNormal := string(v1alpha1.RefreshTypeNormal)
 	refreshTypeHard := string(v1alpha1.RefreshTypeHard)
@@ -1108,6 +1127,21 @@ func Test_unset(t *testing.T) {
 		},
 	}
 
+	cueSource := &v1alpha1.ApplicationSource{
+		Cue: &v1alpha1.ApplicationSourceCue{
+			Tags: []v1alpha1.CueTag{
+				{
+					Name:  "env",
+					Value: "prod",
+				},
+				{
+					Name:  "replicas",
+					Value: "3",
+				},
+			},
+		},
+	}
+
 	assert.Equal(t, "some-prefix", kustomizeSource.Kustomize.NamePrefix)
 	updated, nothingToUnset := unset(kustomizeSource, unsetOpts{namePrefix: true})
 	assert.Empty(t, kustomizeSource.Kustomize.NamePrefix)
@@ -1215,6 +1249,15 @@ func Test_unset(t *testing.T) {
 	updated, nothingToUnset = unset(pluginSource, unsetOpts{pluginEnvs: []string{"env-1"}})
 	assert.False(t, updated)
 	assert.False(t, nothingToUnset)
+
+	assert.Len(t, cueSource.Cue.Tags, 2)
+	updated, nothingToUnset = unset(cueSource, unsetOpts{cueTags: []string{"env"}})
+	assert.Equal(t, []v1alpha1.CueTag{{Name: "replicas", Value: "3"}}, cueSource.Cue.Tags)
+	assert.True(t, updated)
+	assert.False(t, nothingToUnset)
+	updated, nothingToUnset = unset(cueSource, unsetOpts{cueTags: []string{"env"}})
+	assert.False(t, updated)
+	assert.False(t, nothingToUnset)
 }
 
 func Test_unset_nothingToUnset(t *testing.T) {
@@ -1227,6 +1270,7 @@ func Test_unset_nothingToUnset(t *testing.T) {
 		{"kustomize", v1alpha1.ApplicationSource{Kustomize: &v1alpha1.ApplicationSourceKustomize{}}},
 		{"helm", v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{}}},
 		{"plugin", v1alpha1.ApplicationSource{Plugin: &v1alpha1.ApplicationSourcePlugin{}}},
+		{"cue", v1alpha1.ApplicationSource{Cue: &v1alpha1.ApplicationSourceCue{}}},
 	}
 
 	for _, testCase := range testCases {
@@ -2156,6 +2200,10 @@ func (c *fakeAppServiceClient) GetApplicationSyncWindows(_ context.Context, _ *a
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) GetDryRunReport(_ context.Context, _ *applicationpkg.ApplicationDryRunReportQuery, _ ...grpc.CallOption) (*v1alpha1.DryRunReport, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) GetOCIMetadata(_ context.Context, _ *applicationpkg.RevisionMetadataQuery, _ ...grpc.CallOption) (*v1alpha1.OCIMetadata, error) {
 	return nil, nil
 }
@@ -2216,6 +2264,14 @@ func (c *fakeAppServiceClient) TerminateOperation(_ context.Context, _ *applicat
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ApproveOperation(_ context.Context, _ *applicationpkg.OperationApprovalRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) RejectOperation(_ context.Context, _ *applicationpkg.OperationApprovalRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) GetResource(_ context.Context, _ *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceResponse, error) {
 	return nil, nil
 }
@@ -2405,3 +2461,83 @@ func (c *fakeAcdClient) WatchApplicationSetWithRetry(_ context.Context, _ string
 	}()
 	return appSetEventsCh
 }
+
+func Test_verifyManifestProvenance(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	configMap := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"default"}}`
+	secret := `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret","namespace":"default"},"data":{"password":"c2VjcmV0"}}`
+	hiddenSecret := `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret","namespace":"default"},"data":{"password":"++++++++"}}`
+	service := `{"apiVersion":"v1","kind":"Service","metadata":{"name":"service","namespace":"default"}}`
+	newProvenance := func(t *testing.T, manifests ...string) *apiclient.ManifestProvenance {
+		t.Helper()
+		provenance := &apiclient.ManifestProvenance{Revision: "abc123"}
+		provenance.SetManifests(manifests)
+		require.NoError(t, provenance.Sign(key))
+		return provenance
+	}
+
+	t.Run("SingleSource", func(t *testing.T) {
+		warnings, err := verifyManifestProvenance(&apiclient.ManifestResponse{
+			Manifests:  []string{configMap, service},
+			Provenance: newProvenance(t, configMap, service),
+		}, key.Public(), false)
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+	t.Run("MultipleSources", func(t *testing.T) {
+		warnings, err := verifyManifestProvenance(&apiclient.ManifestResponse{
+			Manifests:         []string{configMap, service},
+			SourceProvenances: []*apiclient.ManifestProvenance{newProvenance(t, configMap), newProvenance(t, service)},
+		}, key.Public(), false)
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+	t.Run("HiddenSecret", func(t *testing.T) {
+		_, err := verifyManifestProvenance(&apiclient.ManifestResponse{
+			Manifests:         []string{configMap, hiddenSecret, service},
+			SourceProvenances: []*apiclient.ManifestProvenance{newProvenance(t, configMap, secret), newProvenance(t, service)},
+		}, key.Public(), false)
+		require.EqualError(t, err, "source 1: Secret default/secret cannot be verified because its data is hidden, use --allow-unverified-secrets to skip it")
+	})
+	t.Run("HiddenSecretAllowed", func(t *testing.T) {
+		warnings, err := verifyManifestProvenance(&apiclient.ManifestResponse{
+			Manifests:         []string{configMap, hiddenSecret, service},
+			SourceProvenances: []*apiclient.ManifestProvenance{newProvenance(t, configMap, secret), newProvenance(t, service)},
+		}, key.Public(), true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Secret default/secret of source 1 cannot be verified because its data is hidden"}, warnings)
+	})
+	t.Run("TamperedManifest", func(t *testing.T) {
+		_, err := verifyManifestProvenance(&apiclient.ManifestResponse{
+			Manifests:         []string{configMap, configMap},
+			SourceProvenances: []*apiclient.ManifestProvenance{newProvenance(t, configMap), newProvenance(t, service)},
+		}, key.Public(), false)
+		require.EqualError(t, err, "source 2: manifest 1 does not match its provenance")
+	})
+	t.Run("MissingManifest", func(t *testing.T) {
+		_, err := verifyManifestProvenance(&apiclient.ManifestResponse{
+			Manifests:  []string{configMap},
+			Provenance: newProvenance(t, configMap, service),
+		}, key.Public(), false)
+		require.EqualError(t, err, "source 1: provenance records more manifests than received")
+	})
+	t.Run("ExtraManifest", func(t *testing.T) {
+		_, err := verifyManifestProvenance(&apiclient.ManifestResponse{
+			Manifests:  []string{configMap, service},
+			Provenance: newProvenance(t, configMap),
+		}, key.Public(), false)
+		require.EqualError(t, err, "received 2 manifests, but their provenance records 1")
+	})
+	t.Run("Unsigned", func(t *testing.T) {
+		provenance := &apiclient.ManifestProvenance{}
+		provenance.SetManifests([]string{configMap})
+		_, err := verifyManifestProvenance(&apiclient.ManifestResponse{Manifests: []string{configMap}, Provenance: provenance}, key.Public(), false)
+		require.EqualError(t, err, "source 1: manifest provenance is not signed")
+	})
+	t.Run("NoProvenance", func(t *testing.T) {
+		_, err := verifyManifestProvenance(&apiclient.ManifestResponse{Manifests: []string{configMap}}, key.Public(), false)
+		require.EqualError(t, err, "manifests have no provenance")
+	})
+}
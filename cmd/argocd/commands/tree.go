@@ -144,6 +144,44 @@ func detailedTreeViewAppResourcesOrphaned(prefix string, uidToNodeMap map[string
 	}
 }
 
+// printDependencyTreeView prints the applications the given application depends on, as a tree of dependencies
+func printDependencyTreeView(w *tabwriter.Writer, app *v1alpha1.Application, dependencies []v1alpha1.ApplicationDependencyNode) {
+	nodes := make(map[string]v1alpha1.ApplicationDependencyNode, len(dependencies))
+	for _, node := range dependencies {
+		nodes[node.QualifiedName("")] = node
+	}
+	ancestors := map[string]bool{app.Namespace + "/" + app.Name: true}
+	_, _ = fmt.Fprintf(w, "DEPENDENCY\tSYNC\tHEALTH\n")
+	for _, dependency := range app.Spec.DependsOn {
+		treeViewAppDependencies("", nodes, dependency.QualifiedName(app.Namespace), ancestors, w)
+	}
+}
+
+func treeViewAppDependencies(prefix string, nodes map[string]v1alpha1.ApplicationDependencyNode, key string, ancestors map[string]bool, w *tabwriter.Writer) {
+	node, ok := nodes[key]
+	syncStatus, healthStatus := node.SyncStatus, node.HealthStatus
+	if !ok || syncStatus == "" {
+		syncStatus, healthStatus = v1alpha1.SyncStatusCodeUnknown, health.HealthStatusMissing
+	}
+	if ancestors[key] {
+		_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s (cycle)\n", printPrefix(prefix), key, syncStatus, healthStatus)
+		return
+	}
+	_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s\n", printPrefix(prefix), key, syncStatus, healthStatus)
+	ancestors[key] = true
+	defer delete(ancestors, key)
+	for i, dependency := range node.DependsOn {
+		var p string
+		switch i {
+		case len(node.DependsOn) - 1:
+			p = prefix + lastElemPrefix
+		default:
+			p = prefix + firstElemPrefix
+		}
+		treeViewAppDependencies(p, nodes, dependency.QualifiedName(""), ancestors, w)
+	}
+}
+
 func printPrefix(p string) string {
 	if strings.HasSuffix(p, firstElemPrefix) {
 		p = strings.Replace(p, firstElemPrefix, pipe, strings.Count(p, firstElemPrefix)-1)
@@ -168,6 +168,32 @@ func TestTreeViewDetailedAppResources(t *testing.T) {
 	assert.Contains(t, output, "Readiness Gate failed")
 }
 
+func TestPrintDependencyTreeView(t *testing.T) {
+	app := &v1alpha1.Application{}
+	app.Namespace = "argocd"
+	app.Name = "frontend"
+	app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "backend"}, {Name: "ingress", Namespace: "infra"}}
+	dependency := func(namespace, name string) v1alpha1.ApplicationDependency {
+		return v1alpha1.ApplicationDependency{Namespace: namespace, Name: name}
+	}
+	dependencies := []v1alpha1.ApplicationDependencyNode{
+		{ApplicationDependency: dependency("argocd", "backend"), SyncStatus: v1alpha1.SyncStatusCodeSynced, HealthStatus: "Healthy", DependsOn: []v1alpha1.ApplicationDependency{dependency("argocd", "database"), dependency("argocd", "frontend")}},
+		{ApplicationDependency: dependency("argocd", "database"), SyncStatus: v1alpha1.SyncStatusCodeOutOfSync, HealthStatus: "Progressing"},
+		{ApplicationDependency: dependency("infra", "ingress")},
+	}
+
+	buf := &bytes.Buffer{}
+	w := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+	printDependencyTreeView(w, app, dependencies)
+	require.NoError(t, w.Flush())
+	assert.Equal(t, `DEPENDENCY         SYNC       HEALTH
+argocd/backend     Synced     Healthy
+├─argocd/database  OutOfSync  Progressing
+└─argocd/frontend  Unknown    Missing (cycle)
+infra/ingress      Unknown    Missing
+`, buf.String())
+}
+
 func TestPrintPrefix(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -54,6 +54,20 @@ type AppOptions struct {
 	helmNamespace                   string
 	helmKubeVersion                 string
 	helmApiVersions                 []string //nolint:revive //FIXME(var-naming)
+	helmPostRendererPlugin          string
+	helmPostRendererKustomizePath   string
+	helmfileEnvironment             string
+	helmfileSelectors               []string
+	helmfileStateValuesFiles        []string
+	helmfileStateValues             []string
+	helmfileNamespace               string
+	helmfileKubeVersion             string
+	helmfileAPIVersions             []string
+	helmfileSkipTests               bool
+	cuePackage                      string
+	cueExpression                   string
+	cueTags                         []string
+	cueValuesFiles                  []string
 	project                         string
 	syncPolicy                      string
 	syncOptions                     []string
@@ -91,6 +105,13 @@ type AppOptions struct {
 	retryBackoffMaxDuration         time.Duration
 	retryBackoffFactor              int64
 	retryRefresh                    bool
+	autoRollbackOnSyncFailure       bool
+	autoRollbackDegradedTimeout     time.Duration
+	syncSchedule                    string
+	syncScheduleTimeZone            string
+	syncSchedulePrune               bool
+	syncScheduleHardRefresh         bool
+	dependsOn                       []string
 	ref                             string
 	SourceName                      string
 	drySourceRepo                   string
@@ -133,6 +154,20 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringVar(&opts.helmNamespace, "helm-namespace", "", "Helm namespace to use when running helm template. If not set, use app.spec.destination.namespace")
 	command.Flags().StringVar(&opts.helmKubeVersion, "helm-kube-version", "", "Helm kube-version to use when running helm template. If not set, use the kube version from the destination cluster")
 	command.Flags().StringArrayVar(&opts.helmApiVersions, "helm-api-versions", []string{}, "Helm api-versions (in format [group/]version/kind) to use when running helm template (Can be repeated to set several values: --helm-api-versions traefik.io/v1alpha1/TLSOption --helm-api-versions v1/Service). If not set, use the api-versions from the destination cluster")
+	command.Flags().StringVar(&opts.helmPostRendererPlugin, "helm-post-renderer-plugin", "", "Name of the config management plugin post-rendering the manifests rendered by Helm")
+	command.Flags().StringVar(&opts.helmPostRendererKustomizePath, "helm-post-renderer-kustomize-path", "", "Path of the Kustomize overlay post-rendering the manifests rendered by Helm, relative to the chart directory or starting with $<ref>/")
+	command.Flags().StringVar(&opts.helmfileEnvironment, "helmfile-environment", "", "Helmfile environment to render the releases of")
+	command.Flags().StringArrayVar(&opts.helmfileSelectors, "helmfile-selector", []string{}, "Helmfile label selector of the releases to render (can be repeated: --helmfile-selector tier=frontend --helmfile-selector tier=backend)")
+	command.Flags().StringArrayVar(&opts.helmfileStateValuesFiles, "helmfile-state-values-file", []string{}, "Helmfile state values file(s) to use")
+	command.Flags().StringArrayVar(&opts.helmfileStateValues, "helmfile-state-value", []string{}, "Helmfile state values on the command line (can be repeated to set several values: --helmfile-state-value key1=val1 --helmfile-state-value key2=val2)")
+	command.Flags().StringVar(&opts.helmfileNamespace, "helmfile-namespace", "", "Namespace of the helmfile releases which do not set one. If not set, use app.spec.destination.namespace")
+	command.Flags().StringVar(&opts.helmfileKubeVersion, "helmfile-kube-version", "", "Helm kube-version to use when running helmfile template. If not set, use the kube version from the destination cluster")
+	command.Flags().StringArrayVar(&opts.helmfileAPIVersions, "helmfile-api-versions", []string{}, "Helm api-versions (in format [group/]version/kind) to use when running helmfile template (Can be repeated to set several values: --helmfile-api-versions traefik.io/v1alpha1/TLSOption --helmfile-api-versions v1/Service). If not set, use the api-versions from the destination cluster")
+	command.Flags().BoolVar(&opts.helmfileSkipTests, "helmfile-skip-tests", false, "Skip helm test manifests installation step of the helmfile releases")
+	command.Flags().StringVar(&opts.cuePackage, "cue-package", "", "CUE package to export, relative to the application path (e.g. ./deploy:prod)")
+	command.Flags().StringVar(&opts.cueExpression, "cue-expression", "", "CUE expression selecting the Kubernetes objects to export")
+	command.Flags().StringArrayVar(&opts.cueTags, "cue-tag", []string{}, "CUE tag values injected into the package (can be repeated to set several values: --cue-tag env=prod --cue-tag replicas=3)")
+	command.Flags().StringArrayVar(&opts.cueValuesFiles, "cue-values-file", []string{}, "YAML or JSON file(s) unified with the CUE package")
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
 	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: manual (aliases of manual: none), automated (aliases of automated: auto, automatic))")
 	command.Flags().StringArrayVar(&opts.syncOptions, "sync-option", []string{}, "Add or remove a sync option, e.g add `Prune=false`. Remove using `!` prefix, e.g. `!Prune=false`")
@@ -170,6 +205,13 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().DurationVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max sync retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
 	command.Flags().BoolVar(&opts.retryRefresh, "sync-retry-refresh", false, "Indicates if the latest revision should be used on retry instead of the initial one")
+	command.Flags().BoolVar(&opts.autoRollbackOnSyncFailure, "auto-rollback-on-sync-failure", false, "Automatically roll back to the last healthy revision when a sync fails")
+	command.Flags().DurationVar(&opts.autoRollbackDegradedTimeout, "auto-rollback-degraded-timeout", 0, "Automatically roll back to the last healthy revision when the application stays Degraded for longer than this duration (e.g. 10m). Set to 0 to disable")
+	command.Flags().StringVar(&opts.syncSchedule, "sync-schedule", "", "Cron schedule of the times a sync of the application is started (e.g. \"0 2 * * *\"). Set to an empty string to disable")
+	command.Flags().StringVar(&opts.syncScheduleTimeZone, "sync-schedule-timezone", "", "Time zone of the sync schedule (e.g. Europe/Berlin). Defaults to UTC")
+	command.Flags().BoolVar(&opts.syncSchedulePrune, "sync-schedule-prune", false, "Prune resources during scheduled syncs")
+	command.Flags().BoolVar(&opts.syncScheduleHardRefresh, "sync-schedule-hard-refresh", false, "Hard refresh the application before scheduled syncs")
+	command.Flags().StringArrayVar(&opts.dependsOn, "depends-on", []string{}, "Application which must be synced and healthy before the application is synced, as NAME or NAMESPACE/NAME. This option may be specified repeatedly")
 	command.Flags().StringVar(&opts.ref, "ref", "", "Ref is reference to another source within sources field")
 	command.Flags().StringVar(&opts.SourceName, "source-name", "", "Name of the source from the list of sources of the app.")
 }
@@ -282,8 +324,59 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 				spec.SyncPolicy.Retry = &argoappv1.RetryStrategy{}
 			}
 			spec.SyncPolicy.Retry.Refresh = appOpts.retryRefresh
+		case "auto-rollback-on-sync-failure":
+			if spec.SyncPolicy == nil {
+				spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			if spec.SyncPolicy.AutoRollback == nil {
+				spec.SyncPolicy.AutoRollback = &argoappv1.AutoRollbackPolicy{}
+			}
+			spec.SyncPolicy.AutoRollback.OnSyncFailure = appOpts.autoRollbackOnSyncFailure
+		case "auto-rollback-degraded-timeout":
+			if spec.SyncPolicy == nil {
+				spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			if spec.SyncPolicy.AutoRollback == nil {
+				spec.SyncPolicy.AutoRollback = &argoappv1.AutoRollbackPolicy{}
+			}
+			spec.SyncPolicy.AutoRollback.DegradedTimeout = ""
+			if appOpts.autoRollbackDegradedTimeout > 0 {
+				spec.SyncPolicy.AutoRollback.DegradedTimeout = appOpts.autoRollbackDegradedTimeout.String()
+			}
+		case "sync-schedule":
+			setSyncSchedule(spec, func(schedule *argoappv1.SyncSchedule) { schedule.Schedule = appOpts.syncSchedule })
+		case "sync-schedule-timezone":
+			setSyncSchedule(spec, func(schedule *argoappv1.SyncSchedule) { schedule.TimeZone = appOpts.syncScheduleTimeZone })
+		case "sync-schedule-prune":
+			setSyncSchedule(spec, func(schedule *argoappv1.SyncSchedule) { schedule.Prune = appOpts.syncSchedulePrune })
+		case "sync-schedule-hard-refresh":
+			setSyncSchedule(spec, func(schedule *argoappv1.SyncSchedule) { schedule.HardRefresh = appOpts.syncScheduleHardRefresh })
+		case "depends-on":
+			spec.DependsOn = nil
+			for _, dependency := range appOpts.dependsOn {
+				if dependency == "" {
+					continue
+				}
+				namespace, name, found := strings.Cut(dependency, "/")
+				if !found {
+					namespace, name = "", dependency
+				}
+				spec.DependsOn = append(spec.DependsOn, argoappv1.ApplicationDependency{Name: name, Namespace: namespace})
+			}
 		}
 	})
+	if spec.SyncPolicy != nil && spec.SyncPolicy.AutoRollback != nil && *spec.SyncPolicy.AutoRollback == (argoappv1.AutoRollbackPolicy{}) {
+		spec.SyncPolicy.AutoRollback = nil
+		if spec.SyncPolicy.IsZero() {
+			spec.SyncPolicy = nil
+		}
+	}
+	if spec.SyncPolicy != nil && spec.SyncPolicy.SyncSchedule != nil && spec.SyncPolicy.SyncSchedule.Schedule == "" {
+		spec.SyncPolicy.SyncSchedule = nil
+		if spec.SyncPolicy.IsZero() {
+			spec.SyncPolicy = nil
+		}
+	}
 
 	if flags.Changed("auto-prune") || flags.Changed("self-heal") || flags.Changed("allow-empty") {
 		if spec.SyncPolicy == nil {
@@ -325,6 +418,16 @@ type kustomizeOpts struct {
 	ignoreMissingComponents bool
 }
 
+func setSyncSchedule(spec *argoappv1.ApplicationSpec, update func(schedule *argoappv1.SyncSchedule)) {
+	if spec.SyncPolicy == nil {
+		spec.SyncPolicy = &argoappv1.SyncPolicy{}
+	}
+	if spec.SyncPolicy.SyncSchedule == nil {
+		spec.SyncPolicy.SyncSchedule = &argoappv1.SyncSchedule{}
+	}
+	update(spec.SyncPolicy.SyncSchedule)
+}
+
 func setKustomizeOpt(src *argoappv1.ApplicationSource, opts kustomizeOpts) {
 	if src.Kustomize == nil {
 		src.Kustomize = &argoappv1.ApplicationSourceKustomize{}
@@ -414,6 +517,7 @@ type helmOpts struct {
 	namespace               string
 	kubeVersion             string
 	apiVersions             []string
+	postRenderer            *argoappv1.HelmPostRenderer
 }
 
 func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
@@ -459,6 +563,9 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 	if len(opts.apiVersions) > 0 {
 		src.Helm.APIVersions = opts.apiVersions
 	}
+	if opts.postRenderer != nil {
+		src.Helm.PostRenderer = opts.postRenderer
+	}
 	for _, text := range opts.helmSets {
 		p, err := argoappv1.NewHelmParameter(text, false)
 		if err != nil {
@@ -485,6 +592,86 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 	}
 }
 
+type helmfileOpts struct {
+	environment      string
+	selectors        []string
+	stateValuesFiles []string
+	stateValues      []string
+	namespace        string
+	kubeVersion      string
+	apiVersions      []string
+	skipTests        bool
+}
+
+func setHelmfileOpt(src *argoappv1.ApplicationSource, opts helmfileOpts) {
+	if src.Helmfile == nil {
+		src.Helmfile = &argoappv1.ApplicationSourceHelmfile{}
+	}
+	if opts.environment != "" {
+		src.Helmfile.Environment = opts.environment
+	}
+	if len(opts.selectors) > 0 {
+		src.Helmfile.Selectors = opts.selectors
+	}
+	if len(opts.stateValuesFiles) > 0 {
+		src.Helmfile.StateValuesFiles = opts.stateValuesFiles
+	}
+	for _, text := range opts.stateValues {
+		parts := strings.SplitN(text, "=", 2)
+		if len(parts) != 2 {
+			log.Fatalf("expected helmfile state value of the form key=value but received: %s", text)
+		}
+		src.Helmfile.SetStateValue(parts[0], parts[1])
+	}
+	if opts.namespace != "" {
+		src.Helmfile.Namespace = opts.namespace
+	}
+	if opts.kubeVersion != "" {
+		src.Helmfile.KubeVersion = opts.kubeVersion
+	}
+	if len(opts.apiVersions) > 0 {
+		src.Helmfile.APIVersions = opts.apiVersions
+	}
+	if opts.skipTests {
+		src.Helmfile.SkipTests = opts.skipTests
+	}
+	if src.Helmfile.IsZero() {
+		src.Helmfile = nil
+	}
+}
+
+type cueOpts struct {
+	pkg         string
+	expression  string
+	tags        []string
+	valuesFiles []string
+}
+
+func setCueOpt(src *argoappv1.ApplicationSource, opts cueOpts) {
+	if src.Cue == nil {
+		src.Cue = &argoappv1.ApplicationSourceCue{}
+	}
+	if opts.pkg != "" {
+		src.Cue.Package = opts.pkg
+	}
+	if opts.expression != "" {
+		src.Cue.Expression = opts.expression
+	}
+	for _, text := range opts.tags {
+		tag, err := argoappv1.NewCueTag(text)
+		if err != nil {
+			log.Fatal(err)
+		}
+		src.Cue.AddTag(*tag)
+	}
+	if len(opts.valuesFiles) > 0 {
+		src.Cue.ValuesFiles = opts.valuesFiles
+	}
+	if src.Cue.IsZero() {
+		src.Cue = nil
+	}
+}
+
 func setJsonnetOpt(src *argoappv1.ApplicationSource, tlaParameters []string, code bool) {
 	if src.Directory == nil {
 		src.Directory = &argoappv1.ApplicationSourceDirectory{}
@@ -725,6 +912,34 @@ func ConstructSource(source *argoappv1.ApplicationSource, appOpts AppOptions, fl
 			setHelmOpt(source, helmOpts{kubeVersion: appOpts.helmKubeVersion})
 		case "helm-api-versions":
 			setHelmOpt(source, helmOpts{apiVersions: appOpts.helmApiVersions})
+		case "helm-post-renderer-plugin":
+			setHelmOpt(source, helmOpts{postRenderer: &argoappv1.HelmPostRenderer{Plugin: appOpts.helmPostRendererPlugin}})
+		case "helm-post-renderer-kustomize-path":
+			setHelmOpt(source, helmOpts{postRenderer: &argoappv1.HelmPostRenderer{KustomizePath: appOpts.helmPostRendererKustomizePath}})
+		case "helmfile-environment":
+			setHelmfileOpt(source, helmfileOpts{environment: appOpts.helmfileEnvironment})
+		case "helmfile-selector":
+			setHelmfileOpt(source, helmfileOpts{selectors: appOpts.helmfileSelectors})
+		case "helmfile-state-values-file":
+			setHelmfileOpt(source, helmfileOpts{stateValuesFiles: appOpts.helmfileStateValuesFiles})
+		case "helmfile-state-value":
+			setHelmfileOpt(source, helmfileOpts{stateValues: appOpts.helmfileStateValues})
+		case "helmfile-namespace":
+			setHelmfileOpt(source, helmfileOpts{namespace: appOpts.helmfileNamespace})
+		case "helmfile-kube-version":
+			setHelmfileOpt(source, helmfileOpts{kubeVersion: appOpts.helmfileKubeVersion})
+		case "helmfile-api-versions":
+			setHelmfileOpt(source, helmfileOpts{apiVersions: appOpts.helmfileAPIVersions})
+		case "helmfile-skip-tests":
+			setHelmfileOpt(source, helmfileOpts{skipTests: appOpts.helmfileSkipTests})
+		case "cue-package":
+			setCueOpt(source, cueOpts{pkg: appOpts.cuePackage})
+		case "cue-expression":
+			setCueOpt(source, cueOpts{expression: appOpts.cueExpression})
+		case "cue-tag":
+			setCueOpt(source, cueOpts{tags: appOpts.cueTags})
+		case "cue-values-file":
+			setCueOpt(source, cueOpts{valuesFiles: appOpts.cueValuesFiles})
 		case "directory-recurse":
 			if source.Directory != nil {
 				source.Directory.Recurse = appOpts.directoryRecurse
@@ -92,6 +92,82 @@ func Test_setHelmOpt(t *testing.T) {
 	})
 }
 
+func Test_setHelmfileOpt(t *testing.T) {
+	t.Run("Zero", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmfileOpt(&src, helmfileOpts{})
+		assert.Nil(t, src.Helmfile)
+	})
+	t.Run("Environment", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmfileOpt(&src, helmfileOpts{environment: "production"})
+		assert.Equal(t, "production", src.Helmfile.Environment)
+	})
+	t.Run("Selectors", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmfileOpt(&src, helmfileOpts{selectors: []string{"tier=frontend"}})
+		assert.Equal(t, []string{"tier=frontend"}, src.Helmfile.Selectors)
+	})
+	t.Run("StateValuesFiles", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmfileOpt(&src, helmfileOpts{stateValuesFiles: []string{"values.yaml"}})
+		assert.Equal(t, []string{"values.yaml"}, src.Helmfile.StateValuesFiles)
+	})
+	t.Run("StateValues", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{Helmfile: &v1alpha1.ApplicationSourceHelmfile{StateValues: map[string]string{"replicas": "1", "image": "nginx"}}}
+		setHelmfileOpt(&src, helmfileOpts{stateValues: []string{"replicas=3", "domain=example.com=1"}})
+		assert.Equal(t, map[string]string{"replicas": "3", "image": "nginx", "domain": "example.com=1"}, src.Helmfile.StateValues)
+	})
+	t.Run("Namespace", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmfileOpt(&src, helmfileOpts{namespace: "custom-namespace"})
+		assert.Equal(t, "custom-namespace", src.Helmfile.Namespace)
+	})
+	t.Run("KubeVersion", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmfileOpt(&src, helmfileOpts{kubeVersion: "v1.16.0"})
+		assert.Equal(t, "v1.16.0", src.Helmfile.KubeVersion)
+	})
+	t.Run("APIVersions", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmfileOpt(&src, helmfileOpts{apiVersions: []string{"v1", "v2"}})
+		assert.Equal(t, []string{"v1", "v2"}, src.Helmfile.APIVersions)
+	})
+	t.Run("SkipTests", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmfileOpt(&src, helmfileOpts{skipTests: true})
+		assert.True(t, src.Helmfile.SkipTests)
+	})
+}
+
+func Test_setCueOpt(t *testing.T) {
+	t.Run("Zero", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setCueOpt(&src, cueOpts{})
+		assert.Nil(t, src.Cue)
+	})
+	t.Run("Package", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setCueOpt(&src, cueOpts{pkg: "./deploy:prod"})
+		assert.Equal(t, "./deploy:prod", src.Cue.Package)
+	})
+	t.Run("Expression", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setCueOpt(&src, cueOpts{expression: "objects"})
+		assert.Equal(t, "objects", src.Cue.Expression)
+	})
+	t.Run("Tags", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{Cue: &v1alpha1.ApplicationSourceCue{Tags: []v1alpha1.CueTag{{Name: "env", Value: "dev"}, {Name: "image", Value: "nginx"}}}}
+		setCueOpt(&src, cueOpts{tags: []string{"env=prod", "selector=app=guestbook"}})
+		assert.Equal(t, []v1alpha1.CueTag{{Name: "env", Value: "prod"}, {Name: "image", Value: "nginx"}, {Name: "selector", Value: "app=guestbook"}}, src.Cue.Tags)
+	})
+	t.Run("ValuesFiles", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setCueOpt(&src, cueOpts{valuesFiles: []string{"values.yaml"}})
+		assert.Equal(t, []string{"values.yaml"}, src.Cue.ValuesFiles)
+	})
+}
+
 func Test_setKustomizeOpt(t *testing.T) {
 	t.Run("No kustomize", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
@@ -322,6 +398,32 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-retry-refresh", "false"))
 		assert.False(t, f.spec.SyncPolicy.Retry.Refresh)
 	})
+	t.Run("AutoRollback", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("auto-rollback-on-sync-failure", "true"))
+		assert.True(t, f.spec.SyncPolicy.AutoRollback.OnSyncFailure)
+
+		require.NoError(t, f.SetFlag("auto-rollback-degraded-timeout", "10m"))
+		assert.Equal(t, "10m0s", f.spec.SyncPolicy.AutoRollback.DegradedTimeout)
+
+		require.NoError(t, f.SetFlag("auto-rollback-on-sync-failure", "false"))
+		require.NoError(t, f.SetFlag("auto-rollback-degraded-timeout", "0"))
+		assert.Nil(t, f.spec.SyncPolicy.AutoRollback)
+	})
+	t.Run("DependsOn", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("depends-on", "backend"))
+		require.NoError(t, f.SetFlag("depends-on", "infra/ingress"))
+		assert.Equal(t, []v1alpha1.ApplicationDependency{{Name: "backend"}, {Name: "ingress", Namespace: "infra"}}, f.spec.DependsOn)
+	})
+	t.Run("SyncSchedule", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("sync-schedule", "0 2 * * *"))
+		require.NoError(t, f.SetFlag("sync-schedule-timezone", "Europe/Berlin"))
+		require.NoError(t, f.SetFlag("sync-schedule-prune", "true"))
+		require.NoError(t, f.SetFlag("sync-schedule-hard-refresh", "true"))
+		assert.Equal(t, &v1alpha1.SyncSchedule{Schedule: "0 2 * * *", TimeZone: "Europe/Berlin", Prune: true, HardRefresh: true}, f.spec.SyncPolicy.SyncSchedule)
+
+		require.NoError(t, f.SetFlag("sync-schedule", ""))
+		assert.Nil(t, f.spec.SyncPolicy.SyncSchedule)
+	})
 	t.Run("Kustomize", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-deployment=2"))
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-statefulset=4"))
@@ -353,6 +455,12 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("helm-api-versions", "v2"))
 		assert.Equal(t, []string{"v1", "v2"}, f.spec.Source.Helm.APIVersions)
 	})
+	t.Run("Helm Post Renderer", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("helm-post-renderer-kustomize-path", "overlays/patches"))
+		assert.Equal(t, &v1alpha1.HelmPostRenderer{KustomizePath: "overlays/patches"}, f.spec.Source.Helm.PostRenderer)
+		require.NoError(t, f.SetFlag("helm-post-renderer-plugin", "patcher"))
+		assert.Equal(t, &v1alpha1.HelmPostRenderer{Plugin: "patcher"}, f.spec.Source.Helm.PostRenderer)
+	})
 	t.Run("source hydrator", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("dry-source-repo", "https://github.com/argoproj/argocd-example-apps"))
 		assert.Equal(t, "https://github.com/argoproj/argocd-example-apps", f.spec.SourceHydrator.DrySource.RepoURL)
@@ -28,6 +28,8 @@ type RepoOptions struct {
 	ForceHttpBasicAuth             bool //nolint:revive //FIXME(var-naming)
 	UseAzureWorkloadIdentity       bool
 	Depth                          int64
+	PartialClone                   bool
+	SparseCheckout                 bool
 }
 
 func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
@@ -55,4 +57,6 @@ func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
 	command.Flags().BoolVar(&opts.UseAzureWorkloadIdentity, "use-azure-workload-identity", false, "whether to use azure workload identity for authentication")
 	command.Flags().BoolVar(&opts.InsecureOCIForceHTTP, "insecure-oci-force-http", false, "Use http when accessing an OCI repository")
 	command.Flags().Int64Var(&opts.Depth, "depth", 0, "Specify a custom depth for git clone operations. Unless specified, a full clone is performed using the depth of 0")
+	command.Flags().BoolVar(&opts.PartialClone, "partial-clone", false, "Fetch the git repository as a partial clone, which only downloads the contents of files when they are checked out")
+	command.Flags().BoolVar(&opts.SparseCheckout, "sparse-checkout", false, "Only check out the paths of the application and of its manifest-generate-paths annotation when generating manifests from the git repository. Files outside of these paths, e.g. Kustomize bases in parent directories, are not checked out unless listed in the annotation")
 }
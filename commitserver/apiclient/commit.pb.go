@@ -47,7 +47,13 @@ type CommitHydratedManifestsRequest struct {
 	// AuthorName is the author name to use for the commit. If empty, defaults to "Argo CD".
 	AuthorName string `protobuf:"bytes,8,opt,name=authorName,proto3" json:"authorName,omitempty"`
 	// AuthorEmail is the author email to use for the commit. If empty, defaults to "argo-cd@example.com".
-	AuthorEmail          string   `protobuf:"bytes,9,opt,name=authorEmail,proto3" json:"authorEmail,omitempty"`
+	AuthorEmail string `protobuf:"bytes,9,opt,name=authorEmail,proto3" json:"authorEmail,omitempty"`
+	// PullRequest configures the pull request to open or update from the target branch against the sync branch after
+	// pushing a commit. If nil, no pull request is opened.
+	PullRequest *v1alpha1.HydratorPullRequest `protobuf:"bytes,10,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	// DryRepoURL is the URL of the repository of the dry source. If empty, defaults to the URL of the repo, which is
+	// where hydrated manifests are written to unless they are pushed to an OCI repository.
+	DryRepoURL           string   `protobuf:"bytes,11,opt,name=dryRepoURL,proto3" json:"dryRepoURL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -149,6 +155,20 @@ func (m *CommitHydratedManifestsRequest) GetAuthorEmail() string {
 	return ""
 }
 
+func (m *CommitHydratedManifestsRequest) GetPullRequest() *v1alpha1.HydratorPullRequest {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsRequest) GetDryRepoURL() string {
+	if m != nil {
+		return m.DryRepoURL
+	}
+	return ""
+}
+
 // PathDetails holds information about hydrated manifests to be written to a particular path in the hydrated manifests
 // commit.
 type PathDetails struct {
@@ -157,7 +177,11 @@ type PathDetails struct {
 	// Manifests contains the manifests to write to the path.
 	Manifests []*HydratedManifestDetails `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
 	// Commands contains the commands executed when hydrating the manifests.
-	Commands             []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	Commands []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Layout configures the files the manifests are written to. The manifests are written to manifest.yaml if not set.
+	Layout *v1alpha1.HydratedLayout `protobuf:"bytes,4,opt,name=layout,proto3" json:"layout,omitempty"`
+	// HelmValues is the YAML of the values the Helm chart of the dry source was templated with, if any.
+	HelmValues           string   `protobuf:"bytes,5,opt,name=helmValues,proto3" json:"helmValues,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -217,6 +241,20 @@ func (m *PathDetails) GetCommands() []string {
 	return nil
 }
 
+func (m *PathDetails) GetLayout() *v1alpha1.HydratedLayout {
+	if m != nil {
+		return m.Layout
+	}
+	return nil
+}
+
+func (m *PathDetails) GetHelmValues() string {
+	if m != nil {
+		return m.HelmValues
+	}
+	return ""
+}
+
 // ManifestDetails contains the hydrated manifests.
 type HydratedManifestDetails struct {
 	// ManifestJSON is the hydrated manifest as JSON.
@@ -268,8 +306,11 @@ func (m *HydratedManifestDetails) GetManifestJSON() string {
 
 // ManifestsResponse is the response to the ManifestsRequest.
 type CommitHydratedManifestsResponse struct {
-	// HydratedSha is the commit SHA of the hydrated manifests commit.
-	HydratedSha          string   `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	// HydratedSha is the commit SHA of the hydrated manifests commit, or the digest of the hydrated manifests artifact if
+	// the repo is an OCI repository.
+	HydratedSha string `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	// PullRequestURL is the URL of the pull request opened or updated for the hydrated manifests commit.
+	PullRequestURL       string   `protobuf:"bytes,2,opt,name=pullRequestURL,proto3" json:"pullRequestURL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -315,49 +356,231 @@ func (m *CommitHydratedManifestsResponse) GetHydratedSha() string {
 	return ""
 }
 
+func (m *CommitHydratedManifestsResponse) GetPullRequestURL() string {
+	if m != nil {
+		return m.PullRequestURL
+	}
+	return ""
+}
+
+// PromoteHydratedManifestsRequest is the request to promote hydrated manifests from a hydrated commit to a branch.
+type PromoteHydratedManifestsRequest struct {
+	// Repo contains repository information including, at minimum, the URL of the repository. Generally it will contain
+	// repo credentials.
+	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// SourceSha is the SHA of the hydrated commit holding the manifests to promote.
+	SourceSha string `protobuf:"bytes,2,opt,name=sourceSha,proto3" json:"sourceSha,omitempty"`
+	// TargetBranch is the branch the manifests are promoted to.
+	TargetBranch string `protobuf:"bytes,3,opt,name=targetBranch,proto3" json:"targetBranch,omitempty"`
+	// DrySha is the commit SHA of the dry source of the hydrated manifests.
+	DrySha string `protobuf:"bytes,4,opt,name=drySha,proto3" json:"drySha,omitempty"`
+	// Paths are the paths of the hydrated manifests to copy from the source commit to the target branch.
+	Paths []string `protobuf:"bytes,5,rep,name=paths,proto3" json:"paths,omitempty"`
+	// CommitMessage is the commit message to use when committing changes.
+	CommitMessage string `protobuf:"bytes,6,opt,name=commitMessage,proto3" json:"commitMessage,omitempty"`
+	// AuthorName is the author name to use for the commit. If empty, defaults to "Argo CD".
+	AuthorName string `protobuf:"bytes,7,opt,name=authorName,proto3" json:"authorName,omitempty"`
+	// AuthorEmail is the author email to use for the commit. If empty, defaults to "argo-cd@example.com".
+	AuthorEmail          string   `protobuf:"bytes,8,opt,name=authorEmail,proto3" json:"authorEmail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PromoteHydratedManifestsRequest) Reset()         { *m = PromoteHydratedManifestsRequest{} }
+func (m *PromoteHydratedManifestsRequest) String() string { return proto.CompactTextString(m) }
+func (*PromoteHydratedManifestsRequest) ProtoMessage()    {}
+func (*PromoteHydratedManifestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{4}
+}
+func (m *PromoteHydratedManifestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoteHydratedManifestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromoteHydratedManifestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PromoteHydratedManifestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteHydratedManifestsRequest.Merge(m, src)
+}
+func (m *PromoteHydratedManifestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromoteHydratedManifestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteHydratedManifestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoteHydratedManifestsRequest proto.InternalMessageInfo
+
+func (m *PromoteHydratedManifestsRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *PromoteHydratedManifestsRequest) GetSourceSha() string {
+	if m != nil {
+		return m.SourceSha
+	}
+	return ""
+}
+
+func (m *PromoteHydratedManifestsRequest) GetTargetBranch() string {
+	if m != nil {
+		return m.TargetBranch
+	}
+	return ""
+}
+
+func (m *PromoteHydratedManifestsRequest) GetDrySha() string {
+	if m != nil {
+		return m.DrySha
+	}
+	return ""
+}
+
+func (m *PromoteHydratedManifestsRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *PromoteHydratedManifestsRequest) GetCommitMessage() string {
+	if m != nil {
+		return m.CommitMessage
+	}
+	return ""
+}
+
+func (m *PromoteHydratedManifestsRequest) GetAuthorName() string {
+	if m != nil {
+		return m.AuthorName
+	}
+	return ""
+}
+
+func (m *PromoteHydratedManifestsRequest) GetAuthorEmail() string {
+	if m != nil {
+		return m.AuthorEmail
+	}
+	return ""
+}
+
+// PromoteHydratedManifestsResponse is the response to the PromoteHydratedManifests request.
+type PromoteHydratedManifestsResponse struct {
+	// HydratedSha is the SHA of the commit of the target branch holding the promoted manifests.
+	HydratedSha          string   `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PromoteHydratedManifestsResponse) Reset()         { *m = PromoteHydratedManifestsResponse{} }
+func (m *PromoteHydratedManifestsResponse) String() string { return proto.CompactTextString(m) }
+func (*PromoteHydratedManifestsResponse) ProtoMessage()    {}
+func (*PromoteHydratedManifestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{5}
+}
+func (m *PromoteHydratedManifestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoteHydratedManifestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromoteHydratedManifestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PromoteHydratedManifestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteHydratedManifestsResponse.Merge(m, src)
+}
+func (m *PromoteHydratedManifestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromoteHydratedManifestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteHydratedManifestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoteHydratedManifestsResponse proto.InternalMessageInfo
+
+func (m *PromoteHydratedManifestsResponse) GetHydratedSha() string {
+	if m != nil {
+		return m.HydratedSha
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CommitHydratedManifestsRequest)(nil), "CommitHydratedManifestsRequest")
 	proto.RegisterType((*PathDetails)(nil), "PathDetails")
 	proto.RegisterType((*HydratedManifestDetails)(nil), "HydratedManifestDetails")
 	proto.RegisterType((*CommitHydratedManifestsResponse)(nil), "CommitHydratedManifestsResponse")
+	proto.RegisterType((*PromoteHydratedManifestsRequest)(nil), "PromoteHydratedManifestsRequest")
+	proto.RegisterType((*PromoteHydratedManifestsResponse)(nil), "PromoteHydratedManifestsResponse")
 }
 
 func init() { proto.RegisterFile("commitserver/commit/commit.proto", fileDescriptor_cf3a3abbc35e3069) }
 
 var fileDescriptor_cf3a3abbc35e3069 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x56, 0xd6, 0xae, 0xac, 0xee, 0x76, 0xc0, 0x07, 0x66, 0xf5, 0x90, 0x45, 0x11, 0x87, 0x5e,
-	0x70, 0xb4, 0x56, 0x70, 0xe3, 0xb2, 0x82, 0x34, 0x21, 0x56, 0x50, 0x7a, 0x43, 0x93, 0xd0, 0x5b,
-	0x62, 0x12, 0xb3, 0x24, 0x36, 0xb6, 0x1b, 0x29, 0x12, 0x3f, 0x90, 0x23, 0x3f, 0x01, 0x55, 0xfc,
-	0x10, 0x14, 0x27, 0xa1, 0x29, 0xa8, 0xec, 0xc0, 0x29, 0x7e, 0xdf, 0x7b, 0xf9, 0x3e, 0xbf, 0xef,
-	0xf9, 0x21, 0x2f, 0x12, 0x79, 0xce, 0x8d, 0x66, 0xaa, 0x64, 0x2a, 0x68, 0x82, 0xf6, 0x43, 0xa5,
-	0x12, 0x46, 0x4c, 0xdf, 0x26, 0xdc, 0xa4, 0x9b, 0x3b, 0x1a, 0x89, 0x3c, 0x00, 0x95, 0x08, 0xa9,
-	0xc4, 0x67, 0x7b, 0x78, 0x16, 0xc5, 0x41, 0xb9, 0x08, 0xe4, 0x7d, 0x12, 0x80, 0xe4, 0x3a, 0x00,
-	0x29, 0x33, 0x1e, 0x81, 0xe1, 0xa2, 0x08, 0xca, 0x4b, 0xc8, 0x64, 0x0a, 0x97, 0x41, 0xc2, 0x0a,
-	0xa6, 0xc0, 0xb0, 0xb8, 0x61, 0xf3, 0x7f, 0x0e, 0x90, 0xbb, 0xb4, 0xf4, 0xd7, 0x55, 0x6c, 0x13,
-	0x37, 0x50, 0xf0, 0x4f, 0x4c, 0x1b, 0x1d, 0xb2, 0x2f, 0x1b, 0xa6, 0x0d, 0xbe, 0x45, 0x43, 0xc5,
-	0xa4, 0x20, 0x8e, 0xe7, 0xcc, 0x26, 0xf3, 0x6b, 0xba, 0xd3, 0xa7, 0x9d, 0xbe, 0x3d, 0x7c, 0x8c,
-	0x62, 0x5a, 0x2e, 0xa8, 0xbc, 0x4f, 0x68, 0xad, 0x4f, 0x7b, 0xfa, 0xb4, 0xd3, 0xa7, 0x21, 0x93,
-	0x42, 0x73, 0x23, 0x54, 0x15, 0x5a, 0x56, 0xec, 0x22, 0xa4, 0xab, 0x22, 0xba, 0x52, 0x50, 0x44,
-	0x29, 0x39, 0xf2, 0x9c, 0xd9, 0x38, 0xec, 0x21, 0xd8, 0x47, 0xa7, 0x06, 0x54, 0xc2, 0x4c, 0x5b,
-	0x31, 0xb0, 0x15, 0x7b, 0x18, 0x7e, 0x82, 0x46, 0xb1, 0xaa, 0xd6, 0x29, 0x90, 0xa1, 0xcd, 0xb6,
-	0x11, 0x7e, 0x8a, 0xce, 0x1a, 0xeb, 0x6e, 0x98, 0xd6, 0x90, 0x30, 0x72, 0x6c, 0xd3, 0xfb, 0x20,
-	0xf6, 0xd1, 0xb1, 0x04, 0x93, 0x6a, 0x32, 0xf2, 0x06, 0xb3, 0xc9, 0xfc, 0x94, 0xbe, 0x07, 0x93,
-	0xbe, 0x62, 0x06, 0x78, 0xa6, 0xc3, 0x26, 0x85, 0xbf, 0xa2, 0xc7, 0xb1, 0xaa, 0x96, 0xed, 0x7f,
-	0x06, 0x62, 0x30, 0x40, 0x1e, 0x59, 0x43, 0x56, 0xff, 0x6b, 0x48, 0xc9, 0x35, 0x17, 0x45, 0xc7,
-	0x1a, 0xfe, 0x2d, 0x54, 0x7b, 0x04, 0x1b, 0x93, 0x0a, 0xb5, 0x82, 0x9c, 0x91, 0x93, 0xc6, 0xa3,
-	0x1d, 0x82, 0x3d, 0x34, 0x69, 0xa2, 0xd7, 0x39, 0xf0, 0x8c, 0x8c, 0x6d, 0x41, 0x1f, 0xf2, 0x37,
-	0x68, 0xd2, 0xeb, 0x0a, 0x63, 0x34, 0xac, 0xfb, 0xb2, 0x23, 0x1d, 0x87, 0xf6, 0x8c, 0x5f, 0xa0,
-	0x71, 0xde, 0x8d, 0x9e, 0x1c, 0x59, 0x2b, 0x08, 0xfd, 0xf3, 0x51, 0x74, 0xb6, 0xec, 0x4a, 0xf1,
-	0x14, 0x9d, 0xd4, 0x7e, 0x42, 0x11, 0x6b, 0x32, 0xf0, 0x06, 0xb3, 0x71, 0xf8, 0x3b, 0xf6, 0x5f,
-	0xa2, 0xf3, 0x03, 0x0c, 0xf5, 0x5c, 0x3b, 0x8e, 0x37, 0xeb, 0x77, 0xab, 0xf6, 0x2a, 0x7b, 0x98,
-	0xbf, 0x44, 0x17, 0x07, 0xdf, 0xa6, 0x96, 0xa2, 0xd0, 0xb6, 0xf5, 0xb4, 0x4d, 0xd6, 0xf3, 0x6f,
-	0x58, 0xfa, 0xd0, 0x3c, 0x47, 0x67, 0x0d, 0xc9, 0x9a, 0xa9, 0x92, 0x47, 0x0c, 0xdf, 0xa2, 0xf3,
-	0x03, 0xac, 0xf8, 0x82, 0xfe, 0x7b, 0x17, 0xa6, 0x1e, 0x7d, 0xe0, 0x42, 0x57, 0xcb, 0x6f, 0x5b,
-	0xd7, 0xf9, 0xbe, 0x75, 0x9d, 0x1f, 0x5b, 0xd7, 0xf9, 0xf0, 0xfc, 0x81, 0x65, 0xdd, 0xdb, 0x76,
-	0x90, 0x3c, 0xca, 0x38, 0x2b, 0xcc, 0xdd, 0xc8, 0x2e, 0xe7, 0xe2, 0x57, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x58, 0x7e, 0x26, 0x9f, 0x0e, 0x04, 0x00, 0x00,
+	// 679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6b, 0xd4, 0x4e,
+	0x14, 0x27, 0xdd, 0xed, 0xb6, 0x3b, 0x69, 0xbf, 0xf0, 0x1d, 0xc4, 0x0e, 0x8b, 0x6c, 0x63, 0x10,
+	0xd9, 0x8b, 0x13, 0xba, 0x45, 0x6f, 0x5e, 0xda, 0x0a, 0x45, 0xb6, 0xb5, 0xa6, 0xe8, 0x41, 0x0a,
+	0x65, 0x9a, 0x8c, 0xc9, 0xd8, 0x24, 0x33, 0xce, 0x4c, 0x16, 0x02, 0xfe, 0x81, 0x9e, 0xc4, 0x9b,
+	0x57, 0xe9, 0x7f, 0x21, 0x5e, 0x24, 0x93, 0xc4, 0xcd, 0xb6, 0xae, 0x2b, 0x54, 0xf0, 0xb4, 0x99,
+	0xcf, 0x9b, 0x7d, 0x3f, 0x3e, 0xef, 0xf3, 0xde, 0x00, 0x27, 0xe0, 0x69, 0xca, 0xb4, 0xa2, 0x72,
+	0x4a, 0xa5, 0x57, 0x1d, 0xea, 0x1f, 0x2c, 0x24, 0xd7, 0x7c, 0x30, 0x89, 0x98, 0x8e, 0xf3, 0x0b,
+	0x1c, 0xf0, 0xd4, 0x23, 0x32, 0xe2, 0x42, 0xf2, 0x77, 0xe6, 0xe3, 0x51, 0x10, 0x7a, 0xd3, 0x5d,
+	0x4f, 0x5c, 0x46, 0x1e, 0x11, 0x4c, 0x79, 0x44, 0x88, 0x84, 0x05, 0x44, 0x33, 0x9e, 0x79, 0xd3,
+	0x1d, 0x92, 0x88, 0x98, 0xec, 0x78, 0x11, 0xcd, 0xa8, 0x24, 0x9a, 0x86, 0x95, 0x37, 0xf7, 0x5b,
+	0x17, 0x0c, 0xf7, 0x8d, 0xfb, 0xc3, 0x22, 0x34, 0x86, 0x23, 0x92, 0xb1, 0xb7, 0x54, 0x69, 0xe5,
+	0xd3, 0xf7, 0x39, 0x55, 0x1a, 0x9e, 0x81, 0xae, 0xa4, 0x82, 0x23, 0xcb, 0xb1, 0x46, 0xf6, 0xf8,
+	0x10, 0xcf, 0xe2, 0xe3, 0x26, 0xbe, 0xf9, 0x38, 0x0f, 0x42, 0x3c, 0xdd, 0xc5, 0xe2, 0x32, 0xc2,
+	0x65, 0x7c, 0xdc, 0x8a, 0x8f, 0x9b, 0xf8, 0xd8, 0xa7, 0x82, 0x2b, 0xa6, 0xb9, 0x2c, 0x7c, 0xe3,
+	0x15, 0x0e, 0x01, 0x50, 0x45, 0x16, 0xec, 0x49, 0x92, 0x05, 0x31, 0x5a, 0x71, 0xac, 0x51, 0xdf,
+	0x6f, 0x21, 0xd0, 0x05, 0x1b, 0x9a, 0xc8, 0x88, 0xea, 0xfa, 0x46, 0xc7, 0xdc, 0x98, 0xc3, 0xe0,
+	0x5d, 0xd0, 0x0b, 0x65, 0x71, 0x1a, 0x13, 0xd4, 0x35, 0xd6, 0xfa, 0x04, 0x1f, 0x80, 0xcd, 0x8a,
+	0xba, 0x23, 0xaa, 0x14, 0x89, 0x28, 0x5a, 0x35, 0xe6, 0x79, 0x10, 0xba, 0x60, 0x55, 0x10, 0x1d,
+	0x2b, 0xd4, 0x73, 0x3a, 0x23, 0x7b, 0xbc, 0x81, 0x4f, 0x88, 0x8e, 0x0f, 0xa8, 0x26, 0x2c, 0x51,
+	0x7e, 0x65, 0x82, 0x1f, 0xc0, 0xff, 0xa1, 0x2c, 0xf6, 0xeb, 0xff, 0x69, 0x12, 0x12, 0x4d, 0xd0,
+	0x9a, 0x21, 0xe4, 0xf8, 0xb6, 0x84, 0x4c, 0x99, 0x62, 0x3c, 0x6b, 0xbc, 0xfa, 0x37, 0x03, 0x95,
+	0x1c, 0x91, 0x5c, 0xc7, 0x5c, 0x1e, 0x93, 0x94, 0xa2, 0xf5, 0x8a, 0xa3, 0x19, 0x02, 0x1d, 0x60,
+	0x57, 0xa7, 0x67, 0x29, 0x61, 0x09, 0xea, 0x9b, 0x0b, 0x6d, 0x08, 0x2a, 0x60, 0x8b, 0x3c, 0x49,
+	0xea, 0x96, 0x22, 0x60, 0x32, 0x7f, 0x79, 0xbb, 0xcc, 0x2b, 0xc1, 0x70, 0x79, 0x32, 0x73, 0xec,
+	0xb7, 0xa3, 0x94, 0x69, 0x87, 0xb2, 0x28, 0x3b, 0xfe, 0xca, 0x9f, 0x20, 0xbb, 0x4a, 0x7b, 0x86,
+	0xb8, 0xdf, 0x2d, 0x60, 0xb7, 0xb8, 0x86, 0x10, 0x74, 0x4b, 0xb6, 0x8d, 0xd0, 0xfa, 0xbe, 0xf9,
+	0x86, 0x4f, 0x40, 0x3f, 0x6d, 0x04, 0x89, 0x56, 0x4c, 0x83, 0x10, 0xbe, 0x2e, 0xd5, 0xa6, 0x59,
+	0xb3, 0xab, 0x70, 0x00, 0xd6, 0xcb, 0x2e, 0x93, 0x2c, 0x54, 0xa8, 0xe3, 0x74, 0x46, 0x7d, 0xff,
+	0xe7, 0x19, 0x86, 0xa0, 0x97, 0x90, 0x82, 0xe7, 0xda, 0xc8, 0xc5, 0x1e, 0x4f, 0xfe, 0x06, 0x0f,
+	0x34, 0x9c, 0x18, 0x9f, 0x7e, 0xed, 0xbb, 0xac, 0x3e, 0xa6, 0x49, 0xfa, 0x9a, 0x24, 0x39, 0x55,
+	0xb5, 0xf2, 0x5a, 0x88, 0xfb, 0x14, 0x6c, 0x2d, 0xa8, 0xa3, 0xd4, 0x7c, 0x53, 0xc9, 0xf3, 0xd3,
+	0x17, 0xc7, 0x35, 0x21, 0x73, 0x98, 0x7b, 0x09, 0xb6, 0x17, 0xce, 0xad, 0x12, 0x3c, 0x53, 0x46,
+	0x16, 0x71, 0x6d, 0x2c, 0x67, 0xa3, 0xf2, 0xd2, 0x86, 0xe0, 0x43, 0xf0, 0x5f, 0xab, 0x61, 0x65,
+	0x97, 0xaa, 0x01, 0xbc, 0x86, 0xba, 0x5f, 0x56, 0xc0, 0xf6, 0x89, 0xe4, 0x29, 0xd7, 0xf4, 0x1f,
+	0xad, 0x89, 0x7b, 0xa0, 0xaf, 0x78, 0x2e, 0x03, 0x5a, 0x56, 0x52, 0x25, 0x39, 0x03, 0x6e, 0xb5,
+	0x24, 0xee, 0x34, 0xe3, 0xbf, 0x6a, 0x64, 0x52, 0x1d, 0x6e, 0xae, 0x8e, 0xde, 0xaf, 0x56, 0xc7,
+	0xfc, 0x60, 0xae, 0x2d, 0x1b, 0xcc, 0xf5, 0x1b, 0x83, 0xe9, 0x1e, 0x00, 0x67, 0x31, 0xb1, 0x7f,
+	0xda, 0xc7, 0xf1, 0x27, 0x0b, 0x6c, 0x56, 0x6a, 0x38, 0xa5, 0x72, 0xca, 0x02, 0x0a, 0xcf, 0xc0,
+	0xd6, 0x02, 0x79, 0xc0, 0x6d, 0xfc, 0xfb, 0x85, 0x3f, 0x70, 0xf0, 0x32, 0x65, 0x9d, 0x03, 0xb4,
+	0x28, 0x6b, 0xe8, 0xe0, 0x25, 0x4a, 0x19, 0xdc, 0xc7, 0xcb, 0x4a, 0xde, 0xdb, 0xff, 0x78, 0x35,
+	0xb4, 0x3e, 0x5f, 0x0d, 0xad, 0xaf, 0x57, 0x43, 0xeb, 0xcd, 0xe3, 0x25, 0x4f, 0xde, 0xdc, 0x9b,
+	0x49, 0x04, 0x0b, 0x12, 0x46, 0x33, 0x7d, 0xd1, 0x33, 0x4f, 0xdc, 0xee, 0x8f, 0x01, 0x00, 0x4e,
+	0x8c, 0x5f, 0x3d, 0x54, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +597,8 @@ const _ = grpc.SupportPackageIsVersion4
 type CommitServiceClient interface {
 	// Commit commits hydrated manifests to a repository.
 	CommitHydratedManifests(ctx context.Context, in *CommitHydratedManifestsRequest, opts ...grpc.CallOption) (*CommitHydratedManifestsResponse, error)
+	// PromoteHydratedManifests copies hydrated manifests from a hydrated commit to a branch of the repository.
+	PromoteHydratedManifests(ctx context.Context, in *PromoteHydratedManifestsRequest, opts ...grpc.CallOption) (*PromoteHydratedManifestsResponse, error)
 }
 
 type commitServiceClient struct {
@@ -393,10 +618,21 @@ func (c *commitServiceClient) CommitHydratedManifests(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *commitServiceClient) PromoteHydratedManifests(ctx context.Context, in *PromoteHydratedManifestsRequest, opts ...grpc.CallOption) (*PromoteHydratedManifestsResponse, error) {
+	out := new(PromoteHydratedManifestsResponse)
+	err := c.cc.Invoke(ctx, "/CommitService/PromoteHydratedManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommitServiceServer is the server API for CommitService service.
 type CommitServiceServer interface {
 	// Commit commits hydrated manifests to a repository.
 	CommitHydratedManifests(context.Context, *CommitHydratedManifestsRequest) (*CommitHydratedManifestsResponse, error)
+	// PromoteHydratedManifests copies hydrated manifests from a hydrated commit to a branch of the repository.
+	PromoteHydratedManifests(context.Context, *PromoteHydratedManifestsRequest) (*PromoteHydratedManifestsResponse, error)
 }
 
 // UnimplementedCommitServiceServer can be embedded to have forward compatible implementations.
@@ -406,6 +642,9 @@ type UnimplementedCommitServiceServer struct {
 func (*UnimplementedCommitServiceServer) CommitHydratedManifests(ctx context.Context, req *CommitHydratedManifestsRequest) (*CommitHydratedManifestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommitHydratedManifests not implemented")
 }
+func (*UnimplementedCommitServiceServer) PromoteHydratedManifests(ctx context.Context, req *PromoteHydratedManifestsRequest) (*PromoteHydratedManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteHydratedManifests not implemented")
+}
 
 func RegisterCommitServiceServer(s *grpc.Server, srv CommitServiceServer) {
 	s.RegisterService(&_CommitService_serviceDesc, srv)
@@ -429,6 +668,24 @@ func _CommitService_CommitHydratedManifests_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommitService_PromoteHydratedManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteHydratedManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitServiceServer).PromoteHydratedManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CommitService/PromoteHydratedManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitServiceServer).PromoteHydratedManifests(ctx, req.(*PromoteHydratedManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CommitService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "CommitService",
 	HandlerType: (*CommitServiceServer)(nil),
@@ -437,6 +694,10 @@ var _CommitService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommitHydratedManifests",
 			Handler:    _CommitService_CommitHydratedManifests_Handler,
 		},
+		{
+			MethodName: "PromoteHydratedManifests",
+			Handler:    _CommitService_PromoteHydratedManifests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "commitserver/commit/commit.proto",
@@ -466,6 +727,25 @@ func (m *CommitHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DryRepoURL) > 0 {
+		i -= len(m.DryRepoURL)
+		copy(dAtA[i:], m.DryRepoURL)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.DryRepoURL)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.AuthorEmail) > 0 {
 		i -= len(m.AuthorEmail)
 		copy(dAtA[i:], m.AuthorEmail)
@@ -573,6 +853,25 @@ func (m *PathDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.HelmValues) > 0 {
+		i -= len(m.HelmValues)
+		copy(dAtA[i:], m.HelmValues)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.HelmValues)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Layout != nil {
+		{
+			size, err := m.Layout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -664,6 +963,13 @@ func (m *CommitHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PullRequestURL) > 0 {
+		i -= len(m.PullRequestURL)
+		copy(dAtA[i:], m.PullRequestURL)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.PullRequestURL)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.HydratedSha) > 0 {
 		i -= len(m.HydratedSha)
 		copy(dAtA[i:], m.HydratedSha)
@@ -674,53 +980,177 @@ func (m *CommitHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCommit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCommit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PromoteHydratedManifestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CommitHydratedManifestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PromoteHydratedManifestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoteHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	l = len(m.SyncBranch)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.TargetBranch)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
+	if len(m.AuthorEmail) > 0 {
+		i -= len(m.AuthorEmail)
+		copy(dAtA[i:], m.AuthorEmail)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.AuthorEmail)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.DrySha)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
+	if len(m.AuthorName) > 0 {
+		i -= len(m.AuthorName)
+		copy(dAtA[i:], m.AuthorName)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.AuthorName)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.CommitMessage)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
+	if len(m.CommitMessage) > 0 {
+		i -= len(m.CommitMessage)
+		copy(dAtA[i:], m.CommitMessage)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.CommitMessage)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if len(m.Paths) > 0 {
-		for _, e := range m.Paths {
-			l = e.Size()
-			n += 1 + l + sovCommit(uint64(l))
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintCommit(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.DryCommitMetadata != nil {
-		l = m.DryCommitMetadata.Size()
-		n += 1 + l + sovCommit(uint64(l))
-	}
+	if len(m.DrySha) > 0 {
+		i -= len(m.DrySha)
+		copy(dAtA[i:], m.DrySha)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.DrySha)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TargetBranch) > 0 {
+		i -= len(m.TargetBranch)
+		copy(dAtA[i:], m.TargetBranch)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.TargetBranch)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceSha) > 0 {
+		i -= len(m.SourceSha)
+		copy(dAtA[i:], m.SourceSha)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.SourceSha)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PromoteHydratedManifestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromoteHydratedManifestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoteHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.HydratedSha) > 0 {
+		i -= len(m.HydratedSha)
+		copy(dAtA[i:], m.HydratedSha)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.HydratedSha)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCommit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommitHydratedManifestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.SyncBranch)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.TargetBranch)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.DrySha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.CommitMessage)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, e := range m.Paths {
+			l = e.Size()
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if m.DryCommitMetadata != nil {
+		l = m.DryCommitMetadata.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	l = len(m.AuthorName)
 	if l > 0 {
 		n += 1 + l + sovCommit(uint64(l))
@@ -729,6 +1159,14 @@ func (m *CommitHydratedManifestsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommit(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.DryRepoURL)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -757,6 +1195,14 @@ func (m *PathDetails) Size() (n int) {
 			n += 1 + l + sovCommit(uint64(l))
 		}
 	}
+	if m.Layout != nil {
+		l = m.Layout.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.HelmValues)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -780,6 +1226,72 @@ func (m *HydratedManifestDetails) Size() (n int) {
 }
 
 func (m *CommitHydratedManifestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HydratedSha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.PullRequestURL)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PromoteHydratedManifestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.SourceSha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.TargetBranch)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.DrySha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	l = len(m.CommitMessage)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.AuthorName)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.AuthorEmail)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PromoteHydratedManifestsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1128,6 +1640,74 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.AuthorEmail = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydratorPullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -1277,20 +1857,88 @@ func (m *PathDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCommit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Layout == nil {
+				m.Layout = &v1alpha1.HydratedLayout{}
+			}
+			if err := m.Layout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmValues = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
 
@@ -1411,6 +2059,432 @@ func (m *CommitHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: CommitHydratedManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HydratedSha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullRequestURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromoteHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromoteHydratedManifestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromoteHydratedManifestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceSha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceSha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrySha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorEmail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorEmail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromoteHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromoteHydratedManifestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromoteHydratedManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSha", wireType)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -15,24 +16,32 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 )
 
 const (
-	NoteNamespace = "hydrator.metadata" // NoteNamespace is the custom git notes namespace used by the hydrator to store and retrieve commit-related metadata.
-	ManifestYaml  = "manifest.yaml"     // ManifestYaml constant for the manifest yaml
+	NoteNamespace     = "hydrator.metadata"  // NoteNamespace is the custom git notes namespace used by the hydrator to store and retrieve commit-related metadata.
+	ManifestYaml      = "manifest.yaml"      // ManifestYaml constant for the manifest yaml
+	KustomizationYaml = "kustomization.yaml" // KustomizationYaml is the file listing the hydrated manifest files as Kustomize resources.
+	ValuesYaml        = "values.yaml"        // ValuesYaml is the file holding the values the Helm chart of the dry source was templated with.
 )
 
 // Service is the service that handles commit requests.
 type Service struct {
-	metricsServer     *metrics.Server
-	repoClientFactory RepoClientFactory
+	metricsServer             *metrics.Server
+	repoClientFactory         RepoClientFactory
+	pullRequestCreatorFactory PullRequestCreatorFactory
+	newOCIPusher              func(repoURL string, creds oci.Creds, proxy string, noProxy string) (oci.Pusher, error)
 }
 
-// NewService returns a new instance of the commit service.
-func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *Service {
+// NewService returns a new instance of the commit service. Hydrated commits are signed with commitSigning, unless it is
+// nil.
+func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server, commitSigning *git.CommitSigningOpts) *Service {
 	return &Service{
-		metricsServer:     metricsServer,
-		repoClientFactory: NewRepoClientFactory(gitCredsStore, metricsServer),
+		metricsServer:             metricsServer,
+		repoClientFactory:         NewRepoClientFactory(gitCredsStore, metricsServer, commitSigning),
+		pullRequestCreatorFactory: NewPullRequestCreatorFactory(),
+		newOCIPusher:              oci.NewPusher,
 	}
 }
 
@@ -82,8 +91,10 @@ git checkout {{ .DrySHA }}
 
 // CommitHydratedManifests handles a commit request. It clones the repository, checks out the sync branch, checks out
 // the target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and
-// pushes the changes. It returns the hydrated revision SHA and an error if one occurred.
-func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
+// pushes the changes. If the repository is an OCI repository, it pushes the manifests as an OCI artifact instead. If a
+// pull request is configured, it opens or updates the pull request from the target branch to the
+// sync branch after pushing. It returns the hydrated revision SHA, the pull request URL and an error if one occurred.
+func (s *Service) CommitHydratedManifests(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
 	// This method is intentionally short. It's a wrapper around handleCommitRequest that adds metrics and logging.
 	// Keep logic here minimal and put most of the logic in handleCommitRequest.
 	startTime := time.Now()
@@ -109,7 +120,7 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 
 	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "drySHA": r.DrySha})
 
-	out, sha, err := s.handleCommitRequest(logCtx, r)
+	out, sha, pullRequestURL, err := s.handleCommitRequest(ctx, logCtx, r)
 	if err != nil {
 		logCtx.WithError(err).WithField("output", out).Error("failed to handle commit request")
 
@@ -119,38 +130,50 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 
 	logCtx.Info("Successfully handled commit request")
 	return &apiclient.CommitHydratedManifestsResponse{
-		HydratedSha: sha,
+		HydratedSha:    sha,
+		PullRequestURL: pullRequestURL,
 	}, nil
 }
 
 // handleCommitRequest handles the commit request. It clones the repository, checks out the sync branch, checks out the
 // target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and pushes
-// the changes. It returns the output of the git commands and an error if one occurred.
-func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, error) {
+// the changes. It returns the output of the git commands, the hydrated revision SHA, the URL of the pull request opened
+// for the pushed commit and an error if one occurred.
+func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, string, error) {
 	if r.Repo == nil {
-		return "", "", errors.New("repo is required")
+		return "", "", "", errors.New("repo is required")
 	}
 	if r.Repo.Repo == "" {
-		return "", "", errors.New("repo URL is required")
+		return "", "", "", errors.New("repo URL is required")
 	}
 	if r.TargetBranch == "" {
-		return "", "", errors.New("target branch is required")
+		return "", "", "", errors.New("target branch is required")
 	}
 	if r.SyncBranch == "" {
-		return "", "", errors.New("sync branch is required")
+		return "", "", "", errors.New("sync branch is required")
+	}
+	if r.PullRequest != nil && r.TargetBranch == r.SyncBranch {
+		return "", "", "", errors.New("target branch must differ from the sync branch to open a pull request")
+	}
+	if strings.HasPrefix(r.Repo.Repo, "oci://") {
+		if r.PullRequest != nil {
+			return "", "", "", errors.New("pull requests are not supported for OCI repositories")
+		}
+		sha, err := s.handleOCIRequest(ctx, logCtx.WithField("repo", r.Repo.Repo), r)
+		return "", sha, "", err
 	}
 
 	logCtx = logCtx.WithField("repo", r.Repo.Repo)
 	logCtx.Debug("Initiating git client")
-	gitClient, dirPath, cleanup, err := s.initGitClient(logCtx, r)
+	gitClient, dirPath, cleanup, err := s.initGitClient(logCtx, r.Repo, r.AuthorName, r.AuthorEmail)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to init git client: %w", err)
+		return "", "", "", fmt.Errorf("failed to init git client: %w", err)
 	}
 	defer cleanup()
 
 	root, err := os.OpenRoot(dirPath)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to open root dir: %w", err)
+		return "", "", "", fmt.Errorf("failed to open root dir: %w", err)
 	}
 	defer io.Close(root)
 
@@ -158,18 +181,25 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	var out string
 	out, err = gitClient.CheckoutOrOrphan(r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout sync branch: %w", err)
+		return out, "", "", fmt.Errorf("failed to checkout sync branch: %w", err)
+	}
+	var syncSha string
+	if r.PullRequest != nil {
+		syncSha, err = gitClient.CommitSHA()
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to get sync branch commit SHA: %w", err)
+		}
 	}
 
 	logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
 	out, err = gitClient.CheckoutOrNew(r.TargetBranch, r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout target branch: %w", err)
+		return out, "", "", fmt.Errorf("failed to checkout target branch: %w", err)
 	}
 
 	hydratedSha, err := gitClient.CommitSHA()
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+		return "", "", "", fmt.Errorf("failed to get commit SHA: %w", err)
 	}
 
 	/* git note changes
@@ -182,19 +212,23 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	*/
 	isHydrated, err := IsHydrated(gitClient, r.DrySha, hydratedSha)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get notes from git %w", err)
+		return "", "", "", fmt.Errorf("failed to get notes from git %w", err)
 	}
 	// short-circuit if already hydrated
 	if isHydrated {
 		logCtx.Debugf("this dry sha %s is already hydrated", r.DrySha)
-		return "", hydratedSha, nil
+		pullRequestURL, err := s.openPullRequest(ctx, logCtx, gitClient, r, syncSha, hydratedSha)
+		if err != nil {
+			return "", "", "", err
+		}
+		return "", hydratedSha, pullRequestURL, nil
 	}
 
 	logCtx.Debug("Writing manifests")
-	shouldCommit, err := WriteForPaths(root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths, gitClient)
+	shouldCommit, err := WriteForPaths(root, getDryRepoURL(r), r.DrySha, r.DryCommitMetadata, r.Paths, gitClient)
 	// When there are no new manifests to commit, err will be nil and success will be false as nothing to commit. Else or every other error err will not be nil
 	if err != nil {
-		return "", "", fmt.Errorf("failed to write manifests: %w", err)
+		return "", "", "", fmt.Errorf("failed to write manifests: %w", err)
 	}
 	if !shouldCommit {
 		// Manifests did not change, so we don't need to create a new commit.
@@ -202,34 +236,78 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 		logCtx.Debug("Adding commit note")
 		err = AddNote(gitClient, r.DrySha, hydratedSha)
 		if err != nil {
-			return "", "", fmt.Errorf("failed to add commit note: %w", err)
+			return "", "", "", fmt.Errorf("failed to add commit note: %w", err)
+		}
+		pullRequestURL, err := s.openPullRequest(ctx, logCtx, gitClient, r, syncSha, hydratedSha)
+		if err != nil {
+			return "", "", "", err
 		}
-		return "", hydratedSha, nil
+		return "", hydratedSha, pullRequestURL, nil
 	}
 	logCtx.Debug("Committing and pushing changes")
 	out, err = gitClient.CommitAndPush(r.TargetBranch, r.CommitMessage)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to commit and push: %w", err)
+		return out, "", "", fmt.Errorf("failed to commit and push: %w", err)
 	}
 
 	logCtx.Debug("Getting commit SHA")
 	sha, err := gitClient.CommitSHA()
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+		return "", "", "", fmt.Errorf("failed to get commit SHA: %w", err)
 	}
 	// add the commit note
 	logCtx.Debug("Adding commit note")
 	err = AddNote(gitClient, r.DrySha, sha)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to add commit note: %w", err)
+		return "", "", "", fmt.Errorf("failed to add commit note: %w", err)
+	}
+	pullRequestURL, err := s.openPullRequest(ctx, logCtx, gitClient, r, syncSha, sha)
+	if err != nil {
+		return "", "", "", err
+	}
+	return "", sha, pullRequestURL, nil
+}
+
+// openPullRequest opens or updates the pull request from the target branch, at the given hydrated SHA, to the sync
+// branch, at the given sync SHA, if the request asks for one and the branches differ. It is called whatever the
+// outcome of the hydration, so that a pull request which failed to be opened for a commit already pushed to the target
+// branch is opened by the next request.
+func (s *Service) openPullRequest(ctx context.Context, logCtx *log.Entry, gitClient git.Client, r *apiclient.CommitHydratedManifestsRequest, syncSha, hydratedSha string) (string, error) {
+	if r.PullRequest == nil {
+		return "", nil
+	}
+	changedFiles, err := gitClient.ChangedFiles(syncSha, hydratedSha)
+	if err != nil {
+		return "", fmt.Errorf("failed to compare target branch with sync branch: %w", err)
+	}
+	if len(changedFiles) == 0 {
+		logCtx.Debugf("Target branch %s does not differ from sync branch %s, not opening a pull request", r.TargetBranch, r.SyncBranch)
+		return "", nil
+	}
+	logCtx.Debugf("Opening pull request from %s to %s", r.TargetBranch, r.SyncBranch)
+	pullRequestURL, err := s.createOrUpdatePullRequest(ctx, r)
+	if err != nil {
+		return "", fmt.Errorf("failed to open pull request: %w", err)
+	}
+	return pullRequestURL, nil
+}
+
+// createOrUpdatePullRequest opens a pull request from the target branch to the sync branch, or updates the pull request
+// which is already open between them. The first line of the commit message is the title of the pull request and the
+// rest its description.
+func (s *Service) createOrUpdatePullRequest(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) (string, error) {
+	creator, err := s.pullRequestCreatorFactory.NewCreator(ctx, r.Repo, r.PullRequest)
+	if err != nil {
+		return "", err
 	}
-	return "", sha, nil
+	title, description, _ := strings.Cut(strings.TrimSpace(r.CommitMessage), "\n")
+	return creator.CreateOrUpdate(ctx, r.TargetBranch, r.SyncBranch, title, strings.TrimSpace(description))
 }
 
 // initGitClient initializes a git client for the given repository and returns the client, the path to the directory where
 // the repository is cloned, a cleanup function that should be called when the directory is no longer needed, and an error
 // if one occurred.
-func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (git.Client, string, func(), error) {
+func (s *Service) initGitClient(logCtx *log.Entry, repo *v1alpha1.Repository, requestAuthorName, requestAuthorEmail string) (git.Client, string, func(), error) {
 	dirPath, err := files.CreateTempDir("/tmp/_commit-service")
 	if err != nil {
 		return nil, "", nil, fmt.Errorf("failed to create temp dir: %w", err)
@@ -242,20 +320,20 @@ func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedMa
 		}
 	}
 
-	gitClient, err := s.repoClientFactory.NewClient(r.Repo, dirPath)
+	gitClient, err := s.repoClientFactory.NewClient(repo, dirPath)
 	if err != nil {
 		cleanupOrLog()
 		return nil, "", nil, fmt.Errorf("failed to create git client: %w", err)
 	}
 
-	logCtx.Debugf("Initializing repo %s", r.Repo.Repo)
+	logCtx.Debugf("Initializing repo %s", repo.Repo)
 	err = gitClient.Init()
 	if err != nil {
 		cleanupOrLog()
 		return nil, "", nil, fmt.Errorf("failed to init git client: %w", err)
 	}
 
-	logCtx.Debugf("Fetching repo %s", r.Repo.Repo)
+	logCtx.Debugf("Fetching repo %s", repo.Repo)
 	err = gitClient.Fetch("", 0)
 	if err != nil {
 		cleanupOrLog()
@@ -273,17 +351,17 @@ func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedMa
 	//	 return nil, "", nil, fmt.Errorf("failed to get github app info: %w", err)
 	// }
 	// Use author name and email from request, defaulting to "Argo CD" if not provided
-	authorName := r.AuthorName
+	authorName := requestAuthorName
 	if authorName == "" {
 		authorName = "Argo CD"
 	}
-	authorEmail := r.AuthorEmail
+	authorEmail := requestAuthorEmail
 	if authorEmail == "" {
 		authorEmail = "argo-cd@example.com"
 	}
 
 	logCtx.Debugf("Author config: request name='%s', request email='%s', final name='%s', final email='%s'",
-		r.AuthorName, r.AuthorEmail, authorName, authorEmail)
+		requestAuthorName, requestAuthorEmail, authorName, authorEmail)
 
 	logCtx.Debugf("Setting author %s <%s>", authorName, authorEmail)
 	_, err = gitClient.SetAuthor(authorName, authorEmail)
@@ -1,6 +1,7 @@
 package commit
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -442,6 +443,137 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		// BUG FIX: When manifests don't change (no-op), the existing hydrated SHA should be returned.
 		assert.Equal(t, "root-and-blank-sha", resp.HydratedSha, "Should return existing hydrated SHA for no-op")
 	})
+
+	t.Run("pull request", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockPullRequestCreatorFactory := mocks.NewPullRequestCreatorFactory(t)
+		service.pullRequestCreatorFactory = mockPullRequestCreatorFactory
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("argocd/hydrator/env/test", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote(mock.Anything, mock.Anything).Return("", fmt.Errorf("test %w", git.ErrNoNoteFound)).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything).Return(true, nil).Once()
+		mockGitClient.EXPECT().CommitAndPush("argocd/hydrator/env/test", "subject\n\nbody").Return("", nil).Once()
+		mockGitClient.EXPECT().AddAndPushNote(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("sync-sha", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("pull-request-sha", nil).Twice()
+		mockGitClient.EXPECT().ChangedFiles("sync-sha", "pull-request-sha").Return([]string{"guestbook/manifest.yaml"}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		pullRequest := &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub}
+		creator := &fakePullRequestCreator{url: "https://github.com/argoproj/argocd-example-apps/pull/1"}
+		mockPullRequestCreatorFactory.EXPECT().NewCreator(mock.Anything, validRequest.Repo, pullRequest).Return(creator, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), &apiclient.CommitHydratedManifestsRequest{
+			Repo:          validRequest.Repo,
+			SyncBranch:    "env/test",
+			TargetBranch:  "argocd/hydrator/env/test",
+			CommitMessage: "subject\n\nbody",
+			Paths: []*apiclient.PathDetails{{
+				Path:      "guestbook",
+				Manifests: []*apiclient.HydratedManifestDetails{{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"}}`}},
+			}},
+			PullRequest: pullRequest,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "pull-request-sha", resp.HydratedSha)
+		assert.Equal(t, "https://github.com/argoproj/argocd-example-apps/pull/1", resp.PullRequestURL)
+		assert.Equal(t, []string{"argocd/hydrator/env/test", "env/test", "subject", "body"}, creator.args)
+	})
+
+	t.Run("pull request of an already hydrated commit", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockPullRequestCreatorFactory := mocks.NewPullRequestCreatorFactory(t)
+		service.pullRequestCreatorFactory = mockPullRequestCreatorFactory
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("argocd/hydrator/env/test", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote(mock.Anything, mock.Anything).Return(`{"drySha":"abc123"}`, nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("sync-sha", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("hydrated-sha", nil).Once()
+		mockGitClient.EXPECT().ChangedFiles("sync-sha", "hydrated-sha").Return([]string{"guestbook/manifest.yaml"}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		// the pull request failed to be opened when the commit was pushed, and is opened by the next request
+		pullRequest := &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub}
+		creator := &fakePullRequestCreator{url: "https://github.com/argoproj/argocd-example-apps/pull/1"}
+		mockPullRequestCreatorFactory.EXPECT().NewCreator(mock.Anything, validRequest.Repo, pullRequest).Return(creator, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), &apiclient.CommitHydratedManifestsRequest{
+			Repo:          validRequest.Repo,
+			SyncBranch:    "env/test",
+			TargetBranch:  "argocd/hydrator/env/test",
+			DrySha:        "abc123",
+			CommitMessage: "subject\n\nbody",
+			PullRequest:   pullRequest,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "hydrated-sha", resp.HydratedSha)
+		assert.Equal(t, "https://github.com/argoproj/argocd-example-apps/pull/1", resp.PullRequestURL)
+	})
+
+	t.Run("pull request without changes from the sync branch", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		service.pullRequestCreatorFactory = mocks.NewPullRequestCreatorFactory(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("argocd/hydrator/env/test", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote(mock.Anything, mock.Anything).Return(`{"drySha":"abc123"}`, nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("sync-sha", nil).Twice()
+		mockGitClient.EXPECT().ChangedFiles("sync-sha", "sync-sha").Return([]string{}, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), &apiclient.CommitHydratedManifestsRequest{
+			Repo:         validRequest.Repo,
+			SyncBranch:   "env/test",
+			TargetBranch: "argocd/hydrator/env/test",
+			DrySha:       "abc123",
+			PullRequest:  &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "sync-sha", resp.HydratedSha)
+		assert.Empty(t, resp.PullRequestURL)
+	})
+
+	t.Run("pull request to the target branch", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo:         validRequest.Repo,
+			SyncBranch:   "env/test",
+			TargetBranch: "env/test",
+			PullRequest:  &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub},
+		}
+
+		_, err := service.CommitHydratedManifests(t.Context(), request)
+		require.ErrorContains(t, err, "target branch must differ from the sync branch")
+	})
+}
+
+type fakePullRequestCreator struct {
+	url  string
+	args []string
+}
+
+func (f *fakePullRequestCreator) CreateOrUpdate(_ context.Context, branch, targetBranch, title, description string) (string, error) {
+	f.args = []string{branch, targetBranch, title, description}
+	return f.url, nil
 }
 
 func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
@@ -449,7 +581,7 @@ func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
 
 	metricsServer := metrics.NewMetricsServer()
 	mockCredsStore := git.NoopCredsStore{}
-	service := NewService(mockCredsStore, metricsServer)
+	service := NewService(mockCredsStore, metricsServer, nil)
 	mockRepoClientFactory := mocks.NewRepoClientFactory(t)
 	service.repoClientFactory = mockRepoClientFactory
 
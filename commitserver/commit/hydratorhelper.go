@@ -1,11 +1,14 @@
 package commit
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
@@ -34,7 +37,8 @@ func init() {
 }
 
 // WriteForPaths writes the manifests, hydrator.metadata, and README.md files for each path in the provided paths. It
-// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA.
+// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA. If gitClient is nil, the
+// manifests of every path are considered changed.
 func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *appv1.RevisionMetadata, paths []*apiclient.PathDetails, gitClient git.Client) (bool, error) { //nolint:revive //FIXME(var-naming)
 	hydratorMetadata, err := hydrator.GetCommitMetadata(repoUrl, drySha, dryCommitMetadata)
 	if err != nil {
@@ -67,15 +71,30 @@ func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *app
 			}
 		}
 
-		// Write the manifests
-		err := writeManifests(root, hydratePath, p.Manifests)
+		// Write the manifests and the other files of the layout
+		files, err := writeLayout(root, hydratePath, p)
 		if err != nil {
 			return false, fmt.Errorf("failed to write manifests: %w", err)
 		}
-		// Check if the manifest file has been modified compared to the git index
-		changed, err := gitClient.HasFileChanged(filepath.Join(hydratePath, ManifestYaml))
+		// Remove the files of the previous layout which were not written
+		removed, err := removeStaleFiles(root, hydratePath, files)
 		if err != nil {
-			return false, fmt.Errorf("failed to check if anything changed on the manifest: %w", err)
+			return false, fmt.Errorf("failed to remove stale manifests: %w", err)
+		}
+		// Check if the manifest files have been modified compared to the git index
+		changed := true
+		if gitClient != nil && !removed {
+			changed = false
+			for _, file := range files {
+				fileChanged, err := gitClient.HasFileChanged(filepath.Join(hydratePath, file))
+				if err != nil {
+					return false, fmt.Errorf("failed to check if anything changed on the manifest: %w", err)
+				}
+				if fileChanged {
+					changed = true
+					break
+				}
+			}
 		}
 
 		if !changed {
@@ -90,6 +109,9 @@ func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *app
 			DrySHA:   drySha,
 			RepoURL:  repoUrl,
 		}
+		if p.Layout != nil {
+			hydratorMetadata.Files = files
+		}
 		err = writeMetadata(root, hydratePath, hydratorMetadata)
 		if err != nil {
 			return false, fmt.Errorf("failed to write hydrator metadata: %w", err)
@@ -175,13 +197,152 @@ func writeGitAttributes(root *os.Root) error {
 	return nil
 }
 
+// writeLayout writes the manifests of the path and the other files of its layout. It returns the names of the written
+// files.
+func writeLayout(root *os.Root, dirPath string, p *apiclient.PathDetails) ([]string, error) {
+	layout := p.Layout
+	if layout == nil {
+		layout = &appv1.HydratedLayout{}
+	}
+
+	var files []string
+	if layout.SplitManifests {
+		var err error
+		files, err = writeSplitManifests(root, dirPath, p.Manifests)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err := writeManifests(root, dirPath, p.Manifests)
+		if err != nil {
+			return nil, err
+		}
+		files = []string{ManifestYaml}
+	}
+	manifestFiles := files
+
+	if layout.HelmValues && p.HelmValues != "" {
+		err := root.WriteFile(filepath.Join(dirPath, ValuesYaml), []byte(p.HelmValues), 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write helm values: %w", err)
+		}
+		files = append(files, ValuesYaml)
+	}
+
+	if layout.Kustomization {
+		err := writeKustomization(root, dirPath, manifestFiles)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, KustomizationYaml)
+	}
+	return files, nil
+}
+
+// removeStaleFiles removes the files listed in the existing hydrator.metadata file of the path which are not in files,
+// or the manifest.yaml file if the existing metadata does not list files. It returns whether any file was removed.
+func removeStaleFiles(root *os.Root, dirPath string, files []string) (bool, error) {
+	data, err := root.ReadFile(filepath.Join(dirPath, "hydrator.metadata"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read hydrator metadata: %w", err)
+	}
+	var metadata hydrator.HydratorCommitMetadata
+	err = json.Unmarshal(data, &metadata)
+	if err != nil {
+		return false, fmt.Errorf("failed to unmarshal hydrator metadata: %w", err)
+	}
+	previousFiles := metadata.Files
+	if len(previousFiles) == 0 {
+		previousFiles = []string{ManifestYaml}
+	}
+
+	removed := false
+	for _, file := range previousFiles {
+		// Only remove files directly in the path, since the metadata is part of the repository.
+		if file != filepath.Base(file) || slices.Contains(files, file) {
+			continue
+		}
+		err = root.Remove(filepath.Join(dirPath, file))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return false, fmt.Errorf("failed to remove %s: %w", file, err)
+		}
+		removed = true
+	}
+	return removed, nil
+}
+
 // writeManifests writes the manifests to the manifest.yaml file, truncating the file if it exists and appending the
 // manifests in the order they are provided.
 func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.HydratedManifestDetails) error {
-	// If the file exists, truncate it.
 	// No need to use SecureJoin here, as the path is already sanitized.
-	manifestPath := filepath.Join(dirPath, ManifestYaml)
+	return writeManifestFile(root, filepath.Join(dirPath, ManifestYaml), manifests)
+}
+
+// writeSplitManifests writes each manifest to its own <kind>-<name>.yaml file. A numeric suffix is added to the names
+// of the files of manifests with the same kind and name, in the order they are provided. It returns the names of the
+// written files.
+//
+// The rendered manifests are not validated by the API server, so their kinds and names are sanitized into file names.
+// Manifests without a name, e.g. with a generateName, are named after their generateName.
+func writeSplitManifests(root *os.Root, dirPath string, manifests []*apiclient.HydratedManifestDetails) ([]string, error) {
+	files := make([]string, 0, len(manifests))
+	for _, m := range manifests {
+		obj := &unstructured.Unstructured{}
+		err := json.Unmarshal([]byte(m.ManifestJSON), obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		name := obj.GetName()
+		if name == "" {
+			name = strings.TrimSuffix(obj.GetGenerateName(), "-")
+		}
+		base := sanitizeFileName(obj.GetKind(), "resource") + "-" + sanitizeFileName(name, "unnamed")
+		fileName := base + ".yaml"
+		for i := 2; slices.Contains(files, fileName); i++ {
+			fileName = fmt.Sprintf("%s-%d.yaml", base, i)
+		}
+		err = writeManifestFile(root, filepath.Join(dirPath, fileName), []*apiclient.HydratedManifestDetails{m})
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, fileName)
+	}
+	return files, nil
+}
+
+// maxFileNamePartLength is the maximum length of the kind and of the name of a manifest in its file name, so that the
+// file name does not exceed the maximum length supported by file systems
+const maxFileNamePartLength = 100
 
+// sanitizeFileName returns the given part of a file name lowercased, with the characters other than letters, digits,
+// dots and dashes replaced by dashes, or the given fallback if it is empty
+func sanitizeFileName(part string, fallback string) string {
+	part = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		default:
+			return '-'
+		}
+	}, strings.ToLower(part))
+	if len(part) > maxFileNamePartLength {
+		part = part[:maxFileNamePartLength]
+	}
+	if strings.Trim(part, ".") == "" {
+		return fallback
+	}
+	return part
+}
+
+// writeManifestFile writes the manifests to the file, truncating the file if it exists and appending the manifests in
+// the order they are provided.
+func writeManifestFile(root *os.Root, manifestPath string, manifests []*apiclient.HydratedManifestDetails) error {
 	file, err := root.OpenFile(manifestPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
 	if err != nil {
 		return fmt.Errorf("failed to open manifest file: %w", err)
@@ -216,6 +377,36 @@ func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.Hydrat
 	return nil
 }
 
+// kustomization is the kustomization.yaml file listing the hydrated manifest files as resources.
+type kustomization struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Kind       string   `yaml:"kind"`
+	Resources  []string `yaml:"resources"`
+}
+
+// writeKustomization writes the kustomization.yaml file listing the manifest files as resources.
+func writeKustomization(root *os.Root, dirPath string, manifestFiles []string) error {
+	var data bytes.Buffer
+	enc := yaml.NewEncoder(&data)
+	enc.SetIndent(2)
+	err := enc.Encode(kustomization{
+		APIVersion: "kustomize.config.k8s.io/v1beta1",
+		Kind:       "Kustomization",
+		Resources:  manifestFiles,
+	})
+	if err == nil {
+		err = enc.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal kustomization: %w", err)
+	}
+	err = root.WriteFile(filepath.Join(dirPath, KustomizationYaml), data.Bytes(), 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to write kustomization: %w", err)
+	}
+	return nil
+}
+
 // IsHydrated checks whether the given commit (commitSha) has already been hydrated with the specified Dry SHA (drySha).
 // It does this by retrieving the commit note in the NoteNamespace and examining the DrySHA value.
 // Returns true if the stored DrySHA matches the provided drySha, false if not or if no note exists.
@@ -151,6 +151,127 @@ Argocd-reference-commit-sha: abc123
 	}
 }
 
+func TestWriteForPaths_Layout(t *testing.T) {
+	root := tempRoot(t)
+	appPath := filepath.Join(root.Name(), "app")
+	manifests := []*apiclient.HydratedManifestDetails{
+		{ManifestJSON: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web"}}`},
+		{ManifestJSON: `{"apiVersion":"v1","kind":"Service","metadata":{"name":"web"}}`},
+		{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cfg","namespace":"a"}}`},
+		{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cfg","namespace":"b"}}`},
+	}
+	readFiles := func(t *testing.T) []string {
+		t.Helper()
+		entries, err := os.ReadDir(appPath)
+		require.NoError(t, err)
+		var files []string
+		for _, e := range entries {
+			files = append(files, e.Name())
+		}
+		return files
+	}
+
+	// The default layout writes a single manifest.yaml file.
+	paths := []*apiclient.PathDetails{{Path: "app", Manifests: manifests, HelmValues: "replicas: 2\n"}}
+	changed, err := WriteForPaths(root, "https://github.com/example/repo", "abc123", nil, paths, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.ElementsMatch(t, []string{"README.md", "hydrator.metadata", "manifest.yaml"}, readFiles(t))
+
+	// The split layout replaces manifest.yaml with a file per resource.
+	paths[0].Layout = &appsv1.HydratedLayout{SplitManifests: true, Kustomization: true, HelmValues: true}
+	changed, err = WriteForPaths(root, "https://github.com/example/repo", "abc123", nil, paths, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	files := []string{"deployment-web.yaml", "service-web.yaml", "configmap-cfg.yaml", "configmap-cfg-2.yaml", "values.yaml", "kustomization.yaml"}
+	assert.ElementsMatch(t, append([]string{"README.md", "hydrator.metadata"}, files...), readFiles(t))
+
+	kustomization, err := os.ReadFile(filepath.Join(appPath, "kustomization.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment-web.yaml
+  - service-web.yaml
+  - configmap-cfg.yaml
+  - configmap-cfg-2.yaml
+`, string(kustomization))
+	values, err := os.ReadFile(filepath.Join(appPath, "values.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "replicas: 2\n", string(values))
+	configMap, err := os.ReadFile(filepath.Join(appPath, "configmap-cfg-2.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(configMap), "namespace: b")
+
+	var metadata hydrator.HydratorCommitMetadata
+	metadataBytes, err := os.ReadFile(filepath.Join(appPath, "hydrator.metadata"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(metadataBytes, &metadata))
+	assert.Equal(t, files, metadata.Files)
+
+	// Unchanged files are not committed.
+	mockGitClient := gitmocks.NewClient(t)
+	mockGitClient.EXPECT().HasFileChanged(mock.Anything).Return(false, nil).Times(len(files))
+	changed, err = WriteForPaths(root, "https://github.com/example/repo", "abc123", nil, paths, mockGitClient)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// Removing a resource removes its file.
+	paths[0].Manifests = manifests[:1]
+	paths[0].Layout = &appsv1.HydratedLayout{SplitManifests: true}
+	changed, err = WriteForPaths(root, "https://github.com/example/repo", "abc123", nil, paths, gitmocks.NewClient(t))
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.ElementsMatch(t, []string{"README.md", "hydrator.metadata", "deployment-web.yaml"}, readFiles(t))
+
+	// Going back to the default layout removes the split files.
+	paths[0].Layout = nil
+	changed, err = WriteForPaths(root, "https://github.com/example/repo", "abc123", nil, paths, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.ElementsMatch(t, []string{"README.md", "hydrator.metadata", "manifest.yaml"}, readFiles(t))
+}
+
+func TestWriteForPaths_SplitManifestsFileNames(t *testing.T) {
+	root := tempRoot(t)
+	paths := []*apiclient.PathDetails{{
+		Path: "app",
+		Manifests: []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"apiVersion":"batch/v1","kind":"Job","metadata":{"generateName":"migrate-"}}`},
+			{ManifestJSON: `{"apiVersion":"v1","kind":"Pod","metadata":{}}`},
+			{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"../../etc/passwd"}}`},
+			{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a:b/c"}}`},
+			{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a-b-c"}}`},
+			{ManifestJSON: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"Éclair_Config"}}`},
+			{ManifestJSON: `{"apiVersion":"v1","kind":"..","metadata":{"name":".."}}`},
+			{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"` + strings.Repeat("x", 300) + `"}}`},
+		},
+		Layout: &appsv1.HydratedLayout{SplitManifests: true},
+	}}
+
+	_, err := WriteForPaths(root, "https://github.com/example/repo", "abc123", nil, paths, nil)
+	require.NoError(t, err)
+
+	var metadata hydrator.HydratorCommitMetadata
+	metadataBytes, err := os.ReadFile(filepath.Join(root.Name(), "app", "hydrator.metadata"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(metadataBytes, &metadata))
+	files := []string{
+		"job-migrate.yaml",
+		"pod-unnamed.yaml",
+		"configmap-..-..-etc-passwd.yaml",
+		"configmap-a-b-c.yaml",
+		"configmap-a-b-c-2.yaml",
+		"secret--clair-config.yaml",
+		"resource-unnamed.yaml",
+		"configmap-" + strings.Repeat("x", 100) + ".yaml",
+	}
+	assert.Equal(t, files, metadata.Files)
+	for _, file := range files {
+		assert.FileExists(t, filepath.Join(root.Name(), "app", file))
+	}
+}
+
 func TestWriteForPaths_WithOneManifestMatchesExisting(t *testing.T) {
 	root := tempRoot(t)
 
@@ -0,0 +1,102 @@
+package commit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"slices"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// handleOCIRequest handles a commit request for an OCI repository. It writes the manifests to a temporary directory and
+// pushes them as an OCI artifact tagged with the hydrated tag of the request and the target branch. If an artifact was
+// already pushed for the same dry SHA, target branch and paths, it only moves the target branch tag to it. It returns
+// the digest of the artifact and an error if one occurred.
+func (s *Service) handleOCIRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, error) {
+	logCtx.Debug("Initiating OCI client")
+	pusher, err := s.newOCIPusher(r.Repo.Repo, r.Repo.GetOCICreds(), r.Repo.Proxy, r.Repo.NoProxy)
+	if err != nil {
+		return "", fmt.Errorf("failed to create OCI client: %w", err)
+	}
+
+	tag := hydratedTag(r)
+	digest, err := pusher.Resolve(ctx, tag)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve artifact of dry sha %s: %w", r.DrySha, err)
+	}
+	// short-circuit if already hydrated
+	if digest != "" {
+		logCtx.Debugf("this dry sha %s is already hydrated", r.DrySha)
+		if err := pusher.Tag(ctx, tag, r.TargetBranch); err != nil {
+			return "", fmt.Errorf("failed to tag artifact: %w", err)
+		}
+		return digest, nil
+	}
+
+	dirPath, err := files.CreateTempDir("/tmp/_commit-service")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dirPath); err != nil {
+			logCtx.WithError(err).Error("failed to cleanup temp dir")
+		}
+	}()
+
+	root, err := os.OpenRoot(dirPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open root dir: %w", err)
+	}
+	defer io.Close(root)
+
+	dryRepoURL := getDryRepoURL(r)
+	logCtx.Debug("Writing manifests")
+	if _, err := WriteForPaths(root, dryRepoURL, r.DrySha, r.DryCommitMetadata, r.Paths, nil); err != nil {
+		return "", fmt.Errorf("failed to write manifests: %w", err)
+	}
+
+	logCtx.Debug("Pushing artifact")
+	digest, err = pusher.Push(ctx, dirPath, map[string]string{
+		imagev1.AnnotationRevision: r.DrySha,
+		imagev1.AnnotationSource:   dryRepoURL,
+	}, tag, r.TargetBranch)
+	if err != nil {
+		return "", fmt.Errorf("failed to push artifact: %w", err)
+	}
+	return digest, nil
+}
+
+// hydratedTag returns the tag of the artifact hydrated from the dry SHA of the request for its target branch and paths,
+// i.e. the dry SHA followed by a hash of the target branch and of the paths, since the artifacts hydrated from the same
+// dry SHA for other branches or applications hold other manifests
+func hydratedTag(r *apiclient.CommitHydratedManifestsRequest) string {
+	paths := make([]string, 0, len(r.Paths))
+	for _, p := range r.Paths {
+		paths = append(paths, p.Path)
+	}
+	slices.Sort(paths)
+	h := sha256.New()
+	h.Write([]byte(r.TargetBranch))
+	for _, p := range paths {
+		h.Write([]byte{0})
+		h.Write([]byte(p))
+	}
+	return r.DrySha + "-" + hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// getDryRepoURL returns the URL of the repository of the dry source, which defaults to the repository the manifests are
+// written to.
+func getDryRepoURL(r *apiclient.CommitHydratedManifestsRequest) string {
+	if r.DryRepoURL != "" {
+		return r.DryRepoURL
+	}
+	return r.Repo.Repo
+}
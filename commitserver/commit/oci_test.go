@@ -0,0 +1,112 @@
+package commit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+	ocimocks "github.com/argoproj/argo-cd/v3/util/oci/mocks"
+)
+
+func Test_CommitHydratedManifests_OCI(t *testing.T) {
+	t.Parallel()
+
+	newRequest := func() *apiclient.CommitHydratedManifestsRequest {
+		return &apiclient.CommitHydratedManifestsRequest{
+			Repo:          &v1alpha1.Repository{Repo: "oci://registry.example.com/hydrated", Username: "user", Password: "pass"},
+			DryRepoURL:    "https://github.com/argoproj/argocd-example-apps.git",
+			DrySha:        "abc123",
+			SyncBranch:    "env-test",
+			TargetBranch:  "env-test",
+			CommitMessage: "test commit message",
+			Paths: []*apiclient.PathDetails{{
+				Path:      "guestbook",
+				Manifests: []*apiclient.HydratedManifestDetails{{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"}}`}},
+			}},
+		}
+	}
+
+	newService := func(t *testing.T, pusher oci.Pusher) *Service {
+		t.Helper()
+		service, _ := newServiceWithMocks(t)
+		service.newOCIPusher = func(repoURL string, creds oci.Creds, _, _ string) (oci.Pusher, error) {
+			assert.Equal(t, "oci://registry.example.com/hydrated", repoURL)
+			assert.Equal(t, "user", creds.Username)
+			return pusher, nil
+		}
+		return service
+	}
+
+	t.Run("push", func(t *testing.T) {
+		t.Parallel()
+
+		pusher := ocimocks.NewPusher(t)
+		pusher.EXPECT().Resolve(mock.Anything, hydratedTag(newRequest())).Return("", nil).Once()
+		pusher.EXPECT().Push(mock.Anything, mock.Anything, map[string]string{
+			imagev1.AnnotationRevision: "abc123",
+			imagev1.AnnotationSource:   "https://github.com/argoproj/argocd-example-apps.git",
+		}, hydratedTag(newRequest()), "env-test").RunAndReturn(func(_ context.Context, dir string, _ map[string]string, _ ...string) (string, error) {
+			manifest, err := os.ReadFile(filepath.Join(dir, "guestbook", ManifestYaml))
+			require.NoError(t, err)
+			assert.Contains(t, string(manifest), "name: test")
+			assert.FileExists(t, filepath.Join(dir, "hydrator.metadata"))
+			return "sha256:digest", nil
+		}).Once()
+
+		resp, err := newService(t, pusher).CommitHydratedManifests(t.Context(), newRequest())
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:digest", resp.HydratedSha)
+	})
+
+	t.Run("already hydrated", func(t *testing.T) {
+		t.Parallel()
+
+		pusher := ocimocks.NewPusher(t)
+		pusher.EXPECT().Resolve(mock.Anything, hydratedTag(newRequest())).Return("sha256:digest", nil).Once()
+		pusher.EXPECT().Tag(mock.Anything, hydratedTag(newRequest()), "env-test").Return(nil).Once()
+
+		resp, err := newService(t, pusher).CommitHydratedManifests(t.Context(), newRequest())
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:digest", resp.HydratedSha)
+	})
+
+	t.Run("hydrated tag", func(t *testing.T) {
+		t.Parallel()
+
+		tag := hydratedTag(newRequest())
+		assert.Regexp(t, `^abc123-[0-9a-f]{16}$`, tag)
+
+		otherBranch := newRequest()
+		otherBranch.TargetBranch = "env-prod"
+		assert.NotEqual(t, tag, hydratedTag(otherBranch))
+
+		otherPaths := newRequest()
+		otherPaths.Paths = append(otherPaths.Paths, &apiclient.PathDetails{Path: "other"})
+		assert.NotEqual(t, tag, hydratedTag(otherPaths))
+
+		reorderedPaths := newRequest()
+		reorderedPaths.Paths = append([]*apiclient.PathDetails{{Path: "other"}}, reorderedPaths.Paths...)
+		assert.Equal(t, hydratedTag(otherPaths), hydratedTag(reorderedPaths))
+	})
+
+	t.Run("pull request", func(t *testing.T) {
+		t.Parallel()
+
+		request := newRequest()
+		request.TargetBranch = "env-test-next"
+		request.PullRequest = &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub}
+
+		service, _ := newServiceWithMocks(t)
+		_, err := service.CommitHydratedManifests(t.Context(), request)
+		require.ErrorContains(t, err, "pull requests are not supported for OCI repositories")
+	})
+}
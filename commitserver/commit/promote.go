@@ -0,0 +1,125 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
+)
+
+// PromoteHydratedManifests handles a promotion request. It clones the repository, checks out the target branch,
+// replaces the paths with their content in the source commit, commits the changes, and pushes the changes. It returns
+// the SHA of the commit of the target branch holding the promoted manifests and an error if one occurred.
+func (s *Service) PromoteHydratedManifests(_ context.Context, r *apiclient.PromoteHydratedManifestsRequest) (*apiclient.PromoteHydratedManifestsResponse, error) {
+	// This method is intentionally short. It's a wrapper around handlePromoteRequest that adds metrics and logging.
+	startTime := time.Now()
+
+	var repoURL string
+	if r.Repo != nil {
+		repoURL = r.Repo.Repo
+	}
+
+	var err error
+	s.metricsServer.IncPendingCommitRequest(repoURL)
+	defer func() {
+		s.metricsServer.DecPendingCommitRequest(repoURL)
+		commitResponseType := metrics.CommitResponseTypeSuccess
+		if err != nil {
+			commitResponseType = metrics.CommitResponseTypeFailure
+		}
+		s.metricsServer.IncCommitRequest(repoURL, commitResponseType)
+		s.metricsServer.ObserveCommitRequestDuration(repoURL, commitResponseType, time.Since(startTime))
+	}()
+
+	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "drySHA": r.DrySha, "sourceSHA": r.SourceSha})
+
+	out, sha, err := s.handlePromoteRequest(logCtx, r)
+	if err != nil {
+		logCtx.WithError(err).WithField("output", out).Error("failed to handle promote request")
+
+		// No need to wrap this error, sufficient context is build in handlePromoteRequest.
+		return &apiclient.PromoteHydratedManifestsResponse{}, err
+	}
+
+	logCtx.Info("Successfully handled promote request")
+	return &apiclient.PromoteHydratedManifestsResponse{
+		HydratedSha: sha,
+	}, nil
+}
+
+// handlePromoteRequest handles the promotion request. It returns the output of the git commands, the SHA of the commit
+// of the target branch and an error if one occurred. If the paths already have the content of the source commit, no
+// commit is pushed and the SHA of the current commit of the target branch is returned.
+func (s *Service) handlePromoteRequest(logCtx *log.Entry, r *apiclient.PromoteHydratedManifestsRequest) (string, string, error) {
+	if r.Repo == nil {
+		return "", "", errors.New("repo is required")
+	}
+	if r.Repo.Repo == "" {
+		return "", "", errors.New("repo URL is required")
+	}
+	if r.SourceSha == "" {
+		return "", "", errors.New("source sha is required")
+	}
+	if r.TargetBranch == "" {
+		return "", "", errors.New("target branch is required")
+	}
+	if len(r.Paths) == 0 {
+		return "", "", errors.New("paths are required")
+	}
+
+	logCtx = logCtx.WithField("repo", r.Repo.Repo)
+	logCtx.Debug("Initiating git client")
+	gitClient, _, cleanup, err := s.initGitClient(logCtx, r.Repo, r.AuthorName, r.AuthorEmail)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to init git client: %w", err)
+	}
+	defer cleanup()
+
+	logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
+	out, err := gitClient.CheckoutOrOrphan(r.TargetBranch, false)
+	if err != nil {
+		return out, "", fmt.Errorf("failed to checkout target branch: %w", err)
+	}
+
+	previousSha, err := gitClient.CommitSHA()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+
+	logCtx.Debugf("Copying paths %v from %s", r.Paths, r.SourceSha)
+	out, err = gitClient.RemoveContents(r.Paths)
+	if err != nil {
+		return out, "", fmt.Errorf("failed to remove paths: %w", err)
+	}
+	out, err = gitClient.CheckoutPaths(r.SourceSha, r.Paths)
+	if err != nil {
+		return out, "", fmt.Errorf("failed to copy paths: %w", err)
+	}
+
+	logCtx.Debug("Committing and pushing changes")
+	out, err = gitClient.CommitAndPush(r.TargetBranch, r.CommitMessage)
+	if err != nil {
+		return out, "", fmt.Errorf("failed to commit and push: %w", err)
+	}
+
+	logCtx.Debug("Getting commit SHA")
+	sha, err := gitClient.CommitSHA()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+	if sha == previousSha {
+		// The paths did not change, so nothing was committed.
+		return "", sha, nil
+	}
+	logCtx.Debug("Adding commit note")
+	err = AddNote(gitClient, r.DrySha, sha)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to add commit note: %w", err)
+	}
+	return "", sha, nil
+}
@@ -0,0 +1,83 @@
+package commit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	gitmocks "github.com/argoproj/argo-cd/v3/util/git/mocks"
+)
+
+func Test_PromoteHydratedManifests(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &apiclient.PromoteHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://github.com/argoproj/argocd-example-apps.git",
+		},
+		SourceSha:     "source-sha",
+		TargetBranch:  "env/staging",
+		DrySha:        "dry-sha",
+		Paths:         []string{"guestbook"},
+		CommitMessage: "promote guestbook",
+	}
+
+	t.Run("missing paths", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		request := &apiclient.PromoteHydratedManifestsRequest{
+			Repo:         validRequest.Repo,
+			SourceSha:    "source-sha",
+			TargetBranch: "env/staging",
+		}
+		_, err := service.PromoteHydratedManifests(t.Context(), request)
+		require.EqualError(t, err, "paths are required")
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/staging", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("previous-sha", nil).Once()
+		mockGitClient.EXPECT().RemoveContents([]string{"guestbook"}).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutPaths("source-sha", []string{"guestbook"}).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitAndPush("env/staging", "promote guestbook").Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("promoted-sha", nil).Once()
+		mockGitClient.EXPECT().AddAndPushNote("promoted-sha", NoteNamespace, `{"drySha":"dry-sha"}`).Return(nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.PromoteHydratedManifests(t.Context(), validRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "promoted-sha", resp.HydratedSha)
+	})
+
+	t.Run("already promoted", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/staging", false).Return("", nil).Once()
+		mockGitClient.EXPECT().RemoveContents([]string{"guestbook"}).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutPaths("source-sha", []string{"guestbook"}).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitAndPush("env/staging", "promote guestbook").Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("previous-sha", nil).Twice()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.PromoteHydratedManifests(t.Context(), validRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "previous-sha", resp.HydratedSha)
+	})
+}
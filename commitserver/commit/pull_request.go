@@ -0,0 +1,140 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// PullRequestCreatorFactory is a factory for creating the SCM clients opening pull requests for a repository.
+type PullRequestCreatorFactory interface {
+	NewCreator(ctx context.Context, repo *v1alpha1.Repository, pullRequest *v1alpha1.HydratorPullRequest) (pull_request.PullRequestCreator, error)
+}
+
+type pullRequestCreatorFactory struct{}
+
+// NewPullRequestCreatorFactory returns a new instance of the pull request creator factory.
+func NewPullRequestCreatorFactory() PullRequestCreatorFactory {
+	return &pullRequestCreatorFactory{}
+}
+
+// NewCreator creates a new SCM client for the provider of the pull request, authenticated with the credentials of the
+// repository. The password of the repository is used as the token of the providers authenticating with tokens. Since
+// the API URL of the pull request is set in the application, it must be on the server hosting the repository, so
+// that the credentials are not sent anywhere else.
+func (f *pullRequestCreatorFactory) NewCreator(ctx context.Context, repo *v1alpha1.Repository, pullRequest *v1alpha1.HydratorPullRequest) (pull_request.PullRequestCreator, error) {
+	host, path, err := parseRepoURL(repo.Repo)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAPIHost(pullRequest.API, host); err != nil {
+		return nil, err
+	}
+	segments := strings.Split(path, "/")
+	if len(segments) < 2 {
+		return nil, fmt.Errorf("repo URL %s does not have an owner and a repository", repo.Repo)
+	}
+	owner, name := segments[len(segments)-2], segments[len(segments)-1]
+
+	var svc pull_request.PullRequestService
+	switch pullRequest.Provider {
+	case v1alpha1.HydratorPullRequestProviderGitHub:
+		api := defaultAPI(pullRequest.API, host, "github.com", "https://%s/api/v3")
+		if repo.GithubAppId != 0 {
+			svc, err = pull_request.NewGithubAppService(ctx, github_app_auth.Authentication{
+				Id:                repo.GithubAppId,
+				InstallationId:    repo.GithubAppInstallationId,
+				EnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
+				PrivateKey:        repo.GithubAppPrivateKey,
+			}, api, owner, name, pullRequest.Labels)
+		} else {
+			svc, err = pull_request.NewGithubService(repo.Password, api, owner, name, pullRequest.Labels)
+		}
+	case v1alpha1.HydratorPullRequestProviderGitLab:
+		api := defaultAPI(pullRequest.API, host, "gitlab.com", "https://%s")
+		svc, err = pull_request.NewGitLabService(repo.Password, api, path, pullRequest.Labels, "", "", repo.Insecure, nil)
+	case v1alpha1.HydratorPullRequestProviderGitea:
+		api := defaultAPI(pullRequest.API, host, "", "https://%s")
+		svc, err = pull_request.NewGiteaService(repo.Password, api, owner, name, pullRequest.Labels, repo.Insecure)
+	case v1alpha1.HydratorPullRequestProviderBitbucketServer:
+		api := defaultAPI(pullRequest.API, host, "", "https://%s/rest")
+		if repo.BearerToken != "" {
+			svc, err = pull_request.NewBitbucketServiceBearerToken(ctx, repo.BearerToken, api, owner, name, "", repo.Insecure, nil)
+		} else {
+			svc, err = pull_request.NewBitbucketServiceBasicAuth(ctx, repo.Username, repo.Password, api, owner, name, "", repo.Insecure, nil)
+		}
+	case v1alpha1.HydratorPullRequestProviderBitbucketCloud:
+		svc, err = pull_request.NewBitbucketCloudServiceBasicAuth(pullRequest.API, repo.Username, repo.Password, owner, name)
+	case v1alpha1.HydratorPullRequestProviderAzureDevOps:
+		// Azure DevOps repositories are at dev.azure.com/organization/project/_git/repository over HTTPS and at
+		// ssh.dev.azure.com:v3/organization/project/repository over SSH.
+		segments = slices.DeleteFunc(segments, func(s string) bool { return s == "_git" || s == "v3" })
+		if len(segments) != 3 {
+			return nil, fmt.Errorf("repo URL %s is not an Azure DevOps repository URL", repo.Repo)
+		}
+		svc, err = pull_request.NewAzureDevOpsService(repo.Password, pullRequest.API, segments[0], segments[1], segments[2], pullRequest.Labels)
+	default:
+		return nil, fmt.Errorf("unknown pull request provider %q", pullRequest.Provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s client: %w", pullRequest.Provider, err)
+	}
+	creator, ok := svc.(pull_request.PullRequestCreator)
+	if !ok {
+		return nil, fmt.Errorf("%s client cannot open pull requests", pullRequest.Provider)
+	}
+	return creator, nil
+}
+
+// parseRepoURL returns the host and the path of the repository of an HTTPS or SSH repo URL, without the .git suffix.
+func parseRepoURL(repoURL string) (string, string, error) {
+	repoURL = strings.TrimSuffix(strings.TrimSpace(repoURL), ".git")
+	if ok, _ := git.IsSSHURL(repoURL); ok && !strings.HasPrefix(repoURL, "ssh://") {
+		// Replace the colon of git@server:path style SSH URLs so that the path is not parsed as the port.
+		repoURL = "ssh://" + strings.Replace(repoURL, ":", "/", 1)
+	}
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse repo URL: %w", err)
+	}
+	if u.Hostname() == "" {
+		return "", "", errors.New("repo URL does not have a host")
+	}
+	return u.Hostname(), strings.Trim(u.Path, "/"), nil
+}
+
+// validateAPIHost checks that the given API URL, if any, is on the given host of the repository, or on its api
+// subdomain, e.g. api.github.com for github.com. The ssh subdomain of the host of SSH repositories, e.g.
+// ssh.dev.azure.com, is ignored.
+func validateAPIHost(api, repoHost string) error {
+	if api == "" {
+		return nil
+	}
+	u, err := url.Parse(api)
+	if err != nil {
+		return fmt.Errorf("failed to parse pull request API URL: %w", err)
+	}
+	apiHost := strings.ToLower(u.Hostname())
+	serverHost := strings.TrimPrefix(strings.ToLower(repoHost), "ssh.")
+	if apiHost == "" || (apiHost != serverHost && apiHost != "api."+serverHost) {
+		return fmt.Errorf("pull request API URL %s is not on the host %s of the repository", api, repoHost)
+	}
+	return nil
+}
+
+// defaultAPI returns the configured API URL, or the API of the server hosting the repository if it is not the public
+// service of the provider, in which case the client defaults to the public API.
+func defaultAPI(api, host, publicHost, format string) string {
+	if api != "" || host == publicHost {
+		return api
+	}
+	return fmt.Sprintf(format, host)
+}
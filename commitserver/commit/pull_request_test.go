@@ -0,0 +1,97 @@
+package commit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_parseRepoURL(t *testing.T) {
+	for repoURL, expected := range map[string][2]string{
+		"https://github.com/argoproj/argo-cd.git":            {"github.com", "argoproj/argo-cd"},
+		"git@github.com:argoproj/argo-cd.git":                {"github.com", "argoproj/argo-cd"},
+		"https://gitlab.com/group/subgroup/project":          {"gitlab.com", "group/subgroup/project"},
+		"https://bitbucket.example.com/scm/proj/repo.git":    {"bitbucket.example.com", "scm/proj/repo"},
+		"ssh://git@bitbucket.example.com:7999/proj/repo.git": {"bitbucket.example.com", "proj/repo"},
+		"https://org@dev.azure.com/org/project/_git/repo":    {"dev.azure.com", "org/project/_git/repo"},
+		"git@ssh.dev.azure.com:v3/org/project/repo":          {"ssh.dev.azure.com", "v3/org/project/repo"},
+	} {
+		host, path, err := parseRepoURL(repoURL)
+		require.NoError(t, err, repoURL)
+		assert.Equal(t, expected, [2]string{host, path}, repoURL)
+	}
+
+	_, _, err := parseRepoURL("owner/repo")
+	require.Error(t, err)
+}
+
+func Test_pullRequestCreatorFactory_NewCreator(t *testing.T) {
+	factory := NewPullRequestCreatorFactory()
+
+	// The Gitea client is not tested since it requests the version of the server when it is created.
+
+	for _, provider := range []string{
+		v1alpha1.HydratorPullRequestProviderGitHub,
+		v1alpha1.HydratorPullRequestProviderGitLab,
+		v1alpha1.HydratorPullRequestProviderBitbucketServer,
+		v1alpha1.HydratorPullRequestProviderBitbucketCloud,
+	} {
+		t.Run(provider, func(t *testing.T) {
+			creator, err := factory.NewCreator(t.Context(), &v1alpha1.Repository{Repo: "https://scm.example.com/owner/repo.git", Password: "token"}, &v1alpha1.HydratorPullRequest{Provider: provider, API: "https://scm.example.com/api"})
+			require.NoError(t, err)
+			assert.Implements(t, (*pull_request.PullRequestCreator)(nil), creator)
+		})
+	}
+
+	t.Run(v1alpha1.HydratorPullRequestProviderAzureDevOps, func(t *testing.T) {
+		_, err := factory.NewCreator(t.Context(), &v1alpha1.Repository{Repo: "https://dev.azure.com/org/project/_git/repo"}, &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderAzureDevOps})
+		require.NoError(t, err)
+
+		_, err = factory.NewCreator(t.Context(), &v1alpha1.Repository{Repo: "https://dev.azure.com/org/repo"}, &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderAzureDevOps})
+		require.ErrorContains(t, err, "is not an Azure DevOps repository URL")
+	})
+
+	t.Run("API of another host", func(t *testing.T) {
+		_, err := factory.NewCreator(t.Context(), &v1alpha1.Repository{Repo: "https://scm.example.com/owner/repo.git", Password: "token"}, &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub, API: "https://attacker.example.org/api/v3"})
+		require.EqualError(t, err, "pull request API URL https://attacker.example.org/api/v3 is not on the host scm.example.com of the repository")
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		_, err := factory.NewCreator(t.Context(), &v1alpha1.Repository{Repo: "https://scm.example.com/owner/repo.git"}, &v1alpha1.HydratorPullRequest{Provider: "unknown"})
+		require.EqualError(t, err, `unknown pull request provider "unknown"`)
+	})
+}
+
+func Test_validateAPIHost(t *testing.T) {
+	for api, repoHost := range map[string]string{
+		"":                                       "github.com",
+		"https://api.github.com":                 "github.com",
+		"https://github.example.com/api/v3":      "github.example.com",
+		"https://GitLab.example.com":             "gitlab.example.com",
+		"https://api.bitbucket.org/2.0":          "bitbucket.org",
+		"https://dev.azure.com":                  "ssh.dev.azure.com",
+		"https://bitbucket.example.com:443/rest": "bitbucket.example.com",
+	} {
+		assert.NoError(t, validateAPIHost(api, repoHost), api)
+	}
+
+	for api, repoHost := range map[string]string{
+		"https://github.com.example.org": "github.com",
+		"https://example.com":            "gitlab.example.com",
+		"https://api.example.com":        "gitlab.example.com",
+		"/api/v3":                        "github.example.com",
+		"https://ssh.dev.azure.com.evil": "ssh.dev.azure.com",
+	} {
+		assert.Error(t, validateAPIHost(api, repoHost), api)
+	}
+}
+
+func Test_defaultAPI(t *testing.T) {
+	assert.Empty(t, defaultAPI("", "github.com", "github.com", "https://%s/api/v3"))
+	assert.Equal(t, "https://github.example.com/api/v3", defaultAPI("", "github.example.com", "github.com", "https://%s/api/v3"))
+	assert.Equal(t, "https://api.example.com", defaultAPI("https://api.example.com", "github.example.com", "github.com", "https://%s/api/v3"))
+}
@@ -14,19 +14,25 @@ type RepoClientFactory interface {
 type repoClientFactory struct {
 	gitCredsStore git.CredsStore
 	metricsServer *metrics.Server
+	commitSigning *git.CommitSigningOpts
 }
 
-// NewRepoClientFactory returns a new instance of the repo client factory.
-func NewRepoClientFactory(gitCredsStore git.CredsStore, metricsServer *metrics.Server) RepoClientFactory {
+// NewRepoClientFactory returns a new instance of the repo client factory. The commits of the clients are signed with
+// commitSigning, unless it is nil.
+func NewRepoClientFactory(gitCredsStore git.CredsStore, metricsServer *metrics.Server, commitSigning *git.CommitSigningOpts) RepoClientFactory {
 	return &repoClientFactory{
 		gitCredsStore: gitCredsStore,
 		metricsServer: metricsServer,
+		commitSigning: commitSigning,
 	}
 }
 
 // NewClient creates a new git client for the repository.
 func (r *repoClientFactory) NewClient(repo *v1alpha1.Repository, rootPath string) (git.Client, error) {
 	gitCreds := repo.GetGitCreds(r.gitCredsStore)
-	opts := git.WithEventHandlers(metrics.NewGitClientEventHandlers(r.metricsServer))
-	return git.NewClientExt(repo.Repo, rootPath, gitCreds, repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy, opts)
+	opts := []git.ClientOpts{
+		git.WithEventHandlers(metrics.NewGitClientEventHandlers(r.metricsServer)),
+		git.WithCommitSigning(r.commitSigning),
+	}
+	return git.NewClientExt(repo.Repo, rootPath, gitCreds, repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy, opts...)
 }
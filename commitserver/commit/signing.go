@@ -0,0 +1,87 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// NewCommitSigningOpts returns the options to sign hydrated commits with the private key at keyPath, in the given
+// format. The key is copied to a private temporary location since the mounted key is usually readable by others, which
+// is refused by ssh-keygen, and GnuPG keys are imported into a dedicated keyring. It returns nil if keyPath is empty.
+func NewCommitSigningOpts(format string, keyPath string) (*git.CommitSigningOpts, error) {
+	if keyPath == "" {
+		return nil, nil
+	}
+	key, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+
+	switch git.CommitSigningFormat(format) {
+	case git.CommitSigningFormatSSH:
+		dir, err := os.MkdirTemp("", "commit-signing-ssh")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create signing key directory: %w", err)
+		}
+		privateKeyPath := filepath.Join(dir, "signing.key")
+		err = os.WriteFile(privateKeyPath, key, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write signing key: %w", err)
+		}
+		return &git.CommitSigningOpts{Format: git.CommitSigningFormatSSH, Key: privateKeyPath}, nil
+	case git.CommitSigningFormatOpenPGP:
+		gnuPGHome, err := os.MkdirTemp("", "commit-signing-gnupg")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create GnuPG home directory: %w", err)
+		}
+		keyID, err := importGnuPGSigningKey(gnuPGHome, keyPath)
+		if err != nil {
+			return nil, err
+		}
+		return &git.CommitSigningOpts{Format: git.CommitSigningFormatOpenPGP, Key: keyID, GnuPGHome: gnuPGHome}, nil
+	default:
+		return nil, fmt.Errorf("unsupported signing key format %q: must be one of %s, %s", format, git.CommitSigningFormatOpenPGP, git.CommitSigningFormatSSH)
+	}
+}
+
+// importGnuPGSigningKey imports the GnuPG secret key at keyPath into the keyring at gnuPGHome and returns the
+// fingerprint of the key.
+func importGnuPGSigningKey(gnuPGHome string, keyPath string) (string, error) {
+	ctx := context.Background()
+	environ := append(os.Environ(), "GNUPGHOME="+gnuPGHome, "LANG=C")
+
+	cmd := exec.CommandContext(ctx, "gpg", "--no-permission-warning", "--logger-fd", "1", "--batch", "--import", keyPath)
+	cmd.Env = environ
+	_, err := executil.Run(cmd)
+	if err != nil {
+		return "", fmt.Errorf("failed to import signing key: %w", err)
+	}
+
+	cmd = exec.CommandContext(ctx, "gpg", "--no-permission-warning", "--batch", "--with-colons", "--list-secret-keys")
+	cmd.Env = environ
+	out, err := executil.Run(cmd)
+	if err != nil {
+		return "", fmt.Errorf("failed to list signing keys: %w", err)
+	}
+
+	// The fingerprint of a key is the first fpr record following its sec record.
+	secret := false
+	for line := range strings.SplitSeq(out, "\n") {
+		fields := strings.Split(line, ":")
+		switch {
+		case fields[0] == "sec":
+			secret = true
+		case fields[0] == "fpr" && secret && len(fields) > 9:
+			return fields[9], nil
+		}
+	}
+	return "", errors.New("signing key does not contain a GnuPG secret key")
+}
@@ -0,0 +1,105 @@
+package commit
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+func runTestCmd(t *testing.T, dir string, env []string, name string, args ...string) string {
+	t.Helper()
+	cmd := exec.CommandContext(t.Context(), name, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "error output: %s", out)
+	return string(out)
+}
+
+// killGPGAgent stops the agent started by GnuPG for the home directory.
+func killGPGAgent(gnuPGHome string) {
+	_ = exec.CommandContext(context.Background(), "gpgconf", "--homedir", gnuPGHome, "--kill", "gpg-agent").Run()
+}
+
+func TestNewCommitSigningOpts(t *testing.T) {
+	t.Run("no signing key", func(t *testing.T) {
+		opts, err := NewCommitSigningOpts(string(git.CommitSigningFormatOpenPGP), "")
+		require.NoError(t, err)
+		assert.Nil(t, opts)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		keyPath := filepath.Join(t.TempDir(), "signing.key")
+		require.NoError(t, os.WriteFile(keyPath, []byte("key"), 0o644))
+		_, err := NewCommitSigningOpts("x509", keyPath)
+		assert.ErrorContains(t, err, `unsupported signing key format "x509"`)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := NewCommitSigningOpts(string(git.CommitSigningFormatSSH), filepath.Join(t.TempDir(), "signing.key"))
+		assert.ErrorContains(t, err, "failed to read signing key")
+	})
+
+	t.Run("ssh", func(t *testing.T) {
+		keyPath := filepath.Join(t.TempDir(), "signing.key")
+		runTestCmd(t, "", nil, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath)
+		require.NoError(t, os.Chmod(keyPath, 0o644))
+
+		opts, err := NewCommitSigningOpts(string(git.CommitSigningFormatSSH), keyPath)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(opts.Key)) })
+
+		assert.Equal(t, git.CommitSigningFormatSSH, opts.Format)
+		assert.Empty(t, opts.GnuPGHome)
+		st, err := os.Stat(opts.Key)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), st.Mode().Perm())
+	})
+
+	t.Run("openpgp", func(t *testing.T) {
+		// Generate a key in a separate keyring and export its secret key, like it is mounted from a secret.
+		sourceHome := t.TempDir()
+		sourceEnv := []string{"GNUPGHOME=" + sourceHome}
+		t.Cleanup(func() { killGPGAgent(sourceHome) })
+		runTestCmd(t, "", sourceEnv, "gpg", "--batch", "--passphrase", "", "--quick-gen-key", "Argo CD <hydrator@example.com>", "ed25519", "sign", "never")
+		keyPath := filepath.Join(t.TempDir(), "signing.key")
+		runTestCmd(t, "", sourceEnv, "gpg", "--batch", "--armor", "--output", keyPath, "--export-secret-keys", "hydrator@example.com")
+
+		opts, err := NewCommitSigningOpts(string(git.CommitSigningFormatOpenPGP), keyPath)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			killGPGAgent(opts.GnuPGHome)
+			_ = os.RemoveAll(opts.GnuPGHome)
+		})
+
+		assert.Equal(t, git.CommitSigningFormatOpenPGP, opts.Format)
+		assert.Len(t, opts.Key, 40)
+		assert.NotEmpty(t, opts.GnuPGHome)
+
+		// Commits signed with the imported key can be verified with the public key.
+		originDir := t.TempDir()
+		runTestCmd(t, originDir, nil, "git", "init", "--bare")
+		client, err := git.NewClientExt("file://"+originDir, t.TempDir(), git.NopCreds{}, true, false, "", "", git.WithCommitSigning(opts))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+		_, err = client.SetAuthor("Argo CD", "hydrator@example.com")
+		require.NoError(t, err)
+		out, err := client.CheckoutOrOrphan("hydrated", false)
+		require.NoError(t, err, "error output: %s", out)
+		require.NoError(t, os.WriteFile(filepath.Join(client.Root(), ManifestYaml), []byte("kind: ConfigMap"), 0o644))
+		out, err = client.CommitAndPush("hydrated", "hydrate")
+		require.NoError(t, err, "error output: %s", out)
+
+		for _, revision := range []string{"hydrated", "hydrated^"} {
+			out = runTestCmd(t, originDir, sourceEnv, "git", "verify-commit", "--raw", revision)
+			assert.Contains(t, out, "GOODSIG")
+		}
+	})
+}
@@ -18,9 +18,10 @@ type ArgoCDCommitServer struct {
 	commitService *commit.Service
 }
 
-// NewServer returns a new instance of the commit server.
-func NewServer(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *ArgoCDCommitServer {
-	return &ArgoCDCommitServer{commitService: commit.NewService(gitCredsStore, metricsServer)}
+// NewServer returns a new instance of the commit server. Hydrated commits are signed with commitSigning, unless it is
+// nil.
+func NewServer(gitCredsStore git.CredsStore, metricsServer *metrics.Server, commitSigning *git.CommitSigningOpts) *ArgoCDCommitServer {
+	return &ArgoCDCommitServer{commitService: commit.NewService(gitCredsStore, metricsServer, commitSigning)}
 }
 
 // CreateGRPC creates a new gRPC server.
@@ -513,6 +513,7 @@ func (ctrl *ApplicationController) setAppManagedResources(destCluster *appv1.Clu
 	if err != nil {
 		return nil, fmt.Errorf("error getting resource tree: %w", err)
 	}
+	tree.Dependencies = ctrl.getDependencyGraph(a)
 	err = ctrl.cache.SetAppResourcesTree(a.InstanceName(ctrl.namespace), tree)
 	ts.AddCheckpoint("set_app_resources_tree_ms")
 	if err != nil {
@@ -1488,6 +1489,8 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			state.Message = fmt.Sprintf("Retrying operation%s. Attempt #%d", extraMsg, state.RetryCount)
 			state.FinishedAt = nil
 			state.SyncResult = nil
+			state.SyncBatches = nil
+			state.DryRunReport = nil
 			ctrl.setOperationState(app, state)
 			logCtx.Infof("Retrying operation%s. Attempt #%d", extraMsg, state.RetryCount)
 		default:
@@ -1507,6 +1510,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	terminating := state.Phase == synccommon.OperationTerminating
 	project, err := ctrl.getAppProj(app)
 	if err == nil {
+		if !terminating && (!awaitOperationApproval(app, project, state) || !ctrl.awaitDependencies(app, state)) {
+			ctrl.setOperationState(app, state)
+			return
+		}
 		// Start or resume the sync
 		ctrl.appStateManager.SyncAppState(app, project, state)
 	} else {
@@ -1529,6 +1536,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				// cleanup (e.g. delete jobs, workflows, etc...)
 			}
 		}
+		// Process the operation again when resources failing with a transient error are retried
+		if retryAfter := nextResourceRetryAfter(state); retryAfter != nil && state.Phase == synccommon.OperationRunning {
+			ctrl.requestAppRefresh(app.QualifiedName(), nil, retryAfter)
+		}
 	case synccommon.OperationFailed, synccommon.OperationError:
 		if !terminating && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0) {
 			now := metav1.Now()
@@ -1857,10 +1868,19 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
+	selfHealExcluded := ctrl.evaluateDrift(app, compareResult)
+	waitingForDependencies := ctrl.updateDependenciesCondition(app, compareResult.syncStatus.Status)
 	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
-	if canSync {
-		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
-		setOpDuration = opDuration
+	switch {
+	case !canSync:
+		logCtx.Info("Sync prevented by sync window")
+	case waitingForDependencies:
+		logCtx.Info("Sync prevented by dependencies")
+	default:
+		setOpDuration = ctrl.autoRollback(app, compareResult.syncStatus, compareResult.healthStatus)
+		setOpDuration += ctrl.scheduledSync(app, compareResult.syncStatus, refreshType)
+		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges, selfHealExcluded)
+		setOpDuration += opDuration
 		if syncErrCond != nil {
 			app.Status.SetConditions(
 				[]appv1.ApplicationCondition{*syncErrCond},
@@ -1872,8 +1892,6 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
 			)
 		}
-	} else {
-		logCtx.Info("Sync prevented by sync window")
 	}
 	ts.AddCheckpoint("auto_sync_ms")
 
@@ -1974,6 +1992,7 @@ func (ctrl *ApplicationController) processHydrationQueueItem() (processNext bool
 	logCtx := log.WithFields(log.Fields{
 		"sourceRepoURL":        hydrationKey.SourceRepoURL,
 		"sourceTargetRevision": hydrationKey.SourceTargetRevision,
+		"destinationRepoURL":   hydrationKey.DestinationRepoURL,
 		"destinationBranch":    hydrationKey.DestinationBranch,
 	})
 
@@ -2171,7 +2190,7 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 }
 
 // autoSync will initiate a sync operation for an application configured with automated sync
-func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool) (*appv1.ApplicationCondition, time.Duration) {
+func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool, selfHealExcluded map[kube.ResourceKey]bool) (*appv1.ApplicationCondition, time.Duration) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	ts := stats.NewTimingStats()
 	defer func() {
@@ -2189,6 +2208,10 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		logCtx.Infof("Skipping auto-sync: another operation is in progress")
 		return nil, 0
 	}
+	if isAutoSyncSuspendedByRollback(app) {
+		logCtx.Infof("Skipping auto-sync: application was automatically rolled back and requires a manual sync to resume")
+		return nil, 0
+	}
 	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
 		logCtx.Infof("Skipping auto-sync: deletion in progress")
 		return nil, 0
@@ -2261,15 +2284,8 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 			op.Sync.SelfHealAttemptsCount = app.Status.OperationState.Operation.Sync.SelfHealAttemptsCount
 		}
 
-		if remainingTime := ctrl.selfHealRemainingBackoff(app, int(op.Sync.SelfHealAttemptsCount)); remainingTime > 0 {
-			logCtx.Infof("Skipping auto-sync: already attempted sync to %s with timeout %v (retrying in %v)", lastAttemptedRevisions, ctrl.selfHealTimeout, remainingTime)
-			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remainingTime)
-			return nil, 0
-		}
-
-		op.Sync.SelfHealAttemptsCount++
 		for _, resource := range resources {
-			if resource.Status != appv1.SyncStatusCodeSynced {
+			if resource.Status != appv1.SyncStatusCodeSynced && !selfHealExcluded[kube.NewResourceKey(resource.Group, resource.Kind, resource.Namespace, resource.Name)] {
 				op.Sync.Resources = append(op.Sync.Resources, appv1.SyncOperationResource{
 					Kind:  resource.Kind,
 					Group: resource.Group,
@@ -2277,6 +2293,19 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 				})
 			}
 		}
+		// the drift of resources excluded by the self-heal policy is only reported
+		if len(selfHealExcluded) > 0 && len(op.Sync.Resources) == 0 {
+			logCtx.Infof("Skipping auto-sync: out of sync resources are excluded from self-heal")
+			return nil, 0
+		}
+
+		if remainingTime := ctrl.selfHealRemainingBackoff(app, int(op.Sync.SelfHealAttemptsCount)); remainingTime > 0 {
+			logCtx.Infof("Skipping auto-sync: already attempted sync to %s with timeout %v (retrying in %v)", lastAttemptedRevisions, ctrl.selfHealTimeout, remainingTime)
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remainingTime)
+			return nil, 0
+		}
+
+		op.Sync.SelfHealAttemptsCount++
 	}
 	ts.AddCheckpoint("already_attempted_check_ms")
 
@@ -2506,6 +2535,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			dependsOnIndex: dependsOnIndexFunc,
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -2559,6 +2589,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 					ctrl.appHydrateQueue.AddRateLimited(newApp.QualifiedName())
 				}
 				ctrl.clusterSharding.UpdateApp(newApp)
+				if oldOK && newOK && (oldApp.Status.Sync.Status != newApp.Status.Sync.Status || oldApp.Status.Health.Status != newApp.Status.Health.Status) {
+					ctrl.requestDependentsRefresh(newApp)
+				}
 			},
 			DeleteFunc: func(obj any) {
 				if !ctrl.canProcessApp(obj) {
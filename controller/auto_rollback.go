@@ -0,0 +1,178 @@
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+var autoRollbackConditionTypes = map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionAutoRollbackWarning: true}
+
+// isAutoSyncSuspendedByRollback returns true if the application was automatically rolled back and no sync has been
+// started manually since.
+func isAutoSyncSuspendedByRollback(app *appv1.Application) bool {
+	return len(app.Status.GetConditions(autoRollbackConditionTypes)) > 0
+}
+
+// autoRollback rolls the application back to the most recent healthy revision in its history when the last sync
+// failed or the application has been Degraded for longer than the configured timeout. The rollback is recorded with
+// an AutoRollbackWarning condition, which suspends automated sync until a sync is started manually.
+func (ctrl *ApplicationController) autoRollback(app *appv1.Application, syncStatus *appv1.SyncStatus, healthStatus health.HealthStatusCode) time.Duration {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.AutoRollback == nil {
+		return 0
+	}
+	if app.Operation != nil || (app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero()) {
+		return 0
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	policy := app.Spec.SyncPolicy.AutoRollback
+	opState := app.Status.OperationState
+
+	if conditions := app.Status.GetConditions(autoRollbackConditionTypes); len(conditions) > 0 {
+		rolledBackAt := conditions[0].LastTransitionTime
+		if opState == nil || opState.Operation.InitiatedBy.Automated || rolledBackAt == nil || !opState.StartedAt.After(rolledBackAt.Time) {
+			return 0
+		}
+		logCtx.Infof("Resuming automated sync: sync was started by %s after automatic rollback", opState.Operation.InitiatedBy.Username)
+		app.Status.SetConditions([]appv1.ApplicationCondition{}, autoRollbackConditionTypes)
+	}
+
+	markRevisionHealthy(app, healthStatus)
+
+	var reason string
+	var currentRevisions []string
+	switch {
+	case policy.OnSyncFailure && opState != nil && opState.Phase.Completed() && !opState.Phase.Successful() &&
+		opState.Operation.Sync != nil && !opState.Operation.Sync.DryRun:
+		reason = "sync operation failed: " + opState.Message
+		currentRevisions = attemptedRevisions(opState)
+	case policy.DegradedTimeout != "" && healthStatus == health.HealthStatusDegraded &&
+		app.Status.Health.Status == health.HealthStatusDegraded && app.Status.Health.LastTransitionTime != nil:
+		timeout, err := policy.DegradedTimeoutDuration()
+		if err != nil {
+			logCtx.WithError(err).Warnf("Skipping auto-rollback: invalid degraded timeout %q", policy.DegradedTimeout)
+			return 0
+		}
+		if remaining := timeout - time.Since(app.Status.Health.LastTransitionTime.Time); remaining > 0 {
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remaining)
+			return 0
+		}
+		reason = fmt.Sprintf("application has been Degraded for more than %s", policy.DegradedTimeout)
+		if len(app.Status.History) > 0 {
+			currentRevisions = historyRevisions(app.Status.History.LastRevisionHistory())
+		}
+	default:
+		return 0
+	}
+
+	target := lastHealthyRevision(app.Status.History, currentRevisions)
+	if target == nil {
+		logCtx.Warnf("Skipping auto-rollback (%s): no healthy revision found in history", reason)
+		return 0
+	}
+
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:     target.Revision,
+			Revisions:    target.Revisions,
+			Prune:        app.Spec.SyncPolicy.Automated != nil && app.Spec.SyncPolicy.Automated.Prune,
+			SyncOptions:  app.Spec.SyncPolicy.SyncOptions,
+			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
+			Sources:      target.Sources,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+	}
+	if !target.Source.IsZero() {
+		op.Sync.Source = &target.Source
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	start := time.Now()
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	setOpTime := time.Since(start)
+	if err != nil {
+		if stderrors.Is(err, argo.ErrAnotherOperationInProgress) {
+			logCtx.WithError(err).Warnf("Failed to initiate auto-rollback to history ID %d", target.ID)
+			return 0
+		}
+		logCtx.WithError(err).Errorf("Failed to initiate auto-rollback to history ID %d", target.ID)
+		return setOpTime
+	}
+	ctrl.writeBackToInformer(updatedApp)
+
+	message := fmt.Sprintf("Rolled back to history ID %d (%s) because %s. Automated sync is suspended until a sync is started manually", target.ID, formatHistoryRevisions(target), reason)
+	app.Status.SetConditions([]appv1.ApplicationCondition{{Type: appv1.ApplicationConditionAutoRollbackWarning, Message: message}}, autoRollbackConditionTypes)
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeWarning}, message)
+	logCtx.Info(message)
+	return setOpTime
+}
+
+// markRevisionHealthy records the time the most recently deployed revision was first observed Healthy
+func markRevisionHealthy(app *appv1.Application, healthStatus health.HealthStatusCode) {
+	if healthStatus != health.HealthStatusHealthy || len(app.Status.History) == 0 {
+		return
+	}
+	if opState := app.Status.OperationState; opState == nil || !opState.Phase.Successful() {
+		return
+	}
+	last := &app.Status.History[len(app.Status.History)-1]
+	if last.HealthyAt == nil {
+		now := metav1.Now()
+		last.HealthyAt = &now
+	}
+}
+
+// lastHealthyRevision returns the most recent history entry that was observed Healthy and does not match the given
+// revisions, or nil if there is none.
+func lastHealthyRevision(history appv1.RevisionHistories, currentRevisions []string) *appv1.RevisionHistory {
+	for i := len(history) - 1; i >= 0; i-- {
+		h := history[i]
+		if h.HealthyAt == nil || (h.Source.IsZero() && h.Sources.IsZero()) {
+			continue
+		}
+		if len(currentRevisions) > 0 && slices.Equal(historyRevisions(h), currentRevisions) {
+			continue
+		}
+		return &h
+	}
+	return nil
+}
+
+// attemptedRevisions returns the revisions the given operation synced or attempted to sync
+func attemptedRevisions(opState *appv1.OperationState) []string {
+	if opState.SyncResult != nil {
+		if len(opState.SyncResult.Revisions) > 0 {
+			return opState.SyncResult.Revisions
+		}
+		return []string{opState.SyncResult.Revision}
+	}
+	if len(opState.Operation.Sync.Revisions) > 0 {
+		return opState.Operation.Sync.Revisions
+	}
+	return []string{opState.Operation.Sync.Revision}
+}
+
+func historyRevisions(h appv1.RevisionHistory) []string {
+	if len(h.Revisions) > 0 {
+		return h.Revisions
+	}
+	return []string{h.Revision}
+}
+
+func formatHistoryRevisions(h *appv1.RevisionHistory) string {
+	if len(h.Revisions) > 0 {
+		return fmt.Sprintf("revisions %v", h.Revisions)
+	}
+	return "revision " + h.Revision
+}
@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newFakeAppWithRollbackHistory() *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.AutoRollback = &v1alpha1.AutoRollbackPolicy{OnSyncFailure: true, DegradedTimeout: "5m"}
+	healthyAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 1, Revision: "1111111111111111111111111111111111111111", Source: *app.Spec.Source, HealthyAt: &healthyAt},
+		{ID: 2, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Source: *app.Spec.Source},
+	}
+	return app
+}
+
+func TestAutoRollback(t *testing.T) {
+	syncStatus := &v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+
+	t.Run("RollbackOnSyncFailure", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory()
+		app.Status.OperationState.Phase = synccommon.OperationFailed
+		app.Status.OperationState.Message = "one or more objects failed to apply"
+		app.Status.OperationState.SyncResult.Revision = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.autoRollback(app, syncStatus, health.HealthStatusProgressing)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, updatedApp.Operation)
+		assert.Equal(t, "1111111111111111111111111111111111111111", updatedApp.Operation.Sync.Revision)
+		assert.True(t, updatedApp.Operation.InitiatedBy.Automated)
+		conditions := app.Status.GetConditions(autoRollbackConditionTypes)
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "Rolled back to history ID 1")
+		assert.Contains(t, conditions[0].Message, "one or more objects failed to apply")
+	})
+
+	t.Run("RollbackWhenDegradedPastTimeout", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory()
+		app.Status.Health = v1alpha1.AppHealthStatus{
+			Status:             health.HealthStatusDegraded,
+			LastTransitionTime: &metav1.Time{Time: time.Now().Add(-10 * time.Minute)},
+		}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.autoRollback(app, syncStatus, health.HealthStatusDegraded)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, updatedApp.Operation)
+		assert.Equal(t, "1111111111111111111111111111111111111111", updatedApp.Operation.Sync.Revision)
+		assert.True(t, isAutoSyncSuspendedByRollback(app))
+	})
+
+	t.Run("NoRollbackWhenDegradedWithinTimeout", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory()
+		app.Status.Health = v1alpha1.AppHealthStatus{
+			Status:             health.HealthStatusDegraded,
+			LastTransitionTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+		}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.autoRollback(app, syncStatus, health.HealthStatusDegraded)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+		assert.False(t, isAutoSyncSuspendedByRollback(app))
+	})
+
+	t.Run("NoRollbackWithoutHealthyRevision", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory()
+		app.Status.History[0].HealthyAt = nil
+		app.Status.OperationState.Phase = synccommon.OperationFailed
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.autoRollback(app, syncStatus, health.HealthStatusProgressing)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+	})
+
+	t.Run("MarksDeployedRevisionHealthy", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.autoRollback(app, syncStatus, health.HealthStatusHealthy)
+
+		assert.NotNil(t, app.Status.History[1].HealthyAt)
+	})
+
+	t.Run("ResumesAfterManualSync", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory()
+		rolledBackAt := metav1.NewTime(time.Now().Add(-time.Hour))
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionAutoRollbackWarning, Message: "rolled back", LastTransitionTime: &rolledBackAt}}
+		app.Status.OperationState.StartedAt = metav1.Now()
+		app.Status.OperationState.Operation.InitiatedBy = v1alpha1.OperationInitiator{Username: "admin"}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.autoRollback(app, syncStatus, health.HealthStatusHealthy)
+
+		assert.False(t, isAutoSyncSuspendedByRollback(app))
+	})
+
+	t.Run("StaysSuspendedWithoutManualSync", func(t *testing.T) {
+		app := newFakeAppWithRollbackHistory()
+		rolledBackAt := metav1.Now()
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionAutoRollbackWarning, Message: "rolled back", LastTransitionTime: &rolledBackAt}}
+		app.Status.OperationState.Phase = synccommon.OperationFailed
+		app.Status.OperationState.Operation.InitiatedBy = v1alpha1.OperationInitiator{Automated: true}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.autoRollback(app, syncStatus, health.HealthStatusDegraded)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+		assert.True(t, isAutoSyncSuspendedByRollback(app))
+	})
+}
+
+func TestAutoSyncSuspendedByRollback(t *testing.T) {
+	app := newFakeApp()
+	now := metav1.Now()
+	app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionAutoRollbackWarning, Message: "rolled back", LastTransitionTime: &now}}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: "Deployment", Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestLastHealthyRevision(t *testing.T) {
+	healthyAt := metav1.Now()
+	source := v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"}
+	history := v1alpha1.RevisionHistories{
+		{ID: 1, Revision: "a", Source: source, HealthyAt: &healthyAt},
+		{ID: 2, Revision: "b", Source: source, HealthyAt: &healthyAt},
+		{ID: 3, Revision: "c", Source: source},
+	}
+
+	assert.Equal(t, int64(2), lastHealthyRevision(history, []string{"c"}).ID)
+	assert.Equal(t, int64(1), lastHealthyRevision(history, []string{"b"}).ID)
+	assert.Nil(t, lastHealthyRevision(history[2:], []string{"d"}))
+	assert.Nil(t, lastHealthyRevision(v1alpha1.RevisionHistories{{ID: 1, Revision: "a", HealthyAt: &healthyAt}}, nil))
+}
@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/cache"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+)
+
+// dependsOnIndex indexes applications by the namespace and name of the applications they depend on
+const dependsOnIndex = "dependsOn"
+
+var dependenciesConditionTypes = map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionWaitingForDependencies: true}
+
+func dependsOnIndexFunc(obj any) ([]string, error) {
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return nil, nil
+	}
+	var keys []string
+	for _, dependency := range app.Spec.DependsOn {
+		keys = append(keys, dependency.QualifiedName(app.Namespace))
+	}
+	return keys, nil
+}
+
+// requestDependentsRefresh requests a refresh of the applications which depend on the given application, and the
+// processing of their pending operations, so that they do not keep waiting once the application is synced and healthy
+func (ctrl *ApplicationController) requestDependentsRefresh(app *appv1.Application) {
+	dependents, err := ctrl.appInformer.GetIndexer().ByIndex(dependsOnIndex, app.Namespace+"/"+app.Name)
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).WithError(err).Warn("Failed to list dependent applications")
+		return
+	}
+	for _, obj := range dependents {
+		dependent, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		ctrl.requestAppRefresh(dependent.QualifiedName(), CompareWithRecent.Pointer(), nil)
+		if key, err := cache.MetaNamespaceKeyFunc(dependent); err == nil {
+			ctrl.appOperationQueue.AddRateLimited(key)
+		}
+	}
+}
+
+// getDependencyGraph returns the applications the given application depends on, directly or through other
+// dependencies, along with their sync and health status. Only applications of the same project as the given
+// application can be dependencies, so that the graph does not expose the status of applications of other projects:
+// other applications are reported as not found.
+func (ctrl *ApplicationController) getDependencyGraph(app *appv1.Application) []appv1.ApplicationDependencyNode {
+	var nodes []appv1.ApplicationDependencyNode
+	visited := map[string]bool{}
+	queue := resolveDependencies(app)
+	for len(queue) > 0 {
+		dependency := queue[0]
+		queue = queue[1:]
+		key := dependency.QualifiedName("")
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		node := appv1.ApplicationDependencyNode{ApplicationDependency: dependency}
+		if dependencyApp, err := ctrl.appLister.Applications(dependency.Namespace).Get(dependency.Name); err == nil && dependencyApp.Spec.GetProject() == app.Spec.GetProject() {
+			node.SyncStatus = dependencyApp.Status.Sync.Status
+			node.HealthStatus = dependencyApp.Status.Health.Status
+			node.DependsOn = resolveDependencies(dependencyApp)
+			queue = append(queue, node.DependsOn...)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// resolveDependencies returns the direct dependencies of the given application, with their namespace set
+func resolveDependencies(app *appv1.Application) []appv1.ApplicationDependency {
+	var dependencies []appv1.ApplicationDependency
+	for _, dependency := range app.Spec.DependsOn {
+		if dependency.Namespace == "" {
+			dependency.Namespace = app.Namespace
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies
+}
+
+// waitingForDependencies returns a message describing why the given application cannot be synced yet because of its
+// dependencies, or an empty string if all of its direct dependencies are synced and healthy
+func (ctrl *ApplicationController) waitingForDependencies(app *appv1.Application) string {
+	if len(app.Spec.DependsOn) == 0 {
+		return ""
+	}
+	graph := ctrl.getDependencyGraph(app)
+	statuses := make(map[string]appv1.ApplicationDependencyNode, len(graph))
+	for _, node := range graph {
+		statuses[node.QualifiedName("")] = node
+	}
+	if _, ok := statuses[app.Namespace+"/"+app.Name]; ok {
+		return fmt.Sprintf("Waiting for dependencies: application %s/%s depends on itself", app.Namespace, app.Name)
+	}
+
+	var waiting []string
+	for _, dependency := range resolveDependencies(app) {
+		node := statuses[dependency.QualifiedName("")]
+		switch {
+		case node.SyncStatus == "":
+			waiting = append(waiting, node.QualifiedName("")+" (not found)")
+		case node.SyncStatus != appv1.SyncStatusCodeSynced || node.HealthStatus != health.HealthStatusHealthy:
+			waiting = append(waiting, fmt.Sprintf("%s (%s, %s)", node.QualifiedName(""), node.SyncStatus, node.HealthStatus))
+		}
+	}
+	if len(waiting) == 0 {
+		return ""
+	}
+	return "Waiting for dependencies: " + strings.Join(waiting, ", ")
+}
+
+// updateDependenciesCondition sets the WaitingForDependencies condition of the given application if it is about to
+// be synced, either by an operation or by automated sync, while its dependencies are not synced and healthy, and
+// removes it otherwise. It returns true if the application is waiting for its dependencies.
+func (ctrl *ApplicationController) updateDependenciesCondition(app *appv1.Application, syncStatus appv1.SyncStatusCode) bool {
+	message := ""
+	autoSync := app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.IsAutomatedSyncEnabled() && syncStatus == appv1.SyncStatusCodeOutOfSync
+	if app.Operation != nil || autoSync {
+		message = ctrl.waitingForDependencies(app)
+	}
+	if message == "" {
+		app.Status.SetConditions([]appv1.ApplicationCondition{}, dependenciesConditionTypes)
+		return false
+	}
+	app.Status.SetConditions([]appv1.ApplicationCondition{{Type: appv1.ApplicationConditionWaitingForDependencies, Message: message}}, dependenciesConditionTypes)
+	return true
+}
+
+// awaitDependencies returns true if the given operation may run because the dependencies of the application are
+// synced and healthy. Otherwise, the operation keeps running until they are. Dry-run syncs do not wait since they do
+// not change the cluster.
+func (ctrl *ApplicationController) awaitDependencies(app *appv1.Application, state *appv1.OperationState) bool {
+	if state.Operation.Sync == nil || state.Operation.Sync.DryRun {
+		return true
+	}
+	message := ctrl.waitingForDependencies(app)
+	if message == "" {
+		return true
+	}
+	state.Phase = common.OperationRunning
+	state.Message = message
+	return false
+}
@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newDependencyApp(name string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...string) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Status.Sync.Status = syncStatus
+	app.Status.Health.Status = healthStatus
+	for _, dependency := range dependsOn {
+		app.Spec.DependsOn = append(app.Spec.DependsOn, v1alpha1.ApplicationDependency{Name: dependency})
+	}
+	return app
+}
+
+func TestGetDependencyGraph(t *testing.T) {
+	app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "backend", "ingress")
+	backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "database")
+	database := newDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing)
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, backend, database, &defaultProj}}, nil)
+
+	graph := ctrl.getDependencyGraph(app)
+
+	dependency := func(name string) v1alpha1.ApplicationDependency {
+		return v1alpha1.ApplicationDependency{Namespace: test.FakeArgoCDNamespace, Name: name}
+	}
+	assert.Equal(t, []v1alpha1.ApplicationDependencyNode{
+		{ApplicationDependency: dependency("backend"), SyncStatus: v1alpha1.SyncStatusCodeSynced, HealthStatus: health.HealthStatusHealthy, DependsOn: []v1alpha1.ApplicationDependency{dependency("database")}},
+		{ApplicationDependency: dependency("ingress")},
+		{ApplicationDependency: dependency("database"), SyncStatus: v1alpha1.SyncStatusCodeSynced, HealthStatus: health.HealthStatusProgressing},
+	}, graph)
+}
+
+func TestWaitingForDependencies(t *testing.T) {
+	t.Run("NoDependencies", func(t *testing.T) {
+		app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		assert.Empty(t, ctrl.waitingForDependencies(app))
+	})
+
+	t.Run("DependenciesReady", func(t *testing.T) {
+		app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "backend")
+		backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, backend, &defaultProj}}, nil)
+		assert.Empty(t, ctrl.waitingForDependencies(app))
+	})
+
+	t.Run("DependenciesNotReady", func(t *testing.T) {
+		app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "backend", "ingress")
+		backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusDegraded)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, backend, &defaultProj}}, nil)
+		assert.Equal(t, "Waiting for dependencies: fake-argocd-ns/backend (Synced, Degraded), fake-argocd-ns/ingress (not found)", ctrl.waitingForDependencies(app))
+	})
+
+	t.Run("DependencyOfOtherProject", func(t *testing.T) {
+		app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "backend")
+		backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		backend.Spec.Project = "other"
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, backend, &defaultProj}}, nil)
+		assert.Equal(t, "Waiting for dependencies: fake-argocd-ns/backend (not found)", ctrl.waitingForDependencies(app))
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "backend")
+		backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "frontend")
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, backend, &defaultProj}}, nil)
+		assert.Equal(t, "Waiting for dependencies: application fake-argocd-ns/frontend depends on itself", ctrl.waitingForDependencies(app))
+	})
+}
+
+func TestUpdateDependenciesCondition(t *testing.T) {
+	app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "backend")
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}}
+	backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, backend, &defaultProj}}, nil)
+
+	assert.True(t, ctrl.updateDependenciesCondition(app, v1alpha1.SyncStatusCodeOutOfSync))
+	conditions := app.Status.GetConditions(dependenciesConditionTypes)
+	require.Len(t, conditions, 1)
+	assert.Equal(t, "Waiting for dependencies: fake-argocd-ns/backend (OutOfSync, Healthy)", conditions[0].Message)
+
+	// a synced application does not wait for its dependencies since automated sync has nothing to do
+	assert.False(t, ctrl.updateDependenciesCondition(app, v1alpha1.SyncStatusCodeSynced))
+	assert.Empty(t, app.Status.GetConditions(dependenciesConditionTypes))
+}
+
+func TestDependsOnIndexFunc(t *testing.T) {
+	app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "backend")
+	app.Spec.DependsOn = append(app.Spec.DependsOn, v1alpha1.ApplicationDependency{Name: "ingress", Namespace: "infra"})
+
+	keys, err := dependsOnIndexFunc(app)
+	require.NoError(t, err)
+	assert.Equal(t, []string{test.FakeArgoCDNamespace + "/backend", "infra/ingress"}, keys)
+}
+
+func TestProcessRequestedAppOperation_WaitingForDependencies(t *testing.T) {
+	app := newDependencyApp("my-app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "backend")
+	app.Status.OperationState = nil
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing)
+	data := &fakeData{
+		apps: []runtime.Object{app, backend, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+	}
+	ctrl := newFakeController(t.Context(), data, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, string(synccommon.OperationRunning), phase)
+	assert.Equal(t, "Waiting for dependencies: fake-argocd-ns/backend (Synced, Progressing)", message)
+}
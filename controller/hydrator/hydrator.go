@@ -132,6 +132,9 @@ func (h *Hydrator) ProcessAppHydrateQueueItem(origApp *appv1.Application) {
 		h.dependencies.AddHydrationQueueItem(getHydrationQueueKey(app))
 	} else {
 		logCtx.WithField("reason", reason).Debug("Skipping hydration")
+		if app.Status.SourceHydrator.CurrentOperation.Phase == appv1.HydrateOperationPhaseHydrated {
+			h.promote(logCtx, origApp)
+		}
 	}
 
 	logCtx.Debug("Successfully processed app hydrate queue item")
@@ -141,6 +144,7 @@ func getHydrationQueueKey(app *appv1.Application) types.HydrationQueueKey {
 	key := types.HydrationQueueKey{
 		SourceRepoURL:        git.NormalizeGitURLAllowInvalid(app.Spec.SourceHydrator.DrySource.RepoURL),
 		SourceTargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
+		DestinationRepoURL:   git.NormalizeGitURLAllowInvalid(app.Spec.GetHydrateToSource().RepoURL),
 		DestinationBranch:    app.Spec.GetHydrateToSource().TargetRevision,
 	}
 	return key
@@ -154,6 +158,7 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 	logCtx := log.WithFields(log.Fields{
 		"sourceRepoURL":        hydrationKey.SourceRepoURL,
 		"sourceTargetRevision": hydrationKey.SourceTargetRevision,
+		"destinationRepoURL":   hydrationKey.DestinationRepoURL,
 		"destinationBranch":    hydrationKey.DestinationBranch,
 	})
 
@@ -203,7 +208,7 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 	}
 
 	// Hydrate all the apps
-	drySHA, hydratedSHA, appErrors, err := h.hydrate(logCtx, apps, projects)
+	drySHA, hydratedSHA, pullRequestURL, appErrors, err := h.hydrate(logCtx, apps, projects)
 	if err != nil {
 		// If there is a single error, it affects each applications
 		for i := range apps {
@@ -245,12 +250,14 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 			DrySHA:         drySHA,
 			HydratedSHA:    hydratedSHA,
 			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			PullRequestURL: pullRequestURL,
 		}
 		app.Status.SourceHydrator.CurrentOperation = operation
 		app.Status.SourceHydrator.LastSuccessfulOperation = &appv1.SuccessfulHydrateOperation{
 			DrySHA:         drySHA,
 			HydratedSHA:    hydratedSHA,
 			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			PullRequestURL: pullRequestURL,
 		}
 		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
 
@@ -349,13 +356,14 @@ func (h *Hydrator) validateApplications(apps []*appv1.Application) (map[string]*
 	return projects, errors
 }
 
-func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject) (string, string, map[string]error, error) {
+func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject) (string, string, string, map[string]error, error) {
 	errors := make(map[string]error)
 	if len(apps) == 0 {
-		return "", "", nil, nil
+		return "", "", "", nil, nil
 	}
 
 	// These values are the same for all apps being hydrated together, so just get them from the first app.
+	dryRepoURL := apps[0].Spec.SourceHydrator.DrySource.RepoURL
 	repoURL := apps[0].Spec.GetHydrateToSource().RepoURL
 	targetBranch := apps[0].Spec.GetHydrateToSource().TargetRevision
 	// FIXME: As a convenience, the commit server will create the syncBranch if it does not exist. If the
@@ -369,7 +377,7 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	targetRevision, pathDetails, err := h.getManifests(context.Background(), apps[0], "", projects[apps[0].Spec.Project])
 	if err != nil {
 		errors[apps[0].QualifiedName()] = fmt.Errorf("failed to get manifests: %w", err)
-		return "", "", errors, nil
+		return "", "", "", errors, nil
 	}
 	paths := []*commitclient.PathDetails{pathDetails}
 	logCtx = logCtx.WithFields(log.Fields{"drySha": targetRevision})
@@ -377,7 +385,8 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	// We only inspect one app. If apps have been added/removed, that will be handled on the next DRY commit.
 	if apps[0].Status.SourceHydrator.LastSuccessfulOperation != nil && targetRevision == apps[0].Status.SourceHydrator.LastSuccessfulOperation.DrySHA {
 		logCtx.Debug("Skipping hydration since the DRY commit was already hydrated")
-		return targetRevision, apps[0].Status.SourceHydrator.LastSuccessfulOperation.HydratedSHA, nil, nil
+		lastOperation := apps[0].Status.SourceHydrator.LastSuccessfulOperation
+		return targetRevision, lastOperation.HydratedSHA, lastOperation.PullRequestURL, nil, nil
 	}
 
 	eg, ctx := errgroup.WithContext(context.Background())
@@ -397,7 +406,7 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 		})
 	}
 	if err := eg.Wait(); err != nil {
-		return targetRevision, "", errors, nil
+		return targetRevision, "", "", errors, nil
 	}
 
 	// If all the apps are under the same project, use that project. Otherwise, use an empty string to indicate that we
@@ -411,14 +420,14 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	}
 
 	// Get the commit metadata for the target revision.
-	revisionMetadata, err := h.getRevisionMetadata(context.Background(), repoURL, project, targetRevision)
+	revisionMetadata, err := h.getRevisionMetadata(context.Background(), dryRepoURL, project, targetRevision)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get revision metadata for %q: %w", targetRevision, err)
+		return targetRevision, "", "", errors, fmt.Errorf("failed to get revision metadata for %q: %w", targetRevision, err)
 	}
 
 	repo, err := h.dependencies.GetWriteCredentials(context.Background(), repoURL, project)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrator credentials: %w", err)
+		return targetRevision, "", "", errors, fmt.Errorf("failed to get hydrator credentials: %w", err)
 	}
 	if repo == nil {
 		// Try without credentials.
@@ -430,21 +439,21 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	// get the commit message template
 	commitMessageTemplate, err := h.dependencies.GetHydratorCommitMessageTemplate()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrated commit message template: %w", err)
+		return targetRevision, "", "", errors, fmt.Errorf("failed to get hydrated commit message template: %w", err)
 	}
-	commitMessage, errMsg := getTemplatedCommitMessage(repoURL, targetRevision, commitMessageTemplate, revisionMetadata)
+	commitMessage, errMsg := getTemplatedCommitMessage(dryRepoURL, targetRevision, commitMessageTemplate, revisionMetadata)
 	if errMsg != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrator commit templated message: %w", errMsg)
+		return targetRevision, "", "", errors, fmt.Errorf("failed to get hydrator commit templated message: %w", errMsg)
 	}
 
 	// get commit author configuration from argocd-cm
 	authorName, err := h.dependencies.GetCommitAuthorName()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get commit author name: %w", err)
+		return targetRevision, "", "", errors, fmt.Errorf("failed to get commit author name: %w", err)
 	}
 	authorEmail, err := h.dependencies.GetCommitAuthorEmail()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get commit author email: %w", err)
+		return targetRevision, "", "", errors, fmt.Errorf("failed to get commit author email: %w", err)
 	}
 
 	manifestsRequest := commitclient.CommitHydratedManifestsRequest{
@@ -457,18 +466,25 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 		DryCommitMetadata: revisionMetadata,
 		AuthorName:        authorName,
 		AuthorEmail:       authorEmail,
+		PullRequest:       apps[0].Spec.SourceHydrator.PullRequest,
+		DryRepoURL:        dryRepoURL,
 	}
 
 	closer, commitService, err := h.commitClientset.NewCommitServerClient()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to create commit service: %w", err)
+		return targetRevision, "", "", errors, fmt.Errorf("failed to create commit service: %w", err)
 	}
 	defer utilio.Close(closer)
 	resp, err := commitService.CommitHydratedManifests(context.Background(), &manifestsRequest)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to commit hydrated manifests: %w", err)
+		return targetRevision, "", "", errors, fmt.Errorf("failed to commit hydrated manifests: %w", err)
+	}
+	pullRequestURL := resp.PullRequestURL
+	if pullRequestURL == "" && apps[0].Status.SourceHydrator.LastSuccessfulOperation != nil && apps[0].Status.SourceHydrator.LastSuccessfulOperation.HydratedSHA == resp.HydratedSha {
+		// Nothing was pushed, so the pull request of the last hydration is still the one for the hydrated commit.
+		pullRequestURL = apps[0].Status.SourceHydrator.LastSuccessfulOperation.PullRequestURL
 	}
-	return targetRevision, resp.HydratedSha, errors, nil
+	return targetRevision, resp.HydratedSha, pullRequestURL, errors, nil
 }
 
 // getManifests gets the manifests for the given application and target revision. It returns the resolved revision
@@ -505,11 +521,16 @@ func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, tar
 		manifestDetails[i] = &commitclient.HydratedManifestDetails{ManifestJSON: string(objJSON)}
 	}
 
-	return resp.Revision, &commitclient.PathDetails{
+	pathDetails = &commitclient.PathDetails{
 		Path:      app.Spec.SourceHydrator.SyncSource.Path,
 		Manifests: manifestDetails,
 		Commands:  resp.Commands,
-	}, nil
+		Layout:    app.Spec.SourceHydrator.Layout,
+	}
+	if layout := app.Spec.SourceHydrator.Layout; layout != nil && layout.HelmValues {
+		pathDetails.HelmValues = resp.HelmValues
+	}
+	return resp.Revision, pathDetails, nil
 }
 
 func (h *Hydrator) getRevisionMetadata(ctx context.Context, repoURL, project, revision string) (*appv1.RevisionMetadata, error) {
@@ -0,0 +1,247 @@
+package hydrator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
+
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// promotionSource is the branch hydrated manifests are promoted from to the next promotion stage.
+type promotionSource struct {
+	branch      string
+	drySHA      string
+	hydratedSHA string
+	// since is when the hydrated manifests reached the branch.
+	since time.Time
+}
+
+// promote promotes the hydrated manifests of the application through its promotion stages, in order. Each stage
+// receives the hydrated manifests of the previous stage, or of the SyncSource target branch for the first stage, once
+// its gate passes. The promotion stops at the first stage whose gate does not pass or whose promotion fails, and the
+// status of the stages is persisted in the application status.
+func (h *Hydrator) promote(logCtx *log.Entry, origApp *appv1.Application) {
+	app := origApp.DeepCopy()
+	stages := app.Spec.SourceHydrator.PromotionStages
+	lastOperation := app.Status.SourceHydrator.LastSuccessfulOperation
+	if len(stages) == 0 || lastOperation == nil {
+		if len(stages) == 0 && len(app.Status.SourceHydrator.PromotionStages) > 0 {
+			app.Status.SourceHydrator.PromotionStages = nil
+			h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+		}
+		return
+	}
+
+	source := promotionSource{
+		branch:      app.Spec.SourceHydrator.SyncSource.TargetBranch,
+		drySHA:      lastOperation.DrySHA,
+		hydratedSHA: lastOperation.HydratedSHA,
+	}
+	if currentOperation := app.Status.SourceHydrator.CurrentOperation; currentOperation != nil && currentOperation.DrySHA == lastOperation.DrySHA && currentOperation.FinishedAt != nil {
+		source.since = currentOperation.FinishedAt.Time
+	}
+
+	statuses := make([]appv1.PromotionStageStatus, len(stages))
+	blocked := false
+	for i, stage := range stages {
+		status := appv1.PromotionStageStatus{TargetBranch: stage.TargetBranch}
+		for _, s := range app.Status.SourceHydrator.PromotionStages {
+			if s.TargetBranch == stage.TargetBranch {
+				status = s
+				break
+			}
+		}
+		if !blocked {
+			status = h.promoteStage(logCtx.WithField("stage", stage.TargetBranch), app, stage, status, source)
+			blocked = status.Phase != appv1.PromotionStagePhasePromoted || status.DrySHA != source.drySHA
+		}
+		statuses[i] = status
+		if status.Phase == appv1.PromotionStagePhasePromoted && status.PromotedAt != nil {
+			source = promotionSource{
+				branch:      stage.TargetBranch,
+				drySHA:      status.DrySHA,
+				hydratedSHA: status.HydratedSHA,
+				since:       status.PromotedAt.Time,
+			}
+		}
+	}
+
+	if reflect.DeepEqual(statuses, app.Status.SourceHydrator.PromotionStages) {
+		return
+	}
+	app.Status.SourceHydrator.PromotionStages = statuses
+	h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+}
+
+// promoteStage promotes the hydrated manifests of the source to the stage if they were not promoted yet and the gate
+// of the stage passes. It returns the updated status of the stage.
+func (h *Hydrator) promoteStage(logCtx *log.Entry, app *appv1.Application, stage appv1.PromotionStage, status appv1.PromotionStageStatus, source promotionSource) appv1.PromotionStageStatus {
+	if status.Phase == appv1.PromotionStagePhasePromoted && status.DrySHA == source.drySHA {
+		return status
+	}
+	if message := h.checkPromotionGate(app, stage, source); message != "" {
+		logCtx.Debugf("Promotion gate not passed: %s", message)
+		status.Phase = appv1.PromotionStagePhaseWaiting
+		status.Message = message
+		return status
+	}
+
+	logCtx.Infof("Promoting dry SHA %s from %s", source.drySHA, source.branch)
+	hydratedSHA, err := h.promoteToBranch(app, stage.TargetBranch, source)
+	if err != nil {
+		logCtx.WithError(err).Error("Failed to promote hydrated manifests")
+		status.Phase = appv1.PromotionStagePhaseFailed
+		status.Message = fmt.Sprintf("Failed to promote %s from %s: %v", source.drySHA, source.branch, err)
+		return status
+	}
+	promotedAt := metav1.Now()
+
+	// Request a refresh of the applications syncing from the stage since we pushed a new commit.
+	if apps, err := h.getAppsSyncingFrom(app, stage.TargetBranch); err == nil {
+		for _, a := range apps {
+			if err := h.dependencies.RequestAppRefresh(a.Name, a.Namespace); err != nil {
+				logCtx.WithFields(applog.GetAppLogFields(a)).WithError(err).Error("Failed to request app refresh after promotion")
+			}
+		}
+	}
+	return appv1.PromotionStageStatus{
+		TargetBranch: stage.TargetBranch,
+		Phase:        appv1.PromotionStagePhasePromoted,
+		DrySHA:       source.drySHA,
+		HydratedSHA:  hydratedSHA,
+		PromotedAt:   &promotedAt,
+	}
+}
+
+// checkPromotionGate checks whether the source meets the conditions of the gate of the stage. It returns an empty
+// string if the gate passes, or a message describing the condition the promotion waits for.
+func (h *Hydrator) checkPromotionGate(app *appv1.Application, stage appv1.PromotionStage, source promotionSource) string {
+	gate := stage.Gate
+	if gate.SoakDuration != "" {
+		soakDuration, err := time.ParseDuration(gate.SoakDuration)
+		if err != nil {
+			return fmt.Sprintf("Invalid soak duration %q: %v", gate.SoakDuration, err)
+		}
+		if source.since.IsZero() {
+			return fmt.Sprintf("Waiting for the hydrated manifests to reach %s", source.branch)
+		}
+		if until := source.since.Add(soakDuration); time.Now().Before(until) {
+			return fmt.Sprintf("Waiting until %s for the soak duration of %s on %s", until.UTC().Format(time.RFC3339), gate.SoakDuration, source.branch)
+		}
+	}
+	if gate.Healthy {
+		apps, err := h.getAppsSyncingFrom(app, source.branch)
+		if err != nil {
+			return fmt.Sprintf("Failed to get the applications syncing from %s: %v", source.branch, err)
+		}
+		if len(apps) == 0 {
+			return "No application syncs from " + source.branch
+		}
+		for _, a := range apps {
+			if !isSyncedAndHealthy(a, app, source) {
+				return fmt.Sprintf("Waiting for application %s to be synced to %s and healthy", a.QualifiedName(), source.hydratedSHA)
+			}
+		}
+	}
+	if gate.ManualApproval && !app.IsPromotionApproved(stage.TargetBranch, source.drySHA) {
+		return fmt.Sprintf("Waiting for the approval of the promotion of %s with the %s=%s=%s annotation", source.drySHA, appv1.AnnotationKeyApprovePromotion, stage.TargetBranch, source.drySHA)
+	}
+	return ""
+}
+
+// getAppsSyncingFrom returns the applications syncing from the path of the SyncSource of the application at the branch.
+func (h *Hydrator) getAppsSyncingFrom(app *appv1.Application, branch string) ([]*appv1.Application, error) {
+	apps, err := h.dependencies.GetProcessableApps()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list apps: %w", err)
+	}
+	var relevantApps []*appv1.Application
+	for _, a := range apps.Items {
+		if getSyncingSourceIndex(&a, app, branch) >= 0 {
+			relevantApps = append(relevantApps, &a)
+		}
+	}
+	return relevantApps, nil
+}
+
+// getSyncingSourceIndex returns the index of the source of the application syncing from the path of the SyncSource of
+// the hydrated application at the branch, or -1 if it does not sync from it.
+func getSyncingSourceIndex(app *appv1.Application, hydratedApp *appv1.Application, branch string) int {
+	syncSource := hydratedApp.Spec.SourceHydrator.GetSyncSource()
+	repoURL := git.NormalizeGitURLAllowInvalid(syncSource.RepoURL)
+	for i, source := range app.Spec.GetSources() {
+		if source.TargetRevision == branch && source.Path == syncSource.Path && git.NormalizeGitURLAllowInvalid(source.RepoURL) == repoURL {
+			return i
+		}
+	}
+	return -1
+}
+
+// isSyncedAndHealthy returns whether the application is synced to the hydrated commit of the source and healthy.
+func isSyncedAndHealthy(app *appv1.Application, hydratedApp *appv1.Application, source promotionSource) bool {
+	if app.Status.Health.Status != health.HealthStatusHealthy || app.Status.Sync.Status != appv1.SyncStatusCodeSynced {
+		return false
+	}
+	revisions := app.Status.Sync.Revisions
+	if !app.Spec.HasMultipleSources() {
+		revisions = []string{app.Status.Sync.Revision}
+	}
+	i := getSyncingSourceIndex(app, hydratedApp, source.branch)
+	return i >= 0 && i < len(revisions) && revisions[i] == source.hydratedSHA
+}
+
+// promoteToBranch copies the hydrated manifests of the application from the hydrated commit of the source to the
+// branch with the commit server. It returns the SHA of the commit of the branch holding the promoted manifests.
+func (h *Hydrator) promoteToBranch(app *appv1.Application, branch string, source promotionSource) (string, error) {
+	ctx := context.Background()
+	repoURL := app.Spec.GetHydrateToSource().RepoURL
+	repo, err := h.dependencies.GetWriteCredentials(ctx, repoURL, app.Spec.Project)
+	if err != nil {
+		return "", fmt.Errorf("failed to get hydrator credentials: %w", err)
+	}
+	if repo == nil {
+		// Try without credentials.
+		repo = &appv1.Repository{
+			Repo: repoURL,
+		}
+	}
+	authorName, err := h.dependencies.GetCommitAuthorName()
+	if err != nil {
+		return "", fmt.Errorf("failed to get commit author name: %w", err)
+	}
+	authorEmail, err := h.dependencies.GetCommitAuthorEmail()
+	if err != nil {
+		return "", fmt.Errorf("failed to get commit author email: %w", err)
+	}
+
+	closer, commitService, err := h.commitClientset.NewCommitServerClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to create commit service: %w", err)
+	}
+	defer utilio.Close(closer)
+	resp, err := commitService.PromoteHydratedManifests(ctx, &commitclient.PromoteHydratedManifestsRequest{
+		Repo:          repo,
+		SourceSha:     source.hydratedSHA,
+		TargetBranch:  branch,
+		DrySha:        source.drySHA,
+		Paths:         []string{app.Spec.SourceHydrator.SyncSource.Path},
+		CommitMessage: fmt.Sprintf("Promote %s from %s\n\nDry SHA: %s", app.Spec.SourceHydrator.SyncSource.Path, source.branch, source.drySHA),
+		AuthorName:    authorName,
+		AuthorEmail:   authorEmail,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to promote hydrated manifests: %w", err)
+	}
+	return resp.HydratedSha, nil
+}
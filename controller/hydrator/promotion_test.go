@@ -0,0 +1,203 @@
+package hydrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
+
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	commitservermocks "github.com/argoproj/argo-cd/v3/commitserver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newTestPromotionApp(stages ...v1alpha1.PromotionStage) *v1alpha1.Application {
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.HydrateTo = nil
+	app.Spec.SourceHydrator.PromotionStages = stages
+	finishedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	app.Status.SourceHydrator = v1alpha1.SourceHydratorStatus{
+		CurrentOperation: &v1alpha1.HydrateOperation{
+			Phase:          v1alpha1.HydrateOperationPhaseHydrated,
+			FinishedAt:     &finishedAt,
+			DrySHA:         "dry123",
+			HydratedSHA:    "hydrated123",
+			SourceHydrator: *app.Spec.SourceHydrator,
+		},
+		LastSuccessfulOperation: &v1alpha1.SuccessfulHydrateOperation{
+			DrySHA:         "dry123",
+			HydratedSHA:    "hydrated123",
+			SourceHydrator: *app.Spec.SourceHydrator,
+		},
+	}
+	return app
+}
+
+// newTestStageApp returns an application syncing from the path of the test app at the branch.
+func newTestStageApp(name, branch, revision string, healthStatus health.HealthStatusCode) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "test-project",
+			Source:  &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "app", TargetRevision: branch},
+		},
+		Status: v1alpha1.ApplicationStatus{
+			Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: revision},
+			Health: v1alpha1.AppHealthStatus{Status: healthStatus},
+		},
+	}
+}
+
+func TestHydrator_promote(t *testing.T) {
+	t.Parallel()
+
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	t.Run("promotes through the stages without gates", func(t *testing.T) {
+		t.Parallel()
+
+		d := mocks.NewDependencies(t)
+		cc := commitservermocks.NewCommitServiceClient(t)
+		h := &Hydrator{dependencies: d, commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc}}
+		app := newTestPromotionApp(v1alpha1.PromotionStage{TargetBranch: "staging"}, v1alpha1.PromotionStage{TargetBranch: "prod"})
+
+		repo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+		d.EXPECT().GetWriteCredentials(mock.Anything, repo.Repo, "test-project").Return(repo, nil).Twice()
+		d.EXPECT().GetCommitAuthorName().Return("", nil).Twice()
+		d.EXPECT().GetCommitAuthorEmail().Return("", nil).Twice()
+		d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{}, nil).Twice()
+		cc.EXPECT().PromoteHydratedManifests(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, in *commitclient.PromoteHydratedManifestsRequest, _ ...grpc.CallOption) (*commitclient.PromoteHydratedManifestsResponse, error) {
+			assert.Equal(t, repo, in.Repo)
+			assert.Equal(t, "dry123", in.DrySha)
+			assert.Equal(t, []string{"app"}, in.Paths)
+			switch in.TargetBranch {
+			case "staging":
+				assert.Equal(t, "hydrated123", in.SourceSha)
+			case "prod":
+				assert.Equal(t, "staging123", in.SourceSha)
+			}
+			return &commitclient.PromoteHydratedManifestsResponse{HydratedSha: in.TargetBranch + "123"}, nil
+		}).Twice()
+		d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+			require.Len(t, newStatus.PromotionStages, 2)
+			for i, branch := range []string{"staging", "prod"} {
+				stage := newStatus.PromotionStages[i]
+				assert.Equal(t, branch, stage.TargetBranch)
+				assert.Equal(t, v1alpha1.PromotionStagePhasePromoted, stage.Phase)
+				assert.Equal(t, "dry123", stage.DrySHA)
+				assert.Equal(t, branch+"123", stage.HydratedSHA)
+				assert.NotNil(t, stage.PromotedAt)
+			}
+		}).Once()
+
+		h.promote(logCtx, app)
+	})
+
+	t.Run("already promoted", func(t *testing.T) {
+		t.Parallel()
+
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		app := newTestPromotionApp(v1alpha1.PromotionStage{TargetBranch: "staging"})
+		promotedAt := metav1.Now()
+		app.Status.SourceHydrator.PromotionStages = []v1alpha1.PromotionStageStatus{
+			{TargetBranch: "staging", Phase: v1alpha1.PromotionStagePhasePromoted, DrySHA: "dry123", HydratedSHA: "staging123", PromotedAt: &promotedAt},
+		}
+
+		h.promote(logCtx, app)
+	})
+
+	t.Run("waits for the soak duration", func(t *testing.T) {
+		t.Parallel()
+
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		app := newTestPromotionApp(
+			v1alpha1.PromotionStage{TargetBranch: "staging", Gate: v1alpha1.PromotionGate{SoakDuration: "2h"}},
+			v1alpha1.PromotionStage{TargetBranch: "prod"},
+		)
+		d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+			require.Len(t, newStatus.PromotionStages, 2)
+			assert.Equal(t, v1alpha1.PromotionStagePhaseWaiting, newStatus.PromotionStages[0].Phase)
+			assert.Contains(t, newStatus.PromotionStages[0].Message, "for the soak duration of 2h on hydrated")
+			assert.Equal(t, v1alpha1.PromotionStageStatus{TargetBranch: "prod"}, newStatus.PromotionStages[1])
+		}).Once()
+
+		h.promote(logCtx, app)
+	})
+
+	t.Run("waits for healthy applications", func(t *testing.T) {
+		t.Parallel()
+
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		app := newTestPromotionApp(v1alpha1.PromotionStage{TargetBranch: "staging", Gate: v1alpha1.PromotionGate{Healthy: true}})
+		d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{
+			*newTestStageApp("dev", "hydrated", "hydrated123", health.HealthStatusHealthy),
+			*newTestStageApp("dev-eu", "hydrated", "hydrated123", health.HealthStatusProgressing),
+			*newTestStageApp("staging", "staging", "", health.HealthStatusMissing),
+		}}, nil).Once()
+		d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+			require.Len(t, newStatus.PromotionStages, 1)
+			assert.Equal(t, v1alpha1.PromotionStagePhaseWaiting, newStatus.PromotionStages[0].Phase)
+			assert.Equal(t, "Waiting for application default/dev-eu to be synced to hydrated123 and healthy", newStatus.PromotionStages[0].Message)
+		}).Once()
+
+		h.promote(logCtx, app)
+	})
+
+	t.Run("waits for the manual approval", func(t *testing.T) {
+		t.Parallel()
+
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		app := newTestPromotionApp(v1alpha1.PromotionStage{TargetBranch: "prod", Gate: v1alpha1.PromotionGate{ManualApproval: true}})
+		app.SetAnnotations(map[string]string{v1alpha1.AnnotationKeyApprovePromotion: "prod=dry000"})
+		d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+			require.Len(t, newStatus.PromotionStages, 1)
+			assert.Equal(t, v1alpha1.PromotionStagePhaseWaiting, newStatus.PromotionStages[0].Phase)
+			assert.Equal(t, "Waiting for the approval of the promotion of dry123 with the argocd.argoproj.io/approve-promotion=prod=dry123 annotation", newStatus.PromotionStages[0].Message)
+		}).Once()
+
+		h.promote(logCtx, app)
+	})
+
+	t.Run("clears the status of removed stages", func(t *testing.T) {
+		t.Parallel()
+
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		app := newTestPromotionApp()
+		app.Status.SourceHydrator.PromotionStages = []v1alpha1.PromotionStageStatus{{TargetBranch: "staging", Phase: v1alpha1.PromotionStagePhaseWaiting}}
+		d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+			assert.Empty(t, newStatus.PromotionStages)
+		}).Once()
+
+		h.promote(logCtx, app)
+	})
+}
+
+func TestHydrator_checkPromotionGate_Approved(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	h := &Hydrator{dependencies: d}
+	app := newTestPromotionApp()
+	app.SetAnnotations(map[string]string{v1alpha1.AnnotationKeyApprovePromotion: "prod=dry123"})
+	d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{
+		*newTestStageApp("staging", "staging", "staging123", health.HealthStatusHealthy),
+	}}, nil).Once()
+
+	stage := v1alpha1.PromotionStage{TargetBranch: "prod", Gate: v1alpha1.PromotionGate{Healthy: true, SoakDuration: "30m", ManualApproval: true}}
+	source := promotionSource{branch: "staging", drySHA: "dry123", hydratedSHA: "staging123", since: time.Now().Add(-time.Hour)}
+	assert.Empty(t, h.checkPromotionGate(app, stage, source))
+}
@@ -7,5 +7,8 @@ type HydrationQueueKey struct {
 	// operation because two apps have different URL formats.
 	SourceRepoURL        string
 	SourceTargetRevision string
-	DestinationBranch    string
+	// DestinationRepoURL must be normalized with git.NormalizeGitURL. It differs from SourceRepoURL when the
+	// manifests are pushed to an OCI repository.
+	DestinationRepoURL string
+	DestinationBranch  string
 }
@@ -50,7 +50,9 @@ func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *app
 	delete(app.Annotations, appv1.AnnotationKeyManifestGeneratePaths)
 
 	// FIXME: use cache and revision cache
-	objs, resp, _, err := ctrl.appStateManager.GetRepoObjs(ctx, app, drySources, appLabelKey, dryRevisions, true, true, false, project, false)
+	// the values of the Helm charts are only computed when the hydrator writes them
+	includeHelmValues := app.Spec.SourceHydrator != nil && app.Spec.SourceHydrator.Layout != nil && app.Spec.SourceHydrator.Layout.HelmValues
+	objs, resp, _, err := ctrl.appStateManager.GetRepoObjs(ctx, app, drySources, appLabelKey, dryRevisions, true, true, false, project, false, includeHelmValues)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get repo objects: %w", err)
 	}
@@ -3,6 +3,7 @@ package controller
 import (
 	"encoding/json"
 	"errors"
+	"strconv"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	mockrepoclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v3/test"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
@@ -79,6 +81,34 @@ func TestGetRepoObjs(t *testing.T) {
 	assert.Equal(t, "ConfigMap", objs[0].GetKind())
 }
 
+func TestGetRepoObjs_IncludeHelmValues(t *testing.T) {
+	for _, helmValues := range []bool{false, true} {
+		t.Run(strconv.FormatBool(helmValues), func(t *testing.T) {
+			app := newFakeApp()
+			app.Spec.SourceHydrator = &v1alpha1.SourceHydrator{Layout: &v1alpha1.HydratedLayout{HelmValues: helmValues}}
+			data := fakeData{manifestResponse: &apiclient.ManifestResponse{Revision: "abc123"}}
+			ctrl := newFakeController(t.Context(), &data, nil)
+
+			_, _, err := ctrl.GetRepoObjs(t.Context(), app, app.Spec.GetSource(), "abc123", &v1alpha1.AppProject{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+				Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}, Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}}},
+			})
+			require.NoError(t, err)
+
+			_, repoClient, err := ctrl.appStateManager.(*appStateManager).repoClientset.NewRepoServerClient()
+			require.NoError(t, err)
+			var requests []*apiclient.ManifestRequest
+			for _, call := range repoClient.(*mockrepoclient.RepoServerServiceClient).Calls {
+				if call.Method == "GenerateManifest" {
+					requests = append(requests, call.Arguments.Get(1).(*apiclient.ManifestRequest))
+				}
+			}
+			require.Len(t, requests, 1)
+			assert.Equal(t, helmValues, requests[0].IncludeHelmValues)
+		})
+	}
+}
+
 func TestGetHydratorCommitMessageTemplate_WhenTemplateisNotDefined_FallbackToDefault(t *testing.T) {
 	cm := test.NewConfigMap()
 	cmBytes, _ := json.Marshal(cm)
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/diff"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/utils/kube"
+	jsonpatch "github.com/evanphx/json-patch"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// maxDriftEventDiffLength is the maximum length of the diff included in the message of a drift event
+const maxDriftEventDiffLength = 1024
+
+// evaluateDrift returns the keys of the resources of the application which drifted from their desired state and are
+// excluded from self-heal by the self-heal policy of the application. The resources which drifted since the last
+// reconciliation, without a change of the synced revisions, are reported with a ResourceDrifted event including the
+// changes self-heal applies, or would apply, to them.
+func (ctrl *ApplicationController) evaluateDrift(app *appv1.Application, compareResult *comparisonResult) map[kube.ResourceKey]bool {
+	if app.Spec.SyncPolicy == nil || !app.Spec.SyncPolicy.IsAutomatedSyncEnabled() || !app.Spec.SyncPolicy.Automated.SelfHeal {
+		return nil
+	}
+	policy := app.Spec.SyncPolicy.Automated.SelfHealPolicy
+	previousStatuses := make(map[kube.ResourceKey]appv1.SyncStatusCode, len(app.Status.Resources))
+	for _, res := range app.Status.Resources {
+		previousStatuses[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Status
+	}
+	revisionsUnchanged := app.Status.Sync.Revision == compareResult.syncStatus.Revision &&
+		slices.Equal(app.Status.Sync.Revisions, compareResult.syncStatus.Revisions)
+
+	excluded := map[kube.ResourceKey]bool{}
+	for i, res := range compareResult.managedResources {
+		if i >= len(compareResult.resources) || compareResult.resources[i].Status != appv1.SyncStatusCodeOutOfSync || res.Target == nil {
+			continue
+		}
+		obj := res.Live
+		if obj == nil {
+			obj = res.Target
+		}
+		key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+		selfHealed := policy.IsSelfHealed(res.Group, res.Kind, res.Name, obj.GetLabels())
+		if !selfHealed {
+			excluded[key] = true
+		}
+		if !revisionsUnchanged || previousStatuses[key] != appv1.SyncStatusCodeSynced {
+			continue
+		}
+
+		eventInfo := argo.EventInfo{Reason: argo.EventReasonResourceDrifted, Type: corev1.EventTypeNormal}
+		message := fmt.Sprintf("Resource %s drifted from its desired state and is self-healed", key.String())
+		if !selfHealed {
+			eventInfo.Type = corev1.EventTypeWarning
+			message = fmt.Sprintf("Resource %s drifted from its desired state and is excluded from self-heal", key.String())
+		}
+		ctrl.logAppEvent(context.TODO(), app, eventInfo, message+": "+ctrl.driftDiff(res))
+	}
+	return excluded
+}
+
+// driftDiff returns the changes syncing the given resource applies to it, as a JSON merge patch. The data of secrets is
+// hidden, since events can be read by anyone allowed to list events in the namespace of the application.
+func (ctrl *ApplicationController) driftDiff(res managedResource) string {
+	if res.Live == nil {
+		return "resource is missing"
+	}
+	live, predicted := res.Diff.NormalizedLive, res.Diff.PredictedLive
+	if res.Kind == kube.SecretKind && res.Group == "" {
+		var err error
+		live, predicted, err = hideSecretDataInDiff(live, predicted, ctrl.settingsMgr.GetSensitiveAnnotations())
+		if err != nil {
+			return fmt.Sprintf("failed to hide secret data: %v", err)
+		}
+	}
+	patch, err := jsonpatch.CreateMergePatch(live, predicted)
+	if err != nil {
+		return fmt.Sprintf("failed to compute diff: %v", err)
+	}
+	if len(patch) > maxDriftEventDiffLength {
+		return string(patch[:maxDriftEventDiffLength]) + "... (truncated)"
+	}
+	return string(patch)
+}
+
+// hideSecretDataInDiff replaces the data and the sensitive annotations of the given live and predicted live secrets with
+// plus signs, preserving the differences between them
+func hideSecretDataInDiff(live, predicted []byte, hideAnnotations map[string]bool) ([]byte, []byte, error) {
+	liveObj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(live, liveObj); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshaling live secret: %w", err)
+	}
+	predictedObj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(predicted, predictedObj); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshaling predicted live secret: %w", err)
+	}
+	predictedObj, liveObj, err := diff.HideSecretData(predictedObj, liveObj, hideAnnotations)
+	if err != nil {
+		return nil, nil, err
+	}
+	if live, err = json.Marshal(liveObj); err != nil {
+		return nil, nil, fmt.Errorf("error marshaling live secret: %w", err)
+	}
+	if predicted, err = json.Marshal(predictedObj); err != nil {
+		return nil, nil, fmt.Errorf("error marshaling predicted live secret: %w", err)
+	}
+	return live, predicted, nil
+}
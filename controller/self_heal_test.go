@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/diff"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+func newFakeAppWithSelfHealPolicy() *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.SelfHeal = true
+	app.Spec.SyncPolicy.Automated.SelfHealPolicy = &v1alpha1.SelfHealPolicy{
+		Exclude: []v1alpha1.SelfHealResourceSelector{{Kind: "ConfigMap"}},
+	}
+	return app
+}
+
+func TestEvaluateDrift(t *testing.T) {
+	revision := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	newCompareResult := func() *comparisonResult {
+		deploy := kube.MustToUnstructured(&metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: kube.DeploymentKind},
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: test.FakeDestNamespace},
+		})
+		configMap := kube.MustToUnstructured(&metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook-config", Namespace: test.FakeDestNamespace},
+		})
+		return &comparisonResult{
+			syncStatus: &v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: revision},
+			resources: []v1alpha1.ResourceStatus{
+				{Group: "apps", Kind: kube.DeploymentKind, Namespace: test.FakeDestNamespace, Name: "guestbook", Status: v1alpha1.SyncStatusCodeOutOfSync},
+				{Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "guestbook-config", Status: v1alpha1.SyncStatusCodeOutOfSync},
+			},
+			managedResources: []managedResource{
+				{
+					Group: "apps", Kind: kube.DeploymentKind, Namespace: test.FakeDestNamespace, Name: "guestbook",
+					Live: deploy, Target: deploy,
+					Diff: diff.DiffResult{Modified: true, NormalizedLive: []byte(`{"spec":{"replicas":3}}`), PredictedLive: []byte(`{"spec":{"replicas":1}}`)},
+				},
+				{
+					Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "guestbook-config",
+					Live: configMap, Target: configMap,
+					Diff: diff.DiffResult{Modified: true, NormalizedLive: []byte(`{"data":{"foo":"baz"}}`), PredictedLive: []byte(`{"data":{"foo":"bar"}}`)},
+				},
+			},
+		}
+	}
+	newApp := func() *v1alpha1.Application {
+		app := newFakeAppWithSelfHealPolicy()
+		app.Status.Sync = v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: revision}
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Group: "apps", Kind: kube.DeploymentKind, Namespace: test.FakeDestNamespace, Name: "guestbook", Status: v1alpha1.SyncStatusCodeSynced},
+			{Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "guestbook-config", Status: v1alpha1.SyncStatusCodeSynced},
+		}
+		return app
+	}
+	listDriftEvents := func(t *testing.T, ctrl *ApplicationController) []corev1.Event {
+		t.Helper()
+		events, err := ctrl.kubeClientset.CoreV1().Events(test.FakeArgoCDNamespace).List(t.Context(), metav1.ListOptions{})
+		require.NoError(t, err)
+		var driftEvents []corev1.Event
+		for _, event := range events.Items {
+			if event.Reason == argo.EventReasonResourceDrifted {
+				driftEvents = append(driftEvents, event)
+			}
+		}
+		return driftEvents
+	}
+
+	t.Run("ReportsDrift", func(t *testing.T) {
+		app := newApp()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		excluded := ctrl.evaluateDrift(app, newCompareResult())
+
+		assert.Equal(t, map[kube.ResourceKey]bool{kube.NewResourceKey("", "ConfigMap", test.FakeDestNamespace, "guestbook-config"): true}, excluded)
+		events := listDriftEvents(t, ctrl)
+		require.Len(t, events, 2)
+		messages := map[string]string{}
+		for _, event := range events {
+			messages[event.Type] = event.Message
+		}
+		assert.Equal(t, "Resource apps/Deployment/"+test.FakeDestNamespace+"/guestbook drifted from its desired state and is self-healed: {\"spec\":{\"replicas\":1}}", messages[corev1.EventTypeNormal])
+		assert.Equal(t, "Resource /ConfigMap/"+test.FakeDestNamespace+"/guestbook-config drifted from its desired state and is excluded from self-heal: {\"data\":{\"foo\":\"bar\"}}", messages[corev1.EventTypeWarning])
+	})
+
+	t.Run("HidesSecretData", func(t *testing.T) {
+		app := newApp()
+		app.Spec.SyncPolicy.Automated.SelfHealPolicy = nil
+		app.Status.Resources = []v1alpha1.ResourceStatus{{Kind: kube.SecretKind, Namespace: test.FakeDestNamespace, Name: "guestbook-secret", Status: v1alpha1.SyncStatusCodeSynced}}
+		secret := kube.MustToUnstructured(&metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: kube.SecretKind},
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook-secret", Namespace: test.FakeDestNamespace},
+		})
+		compareResult := &comparisonResult{
+			syncStatus: &v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: revision},
+			resources:  []v1alpha1.ResourceStatus{{Kind: kube.SecretKind, Namespace: test.FakeDestNamespace, Name: "guestbook-secret", Status: v1alpha1.SyncStatusCodeOutOfSync}},
+			managedResources: []managedResource{{
+				Kind: kube.SecretKind, Namespace: test.FakeDestNamespace, Name: "guestbook-secret",
+				Live: secret, Target: secret,
+				Diff: diff.DiffResult{
+					Modified:       true,
+					NormalizedLive: []byte(`{"apiVersion":"v1","kind":"Secret","data":{"password":"bGl2ZS1wYXNzd29yZA=="}}`),
+					PredictedLive:  []byte(`{"apiVersion":"v1","kind":"Secret","data":{"password":"dGFyZ2V0LXBhc3N3b3Jk"},"stringData":{"token":"target-token"}}`),
+				},
+			}},
+		}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.evaluateDrift(app, compareResult)
+
+		events := listDriftEvents(t, ctrl)
+		require.Len(t, events, 1)
+		assert.Equal(t, "Resource /Secret/"+test.FakeDestNamespace+"/guestbook-secret drifted from its desired state and is self-healed: {\"data\":{\"password\":\"++++++++\",\"token\":\"++++++++\"}}", events[0].Message)
+		for _, value := range []string{"bGl2ZS1wYXNzd29yZA==", "dGFyZ2V0LXBhc3N3b3Jk", "target-token", "dGFyZ2V0LXRva2Vu"} {
+			assert.NotContains(t, events[0].Message, value)
+		}
+	})
+
+	t.Run("NoEventsWhenAlreadyOutOfSync", func(t *testing.T) {
+		app := newApp()
+		app.Status.Resources[1].Status = v1alpha1.SyncStatusCodeOutOfSync
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		excluded := ctrl.evaluateDrift(app, newCompareResult())
+
+		assert.Len(t, excluded, 1)
+		events := listDriftEvents(t, ctrl)
+		require.Len(t, events, 1)
+		assert.Equal(t, corev1.EventTypeNormal, events[0].Type)
+	})
+
+	t.Run("NoEventsWhenRevisionChanged", func(t *testing.T) {
+		app := newApp()
+		app.Status.Sync.Revision = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		excluded := ctrl.evaluateDrift(app, newCompareResult())
+
+		assert.Len(t, excluded, 1)
+		assert.Empty(t, listDriftEvents(t, ctrl))
+	})
+
+	t.Run("SelfHealDisabled", func(t *testing.T) {
+		app := newApp()
+		app.Spec.SyncPolicy.Automated.SelfHeal = false
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.Nil(t, ctrl.evaluateDrift(app, newCompareResult()))
+		assert.Empty(t, listDriftEvents(t, ctrl))
+	})
+}
+
+func TestAutoSyncSelfHealPolicy(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	configMapKey := kube.NewResourceKey("", "ConfigMap", test.FakeDestNamespace, "guestbook-config")
+
+	t.Run("SkipWhenAllExcluded", func(t *testing.T) {
+		app := newFakeAppWithSelfHealPolicy()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{
+			{Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "guestbook-config", Status: v1alpha1.SyncStatusCodeOutOfSync},
+		}, true, map[kube.ResourceKey]bool{configMapKey: true})
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("SelfHealIncludedResources", func(t *testing.T) {
+		app := newFakeAppWithSelfHealPolicy()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{
+			{Group: "apps", Kind: kube.DeploymentKind, Namespace: test.FakeDestNamespace, Name: "guestbook", Status: v1alpha1.SyncStatusCodeOutOfSync},
+			{Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "guestbook-config", Status: v1alpha1.SyncStatusCodeOutOfSync},
+		}, true, map[kube.ResourceKey]bool{configMapKey: true})
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Equal(t, []v1alpha1.SyncOperationResource{{Group: "apps", Kind: kube.DeploymentKind, Name: "guestbook"}}, app.Operation.Sync.Resources)
+	})
+}
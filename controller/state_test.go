@@ -733,6 +733,37 @@ func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
 	assert.Len(t, compRes.resources, 4)
 }
 
+func TestCompareAppStateManifestPolicyViolations(t *testing.T) {
+	pod := NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+			PolicyViolations: []*apiclient.ManifestPolicyViolation{{
+				Policy:    "pod-policies/host-network",
+				Kind:      "Pod",
+				Namespace: test.FakeDestNamespace,
+				Name:      pod.GetName(),
+				Message:   "host network is not allowed",
+			}},
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{},
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+	compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, sources, false, false, nil, false)
+	require.NoError(t, err)
+
+	assert.NotNil(t, compRes)
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionManifestPolicyWarning, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Manifests violate policy pod-policies/host-network: /Pod fake-dest-ns/my-pod: host network is not allowed", app.Status.Conditions[0].Message)
+}
+
 func TestCompareAppStateManagedNamespaceMetadataWithLiveNsDoesNotGetPruned(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{
@@ -1980,7 +2011,7 @@ func TestCompareAppState_CallUpdateRevisionForPaths_ForOCI(t *testing.T) {
 	sources := make([]v1alpha1.ApplicationSource, 0)
 	sources = append(sources, source)
 
-	_, _, revisionsMayHaveChanges, err := ctrl.appStateManager.GetRepoObjs(t.Context(), app, sources, "abc123", []string{"123456"}, false, false, false, &defaultProj, false)
+	_, _, revisionsMayHaveChanges, err := ctrl.appStateManager.GetRepoObjs(t.Context(), app, sources, "abc123", []string{"123456"}, false, false, false, &defaultProj, false, false)
 	require.NoError(t, err)
 	require.False(t, revisionsMayHaveChanges)
 }
@@ -2034,7 +2065,7 @@ func TestCompareAppState_CallUpdateRevisionForPaths_ForMultiSource(t *testing.T)
 
 	sources := app.Spec.Sources
 
-	_, _, revisionsMayHaveChanges, err := ctrl.appStateManager.GetRepoObjs(t.Context(), app, sources, "0.0.1", revisions, false, false, false, &defaultProj, false)
+	_, _, revisionsMayHaveChanges, err := ctrl.appStateManager.GetRepoObjs(t.Context(), app, sources, "0.0.1", revisions, false, false, false, &defaultProj, false, false)
 	require.NoError(t, err)
 	require.False(t, revisionsMayHaveChanges)
 }
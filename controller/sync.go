@@ -22,7 +22,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/kubectl/pkg/util/openapi"
@@ -169,6 +171,11 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	// what we should be syncing to when resuming operations.
 	state.SyncResult.Revision = compareResult.syncStatus.Revision
 	state.SyncResult.Revisions = compareResult.syncStatus.Revisions
+	if isMultiSourceSync {
+		state.SyncResult.ManifestsDigests = compareResult.manifestsDigests
+	} else if len(compareResult.manifestsDigests) > 0 {
+		state.SyncResult.ManifestsDigest = compareResult.manifestsDigests[0]
+	}
 
 	// validates if it should fail the sync on that revision if it finds shared resources
 	hasSharedResource, sharedResourceMessage := hasSharedResourceCondition(app)
@@ -217,8 +224,25 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		return
 	}
 
-	initialResourcesRes := make([]common.ResourceSyncResult, len(state.SyncResult.Resources))
-	for i, res := range state.SyncResult.Resources {
+	// with the batch strategy, only the resources of the current batch are synced once the previous batch is healthy
+	var batcher *syncBatcher
+	syncResources := state.SyncResult.Resources
+	var previousBatchResources v1alpha1.ResourceResults
+	if syncOp.SyncStrategy != nil && syncOp.SyncStrategy.Batch != nil {
+		batcher, err = newSyncBatcher(syncOp.SyncStrategy.Batch, state)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Invalid sync batches: %v", err)
+			return
+		}
+		if state.Phase != common.OperationTerminating && !batcher.awaitHealthy(compareResult.reconciliationResult, compareResult.resources) {
+			return
+		}
+		previousBatchResources, syncResources = batcher.splitResults(state.SyncResult.Resources)
+	}
+
+	initialResourcesRes := make([]common.ResourceSyncResult, len(syncResources))
+	for i, res := range syncResources {
 		key := kube.ResourceKey{Group: res.Group, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name}
 		initialResourcesRes[i] = common.ResourceSyncResult{
 			ResourceKey: key,
@@ -230,6 +254,54 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			Version:     res.Version,
 			Images:      res.Images,
 			Order:       i + 1,
+			SyncWave:    int(res.SyncWave),
+			RetryCount:  int(res.RetryCount),
+		}
+		if res.WaveStartedAt != nil {
+			initialResourcesRes[i].WaveStartedAt = res.WaveStartedAt.Time
+		}
+		if res.WaveDuration != nil {
+			initialResourcesRes[i].WaveDuration = res.WaveDuration.Duration
+		}
+		if res.NextRetryAt != nil {
+			initialResourcesRes[i].NextRetryAt = res.NextRetryAt.Time
+		}
+	}
+
+	var syncWaveTimeout time.Duration
+	if value, ok := syncOp.SyncOptions.GetOptionValue("SyncWaveTimeout"); ok {
+		syncWaveTimeout, err = time.ParseDuration(value)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to parse SyncWaveTimeout sync option: %v", err)
+			return
+		}
+	}
+
+	var pruneBudget *intstr.IntOrString
+	if value, ok := syncOp.SyncOptions.GetOptionValue("PruneBudget"); ok {
+		budget := intstr.Parse(value)
+		var scaled int
+		if scaled, err = intstr.GetScaledValueFromIntOrPercent(&budget, 100, false); err == nil && scaled < 0 {
+			err = stderrors.New("must not be negative")
+		}
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to parse PruneBudget sync option: %v", err)
+			return
+		}
+		pruneBudget = &budget
+	}
+
+	var resourceRetryLimit int
+	var resourceRetryBackoff wait.Backoff
+	if resourceRetry := state.Operation.Retry.Resources; resourceRetry != nil && resourceRetry.Limit > 0 {
+		resourceRetryLimit = int(resourceRetry.Limit)
+		resourceRetryBackoff, err = resourceRetry.RetryBackoff()
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to parse resource retry backoff: %v", err)
+			return
 		}
 	}
 
@@ -270,6 +342,9 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		}
 		reconciliationResult.Target = patchedTargets
 	}
+	if batcher != nil {
+		reconciliationResult = batcher.filter(reconciliationResult)
+	}
 
 	installationID, err := m.settingsMgr.GetInstallationID()
 	if err != nil {
@@ -336,6 +411,8 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
 		sync.WithSyncWaveHook(delayBetweenSyncWaves),
+		sync.WithSyncWaveTimeout(syncWaveTimeout),
+		sync.WithResourceRetry(resourceRetryLimit, resourceRetryBackoff),
 		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
@@ -347,6 +424,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			clientSideApplyManager,
 		),
 		sync.WithPruneConfirmed(app.IsDeletionConfirmed(state.StartedAt.Time)),
+		sync.WithPruneBudget(pruneBudget),
 		sync.WithSkipDryRunOnMissingResource(syncOp.SyncOptions.HasOption(common.SyncOptionSkipDryRunOnMissingResource)),
 	}
 
@@ -381,7 +459,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
-	state.SyncResult.Resources = nil
+	state.SyncResult.Resources = previousBatchResources
 
 	if app.Spec.SyncPolicy != nil {
 		state.SyncResult.ManagedNamespaceMetadata = app.Spec.SyncPolicy.ManagedNamespaceMetadata
@@ -405,19 +483,40 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			res.Message = augmentedMsg
 		}
 
-		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
-			HookType:  res.HookType,
-			Group:     res.ResourceKey.Group,
-			Kind:      res.ResourceKey.Kind,
-			Namespace: res.ResourceKey.Namespace,
-			Name:      res.ResourceKey.Name,
-			Version:   res.Version,
-			SyncPhase: res.SyncPhase,
-			HookPhase: res.HookPhase,
-			Status:    res.Status,
-			Message:   res.Message,
-			Images:    res.Images,
-		})
+		resResult := &v1alpha1.ResourceResult{
+			HookType:   res.HookType,
+			Group:      res.ResourceKey.Group,
+			Kind:       res.ResourceKey.Kind,
+			Namespace:  res.ResourceKey.Namespace,
+			Name:       res.ResourceKey.Name,
+			Version:    res.Version,
+			SyncPhase:  res.SyncPhase,
+			HookPhase:  res.HookPhase,
+			Status:     res.Status,
+			Message:    res.Message,
+			Images:     res.Images,
+			SyncWave:   int64(res.SyncWave),
+			RetryCount: int64(res.RetryCount),
+		}
+		if !res.WaveStartedAt.IsZero() {
+			resResult.WaveStartedAt = &metav1.Time{Time: res.WaveStartedAt}
+			resResult.WaveDuration = &metav1.Duration{Duration: res.WaveDuration}
+		}
+		if !res.NextRetryAt.IsZero() {
+			resResult.NextRetryAt = &metav1.Time{Time: res.NextRetryAt}
+		}
+		if batcher != nil {
+			resResult.SyncBatch = batcher.status().Name
+		}
+		state.SyncResult.Resources = append(state.SyncResult.Resources, resResult)
+	}
+
+	if batcher != nil {
+		batcher.update(syncOp.DryRun)
+	}
+
+	if syncOp.DryRun && state.Phase.Completed() {
+		state.DryRunReport = newDryRunReport(state.SyncResult.Resources, compareResult.managedResources)
 	}
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
@@ -435,6 +534,25 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 //   - applies normalization to the target resources based on the live resources
 //   - copies ignored fields from the matching live resources: apply normalizer to the live resource,
 //     calculates the patch performed by normalizer and applies the patch to the target resource
+// nextResourceRetryAfter returns the wait until the earliest retry of a resource which failed to sync with a transient
+// error, or nil if no retry is pending.
+func nextResourceRetryAfter(state *v1alpha1.OperationState) *time.Duration {
+	if state.SyncResult == nil {
+		return nil
+	}
+	var retryAt *metav1.Time
+	for _, res := range state.SyncResult.Resources {
+		if res.NextRetryAt != nil && (retryAt == nil || res.NextRetryAt.Before(retryAt)) {
+			retryAt = res.NextRetryAt
+		}
+	}
+	if retryAt == nil {
+		return nil
+	}
+	retryAfter := max(time.Until(retryAt.Time), 0)
+	return &retryAfter
+}
+
 func normalizeTargetResources(cr *comparisonResult) ([]*unstructured.Unstructured, error) {
 	// normalize live and target resources
 	normalized, err := diff.Normalize(cr.reconciliationResult.Live, cr.reconciliationResult.Target, cr.diffConfig)
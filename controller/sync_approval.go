@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// awaitOperationApproval returns true if the given operation may run: either it does not require approvals, or it was
+// approved by as many users as the approval gates of the project require. Otherwise, the operation keeps waiting for
+// approvals, or fails if it was rejected. Dry-run syncs do not require approvals since they do not change the cluster.
+func awaitOperationApproval(app *v1alpha1.Application, project *v1alpha1.AppProject, state *v1alpha1.OperationState) bool {
+	if state.Operation.Sync == nil || state.Operation.Sync.DryRun {
+		return true
+	}
+	required := project.RequiredApprovals(app)
+	if required == 0 {
+		return true
+	}
+
+	// approvals and rejections are recorded on the requested operation after the operation state was initialized
+	if app.Operation != nil {
+		state.Operation.Approvals = app.Operation.Approvals
+		state.Operation.Rejection = app.Operation.Rejection
+	}
+	if rejection := state.Operation.Rejection; rejection != nil {
+		state.Phase = common.OperationFailed
+		state.Message = "Operation rejected by " + rejection.Username
+		if rejection.Message != "" {
+			state.Message = fmt.Sprintf("%s: %s", state.Message, rejection.Message)
+		}
+		// record the revision that was requested, so that automated sync does not request it again
+		if state.SyncResult == nil {
+			state.SyncResult = newSyncOperationResult(app, *state.Operation.Sync)
+		}
+		return false
+	}
+	if approvals := int64(len(state.Operation.Approvals)); approvals < required {
+		state.Phase = common.OperationRunning
+		state.Message = fmt.Sprintf("Waiting for approval: %d of %d required approvals", approvals, required)
+		return false
+	}
+	return true
+}
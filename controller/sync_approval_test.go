@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newApprovalGateProject(requiredApprovals int64) *v1alpha1.AppProject {
+	proj := defaultProj.DeepCopy()
+	proj.Spec.ApprovalGates = []v1alpha1.ApprovalGate{{Applications: []string{"my-app"}, RequiredApprovals: requiredApprovals}}
+	return proj
+}
+
+func TestAwaitOperationApproval(t *testing.T) {
+	newState := func(app *v1alpha1.Application) *v1alpha1.OperationState {
+		return &v1alpha1.OperationState{Operation: *app.Operation, Phase: synccommon.OperationRunning}
+	}
+
+	t.Run("NoMatchingGate", func(t *testing.T) {
+		app := newFakeApp()
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		assert.True(t, awaitOperationApproval(app, &defaultProj, newState(app)))
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		app := newFakeApp()
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{DryRun: true}}
+		assert.True(t, awaitOperationApproval(app, newApprovalGateProject(1), newState(app)))
+	})
+
+	t.Run("WaitsForApprovals", func(t *testing.T) {
+		app := newFakeApp()
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		state := newState(app)
+		app.Operation.Approvals = []v1alpha1.OperationApproval{{Username: "alice"}}
+
+		assert.False(t, awaitOperationApproval(app, newApprovalGateProject(2), state))
+		assert.Equal(t, synccommon.OperationRunning, state.Phase)
+		assert.Equal(t, "Waiting for approval: 1 of 2 required approvals", state.Message)
+		assert.Equal(t, app.Operation.Approvals, state.Operation.Approvals)
+	})
+
+	t.Run("Approved", func(t *testing.T) {
+		app := newFakeApp()
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		state := newState(app)
+		app.Operation.Approvals = []v1alpha1.OperationApproval{{Username: "alice"}, {Username: "bob"}}
+
+		assert.True(t, awaitOperationApproval(app, newApprovalGateProject(2), state))
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		app := newFakeApp()
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "abc123"}}
+		state := newState(app)
+		app.Operation.Rejection = &v1alpha1.OperationApproval{Username: "alice", Message: "not now"}
+
+		assert.False(t, awaitOperationApproval(app, newApprovalGateProject(1), state))
+		assert.Equal(t, synccommon.OperationFailed, state.Phase)
+		assert.Equal(t, "Operation rejected by alice: not now", state.Message)
+		require.NotNil(t, state.SyncResult)
+		assert.Equal(t, "abc123", state.SyncResult.Revision)
+	})
+}
+
+func TestProcessRequestedAppOperation_WaitingForApproval(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	data := &fakeData{
+		apps: []runtime.Object{app, newApprovalGateProject(1)},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+	}
+	ctrl := newFakeController(t.Context(), data, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, string(synccommon.OperationRunning), phase)
+	assert.Equal(t, "Waiting for approval: 0 of 1 required approvals", message)
+
+	// once approved, the operation is run
+	app.Status.OperationState = &v1alpha1.OperationState{Operation: *app.Operation, Phase: synccommon.OperationRunning, Message: message}
+	app.Operation.Approvals = []v1alpha1.OperationApproval{{Username: "alice"}}
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ = unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	message, _, _ = unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+	assert.Equal(t, "successfully synced (no more tasks)", message)
+}
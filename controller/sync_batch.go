@@ -0,0 +1,210 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync/hook"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// syncBatchRemaining is the name of the final batch of a batch sync, which syncs the resources and hooks that are not
+// selected by any batch
+const syncBatchRemaining = "remaining"
+
+// syncBatcher tracks the progress of a sync operation using the batch sync strategy. Each batch is synced as a
+// separate sync of the resources and hooks it selects. Once synced, the resources of a batch must become healthy
+// before the next batch is synced.
+type syncBatcher struct {
+	batches   []v1alpha1.SyncBatch
+	selectors []labels.Selector
+	state     *v1alpha1.OperationState
+	// current is the index of the batch being synced
+	current int
+}
+
+func newSyncBatcher(strategy *v1alpha1.SyncStrategyBatch, state *v1alpha1.OperationState) (*syncBatcher, error) {
+	if len(strategy.Batches) == 0 {
+		return nil, errors.New("at least one batch is required")
+	}
+	b := &syncBatcher{batches: strategy.Batches, state: state}
+	names := map[string]bool{syncBatchRemaining: true}
+	for i := range strategy.Batches {
+		batch := strategy.Batches[i]
+		if batch.Name == "" {
+			return nil, fmt.Errorf("batch %d has no name", i+1)
+		}
+		if names[batch.Name] {
+			return nil, fmt.Errorf("batch name %q is not unique", batch.Name)
+		}
+		names[batch.Name] = true
+		selector, err := metav1.LabelSelectorAsSelector(&batch.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of batch %q: %w", batch.Name, err)
+		}
+		b.selectors = append(b.selectors, selector)
+	}
+
+	if len(state.SyncBatches) == 0 {
+		for _, batch := range strategy.Batches {
+			state.SyncBatches = append(state.SyncBatches, v1alpha1.SyncBatchStatus{Name: batch.Name})
+		}
+		state.SyncBatches = append(state.SyncBatches, v1alpha1.SyncBatchStatus{Name: syncBatchRemaining})
+	}
+	if len(state.SyncBatches) != len(strategy.Batches)+1 {
+		return nil, errors.New("batches do not match the batches of the operation state")
+	}
+	for b.current < len(state.SyncBatches)-1 && state.SyncBatches[b.current].Phase.Successful() {
+		b.current++
+	}
+	return b, nil
+}
+
+// batchIndex returns the index of the batch the given resource or hook belongs to: the first batch that names it as
+// analysis hook, otherwise the first batch whose selector matches its labels, otherwise the final batch.
+func (b *syncBatcher) batchIndex(obj *unstructured.Unstructured) int {
+	if hook.IsHook(obj) {
+		for i, batch := range b.batches {
+			if batch.AnalysisHook != "" && batch.AnalysisHook == obj.GetName() {
+				return i
+			}
+		}
+	}
+	for i, selector := range b.selectors {
+		if selector.Matches(labels.Set(obj.GetLabels())) {
+			return i
+		}
+	}
+	return len(b.batches)
+}
+
+func (b *syncBatcher) status() *v1alpha1.SyncBatchStatus {
+	return &b.state.SyncBatches[b.current]
+}
+
+func (b *syncBatcher) isLast() bool {
+	return b.current == len(b.state.SyncBatches)-1
+}
+
+// awaitHealthy checks the health of the resources of the current batch if it was synced already. It advances to the
+// next batch once they are all healthy, and returns false if the operation must not continue syncing in the meantime.
+func (b *syncBatcher) awaitHealthy(reconciliationResult sync.ReconciliationResult, resources []v1alpha1.ResourceStatus) bool {
+	status := b.status()
+	if status.SyncedAt == nil || status.Phase.Completed() {
+		return true
+	}
+	waiting := 0
+	var firstWaiting string
+	for i, target := range reconciliationResult.Target {
+		if target == nil || i >= len(resources) || b.batchIndex(target) != b.current {
+			continue
+		}
+		res := resources[i]
+		if res.Health == nil || res.Health.Status == health.HealthStatusHealthy {
+			continue
+		}
+		if res.Health.Status == health.HealthStatusDegraded {
+			b.complete(common.OperationFailed, fmt.Sprintf("resource %s/%s/%s is %s", res.Group, res.Kind, res.Name, res.Health.Status))
+			return false
+		}
+		if waiting == 0 {
+			firstWaiting = fmt.Sprintf("%s/%s/%s", res.Group, res.Kind, res.Name)
+		}
+		waiting++
+	}
+	if waiting > 0 {
+		message := "waiting for healthy state of " + firstWaiting
+		if waiting > 1 {
+			message = fmt.Sprintf("%s and %d more resources", message, waiting-1)
+		}
+		status.Message = message
+		b.state.Phase = common.OperationRunning
+		b.state.Message = fmt.Sprintf("batch %s: %s", status.Name, message)
+		return false
+	}
+	b.complete(common.OperationSucceeded, "successfully synced and healthy")
+	b.current++
+	return true
+}
+
+// filter returns the resources and hooks of the given reconciliation result that belong to the current batch
+func (b *syncBatcher) filter(reconciliationResult sync.ReconciliationResult) sync.ReconciliationResult {
+	filtered := sync.ReconciliationResult{}
+	for i := range reconciliationResult.Target {
+		target, live := reconciliationResult.Target[i], reconciliationResult.Live[i]
+		obj := target
+		if obj == nil {
+			obj = live
+		}
+		if obj != nil && b.batchIndex(obj) == b.current {
+			filtered.Target = append(filtered.Target, target)
+			filtered.Live = append(filtered.Live, live)
+		}
+	}
+	for _, obj := range reconciliationResult.Hooks {
+		if b.batchIndex(obj) == b.current {
+			filtered.Hooks = append(filtered.Hooks, obj)
+		}
+	}
+	return filtered
+}
+
+// splitResults returns the resource results of the previous batches and those of the current batch
+func (b *syncBatcher) splitResults(results v1alpha1.ResourceResults) (previous, current v1alpha1.ResourceResults) {
+	name := b.status().Name
+	for _, res := range results {
+		if res.SyncBatch != "" && res.SyncBatch != name {
+			previous = append(previous, res)
+		} else {
+			current = append(current, res)
+		}
+	}
+	return previous, current
+}
+
+// update records the outcome of syncing the current batch, and sets the operation phase and message accordingly
+func (b *syncBatcher) update(dryRun bool) {
+	status := b.status()
+	if status.StartedAt == nil {
+		now := metav1.Now()
+		status.StartedAt = &now
+	}
+	switch {
+	case b.state.Phase.Successful() && !b.isLast() && !dryRun:
+		now := metav1.Now()
+		status.Phase = common.OperationRunning
+		status.SyncedAt = &now
+		status.Message = "waiting for healthy state of resources"
+		b.state.Phase = common.OperationRunning
+	case b.state.Phase.Successful() && !b.isLast():
+		b.complete(common.OperationSucceeded, b.state.Message)
+		b.state.Phase = common.OperationRunning
+	case b.state.Phase.Completed():
+		b.complete(b.state.Phase, b.state.Message)
+		return
+	default:
+		status.Phase = b.state.Phase
+		status.Message = b.state.Message
+	}
+	b.state.Message = fmt.Sprintf("batch %s: %s", status.Name, status.Message)
+}
+
+// complete completes the current batch with the given phase. Unsuccessful batches complete the operation.
+func (b *syncBatcher) complete(phase common.OperationPhase, message string) {
+	status := b.status()
+	now := metav1.Now()
+	status.Phase = phase
+	status.Message = message
+	status.FinishedAt = &now
+	if !phase.Successful() {
+		b.state.Phase = phase
+		b.state.Message = fmt.Sprintf("batch %s: %s", status.Name, message)
+	}
+}
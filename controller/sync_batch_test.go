@@ -0,0 +1,247 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/health"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newBatchStrategy() *v1alpha1.SyncStrategyBatch {
+	return &v1alpha1.SyncStrategyBatch{Batches: []v1alpha1.SyncBatch{{
+		Name:         "canary",
+		Selector:     metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+		AnalysisHook: "smoke-test",
+	}}}
+}
+
+func newBatchConfigMap(name string, canary bool) *unstructured.Unstructured {
+	obj := test.NewFakeConfigMap()
+	obj.SetName(name)
+	if canary {
+		obj.SetLabels(map[string]string{"canary": "true"})
+	}
+	return kube.MustToUnstructured(obj)
+}
+
+func TestNewSyncBatcher(t *testing.T) {
+	t.Run("InitializesBatches", func(t *testing.T) {
+		state := &v1alpha1.OperationState{}
+		batcher, err := newSyncBatcher(newBatchStrategy(), state)
+		require.NoError(t, err)
+		require.Len(t, state.SyncBatches, 2)
+		assert.Equal(t, "canary", state.SyncBatches[0].Name)
+		assert.Equal(t, syncBatchRemaining, state.SyncBatches[1].Name)
+		assert.Equal(t, 0, batcher.current)
+	})
+
+	t.Run("ResumesFirstIncompleteBatch", func(t *testing.T) {
+		state := &v1alpha1.OperationState{SyncBatches: []v1alpha1.SyncBatchStatus{
+			{Name: "canary", Phase: synccommon.OperationSucceeded},
+			{Name: syncBatchRemaining, Phase: synccommon.OperationRunning},
+		}}
+		batcher, err := newSyncBatcher(newBatchStrategy(), state)
+		require.NoError(t, err)
+		assert.Equal(t, 1, batcher.current)
+		assert.True(t, batcher.isLast())
+	})
+
+	t.Run("InvalidBatches", func(t *testing.T) {
+		_, err := newSyncBatcher(&v1alpha1.SyncStrategyBatch{}, &v1alpha1.OperationState{})
+		require.ErrorContains(t, err, "at least one batch is required")
+
+		_, err = newSyncBatcher(&v1alpha1.SyncStrategyBatch{Batches: []v1alpha1.SyncBatch{{Name: "a"}, {Name: "a"}}}, &v1alpha1.OperationState{})
+		require.ErrorContains(t, err, `batch name "a" is not unique`)
+
+		_, err = newSyncBatcher(&v1alpha1.SyncStrategyBatch{Batches: []v1alpha1.SyncBatch{{Name: syncBatchRemaining}}}, &v1alpha1.OperationState{})
+		require.ErrorContains(t, err, `batch name "remaining" is not unique`)
+
+		_, err = newSyncBatcher(&v1alpha1.SyncStrategyBatch{Batches: []v1alpha1.SyncBatch{{
+			Name:     "a",
+			Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "a", Operator: "foo"}}},
+		}}}, &v1alpha1.OperationState{})
+		require.ErrorContains(t, err, `invalid selector of batch "a"`)
+	})
+}
+
+func TestSyncBatcher_Filter(t *testing.T) {
+	batcher, err := newSyncBatcher(newBatchStrategy(), &v1alpha1.OperationState{})
+	require.NoError(t, err)
+
+	canary := newBatchConfigMap("canary", true)
+	other := newBatchConfigMap("other", false)
+	pruned := newBatchConfigMap("pruned", true)
+	analysisHook := newBatchConfigMap("smoke-test", false)
+	analysisHook.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: string(synccommon.HookTypePostSync)})
+	otherHook := newBatchConfigMap("other-hook", false)
+	otherHook.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: string(synccommon.HookTypePostSync)})
+	reconciliationResult := sync.ReconciliationResult{
+		Target: []*unstructured.Unstructured{canary, other, nil},
+		Live:   []*unstructured.Unstructured{nil, other, pruned},
+		Hooks:  []*unstructured.Unstructured{analysisHook, otherHook},
+	}
+
+	filtered := batcher.filter(reconciliationResult)
+	assert.Equal(t, []*unstructured.Unstructured{canary, nil}, filtered.Target)
+	assert.Equal(t, []*unstructured.Unstructured{nil, pruned}, filtered.Live)
+	assert.Equal(t, []*unstructured.Unstructured{analysisHook}, filtered.Hooks)
+
+	batcher.current++
+	filtered = batcher.filter(reconciliationResult)
+	assert.Equal(t, []*unstructured.Unstructured{other}, filtered.Target)
+	assert.Equal(t, []*unstructured.Unstructured{other}, filtered.Live)
+	assert.Equal(t, []*unstructured.Unstructured{otherHook}, filtered.Hooks)
+}
+
+func TestSyncBatcher_AwaitHealthy(t *testing.T) {
+	canary := newBatchConfigMap("canary", true)
+	other := newBatchConfigMap("other", false)
+	reconciliationResult := sync.ReconciliationResult{
+		Target: []*unstructured.Unstructured{canary, other},
+		Live:   []*unstructured.Unstructured{canary, other},
+	}
+	newState := func() *v1alpha1.OperationState {
+		syncedAt := metav1.Now()
+		return &v1alpha1.OperationState{Phase: synccommon.OperationRunning, SyncBatches: []v1alpha1.SyncBatchStatus{
+			{Name: "canary", Phase: synccommon.OperationRunning, SyncedAt: &syncedAt},
+			{Name: syncBatchRemaining},
+		}}
+	}
+	resources := func(canaryHealth health.HealthStatusCode) []v1alpha1.ResourceStatus {
+		return []v1alpha1.ResourceStatus{
+			{Kind: "ConfigMap", Name: "canary", Health: &v1alpha1.HealthStatus{Status: canaryHealth}},
+			{Kind: "ConfigMap", Name: "other", Health: &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded}},
+		}
+	}
+
+	t.Run("Progressing", func(t *testing.T) {
+		state := newState()
+		batcher, err := newSyncBatcher(newBatchStrategy(), state)
+		require.NoError(t, err)
+		assert.False(t, batcher.awaitHealthy(reconciliationResult, resources(health.HealthStatusProgressing)))
+		assert.Equal(t, synccommon.OperationRunning, state.Phase)
+		assert.Equal(t, "batch canary: waiting for healthy state of /ConfigMap/canary", state.Message)
+		assert.Equal(t, 0, batcher.current)
+	})
+
+	t.Run("Degraded", func(t *testing.T) {
+		state := newState()
+		batcher, err := newSyncBatcher(newBatchStrategy(), state)
+		require.NoError(t, err)
+		assert.False(t, batcher.awaitHealthy(reconciliationResult, resources(health.HealthStatusDegraded)))
+		assert.Equal(t, synccommon.OperationFailed, state.Phase)
+		assert.Equal(t, "batch canary: resource /ConfigMap/canary is Degraded", state.Message)
+		assert.Equal(t, synccommon.OperationFailed, state.SyncBatches[0].Phase)
+		assert.NotNil(t, state.SyncBatches[0].FinishedAt)
+	})
+
+	t.Run("Healthy", func(t *testing.T) {
+		state := newState()
+		batcher, err := newSyncBatcher(newBatchStrategy(), state)
+		require.NoError(t, err)
+		assert.True(t, batcher.awaitHealthy(reconciliationResult, resources(health.HealthStatusHealthy)))
+		assert.Equal(t, synccommon.OperationSucceeded, state.SyncBatches[0].Phase)
+		assert.Equal(t, 1, batcher.current)
+	})
+}
+
+func TestSyncBatcher_Update(t *testing.T) {
+	t.Run("WaitsForHealthAfterSync", func(t *testing.T) {
+		state := &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded, Message: "successfully synced (all tasks run)"}
+		batcher, err := newSyncBatcher(newBatchStrategy(), state)
+		require.NoError(t, err)
+		batcher.update(false)
+		assert.Equal(t, synccommon.OperationRunning, state.Phase)
+		assert.Equal(t, "batch canary: waiting for healthy state of resources", state.Message)
+		assert.NotNil(t, state.SyncBatches[0].StartedAt)
+		assert.NotNil(t, state.SyncBatches[0].SyncedAt)
+	})
+
+	t.Run("DryRunDoesNotWaitForHealth", func(t *testing.T) {
+		state := &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded, Message: "successfully synced (all tasks run)"}
+		batcher, err := newSyncBatcher(newBatchStrategy(), state)
+		require.NoError(t, err)
+		batcher.update(true)
+		assert.Equal(t, synccommon.OperationRunning, state.Phase)
+		assert.Equal(t, synccommon.OperationSucceeded, state.SyncBatches[0].Phase)
+	})
+
+	t.Run("CompletesOperationWithLastBatch", func(t *testing.T) {
+		state := &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded, Message: "successfully synced (all tasks run)", SyncBatches: []v1alpha1.SyncBatchStatus{
+			{Name: "canary", Phase: synccommon.OperationSucceeded},
+			{Name: syncBatchRemaining},
+		}}
+		batcher, err := newSyncBatcher(newBatchStrategy(), state)
+		require.NoError(t, err)
+		batcher.update(false)
+		assert.Equal(t, synccommon.OperationSucceeded, state.Phase)
+		assert.Equal(t, "successfully synced (all tasks run)", state.Message)
+		assert.Equal(t, synccommon.OperationSucceeded, state.SyncBatches[1].Phase)
+	})
+
+	t.Run("FailsOperation", func(t *testing.T) {
+		state := &v1alpha1.OperationState{Phase: synccommon.OperationFailed, Message: "one or more objects failed to apply"}
+		batcher, err := newSyncBatcher(newBatchStrategy(), state)
+		require.NoError(t, err)
+		batcher.update(false)
+		assert.Equal(t, synccommon.OperationFailed, state.Phase)
+		assert.Equal(t, "batch canary: one or more objects failed to apply", state.Message)
+		assert.Equal(t, synccommon.OperationFailed, state.SyncBatches[0].Phase)
+	})
+}
+
+func TestSyncAppStateWithBatchStrategy(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	canary, err := newBatchConfigMap("canary", true).MarshalJSON()
+	require.NoError(t, err)
+	other, err := newBatchConfigMap("other", false).MarshalJSON()
+	require.NoError(t, err)
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(canary), string(other)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+
+	opState := &v1alpha1.OperationState{
+		Phase: synccommon.OperationRunning,
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{
+			SyncStrategy: &v1alpha1.SyncStrategy{Batch: newBatchStrategy()},
+		}},
+	}
+	ctrl.appStateManager.SyncAppState(app, defaultProject, opState)
+
+	// the fake cluster is unreachable, so the sync of the first batch fails, but only its resources are synced
+	assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+	assert.Equal(t, "batch canary: one or more synchronization tasks are not valid", opState.Message)
+	require.Len(t, opState.SyncBatches, 2)
+	assert.Equal(t, synccommon.OperationFailed, opState.SyncBatches[0].Phase)
+	assert.Empty(t, opState.SyncBatches[1].Phase)
+	require.Len(t, opState.SyncResult.Resources, 1)
+	assert.Equal(t, "canary", opState.SyncResult.Resources[0].Name)
+	assert.Equal(t, "canary", opState.SyncResult.Resources[0].SyncBatch)
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// newDryRunReport returns the report of a completed dry-run sync operation. The action performed on each resource is
+// derived from the managed resources the operation compared, and the errors are those returned by the dry-run.
+func newDryRunReport(results v1alpha1.ResourceResults, managedResources []managedResource) *v1alpha1.DryRunReport {
+	managedByKey := make(map[kube.ResourceKey]managedResource, len(managedResources))
+	for _, res := range managedResources {
+		managedByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res
+	}
+
+	report := &v1alpha1.DryRunReport{GeneratedAt: metav1.Now()}
+	for _, res := range results {
+		item := v1alpha1.DryRunResourceResult{
+			Group:     res.Group,
+			Version:   res.Version,
+			Kind:      res.Kind,
+			Namespace: res.Namespace,
+			Name:      res.Name,
+			Action:    v1alpha1.DryRunActionCreate,
+			HookType:  res.HookType,
+			SyncPhase: res.SyncPhase,
+			SyncWave:  res.SyncWave,
+			Message:   res.Message,
+		}
+		if res.Status == common.ResultCodeSyncFailed {
+			item.Message = ""
+			item.ValidationError = res.Message
+		}
+		if res.HookType != "" {
+			report.Hooks = append(report.Hooks, item)
+			continue
+		}
+
+		switch managed, ok := managedByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)]; {
+		case res.Status == common.ResultCodePruneSkipped:
+			item.Action = v1alpha1.DryRunActionNone
+		case res.Status == common.ResultCodePruned || (ok && managed.Target == nil):
+			item.Action = v1alpha1.DryRunActionPrune
+		case !ok || managed.Live == nil:
+			item.Action = v1alpha1.DryRunActionCreate
+		case managed.Diff.Modified:
+			item.Action = v1alpha1.DryRunActionUpdate
+		default:
+			item.Action = v1alpha1.DryRunActionNone
+		}
+		report.Resources = append(report.Resources, item)
+	}
+	return report
+}
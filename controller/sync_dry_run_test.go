@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/diff"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func TestNewDryRunReport(t *testing.T) {
+	obj := func(name string) *unstructured.Unstructured {
+		cm := &unstructured.Unstructured{}
+		cm.SetName(name)
+		return cm
+	}
+	managed := func(name string, target, live *unstructured.Unstructured, modified bool) managedResource {
+		return managedResource{Kind: "ConfigMap", Namespace: test.FakeArgoCDNamespace, Name: name, Target: target, Live: live, Diff: diff.DiffResult{Modified: modified}}
+	}
+	result := func(name string, status common.ResultCode, message string) *v1alpha1.ResourceResult {
+		return &v1alpha1.ResourceResult{Version: "v1", Kind: "ConfigMap", Namespace: test.FakeArgoCDNamespace, Name: name, Status: status, Message: message, SyncPhase: common.SyncPhaseSync}
+	}
+	managedResources := []managedResource{
+		managed("new", obj("new"), nil, false),
+		managed("changed", obj("changed"), obj("changed"), true),
+		managed("unchanged", obj("unchanged"), obj("unchanged"), false),
+		managed("extra", nil, obj("extra"), false),
+		managed("invalid", obj("invalid"), obj("invalid"), true),
+	}
+	results := v1alpha1.ResourceResults{
+		result("new", common.ResultCodeSynced, "configmap/new created (dry run)"),
+		result("changed", common.ResultCodeSynced, "configmap/changed configured (dry run)"),
+		result("unchanged", common.ResultCodeSynced, "configmap/unchanged unchanged (dry run)"),
+		result("extra", common.ResultCodePruneSkipped, "ignored (requires pruning)"),
+		result("invalid", common.ResultCodeSyncFailed, "field is immutable"),
+		{Version: "v1", Kind: "Pod", Namespace: test.FakeArgoCDNamespace, Name: "migrate", HookType: common.HookTypePreSync, SyncPhase: common.SyncPhasePreSync, SyncWave: -1, Message: "pod/migrate created (dry run)"},
+	}
+
+	report := newDryRunReport(results, managedResources)
+
+	require.Len(t, report.Resources, 5)
+	actions := map[string]v1alpha1.DryRunAction{}
+	for _, res := range report.Resources {
+		actions[res.Name] = res.Action
+	}
+	assert.Equal(t, map[string]v1alpha1.DryRunAction{
+		"new":       v1alpha1.DryRunActionCreate,
+		"changed":   v1alpha1.DryRunActionUpdate,
+		"unchanged": v1alpha1.DryRunActionNone,
+		"extra":     v1alpha1.DryRunActionNone,
+		"invalid":   v1alpha1.DryRunActionUpdate,
+	}, actions)
+	assert.Equal(t, "field is immutable", report.Resources[4].ValidationError)
+	assert.Empty(t, report.Resources[4].Message)
+	assert.Equal(t, "ignored (requires pruning)", report.Resources[3].Message)
+	require.Len(t, report.Hooks, 1)
+	assert.Equal(t, v1alpha1.DryRunResourceResult{
+		Version: "v1", Kind: "Pod", Namespace: test.FakeArgoCDNamespace, Name: "migrate", Action: v1alpha1.DryRunActionCreate,
+		HookType: common.HookTypePreSync, SyncPhase: common.SyncPhasePreSync, SyncWave: -1, Message: "pod/migrate created (dry run)",
+	}, report.Hooks[0])
+	assert.True(t, report.HasErrors())
+	assert.False(t, report.GeneratedAt.IsZero())
+
+	results[3].Status = common.ResultCodePruned
+	report = newDryRunReport(results, managedResources)
+	assert.Equal(t, v1alpha1.DryRunActionPrune, report.Resources[3].Action)
+}
+
+func TestSyncAppStateDryRunReport(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	cm, err := newBatchConfigMap("my-map", false).MarshalJSON()
+	require.NoError(t, err)
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(cm)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+
+	opState := &v1alpha1.OperationState{
+		Phase:     common.OperationRunning,
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{DryRun: true}},
+	}
+	ctrl.appStateManager.SyncAppState(app, defaultProject, opState)
+
+	// the fake cluster is unreachable, so the dry-run fails and the report contains the error
+	assert.Equal(t, common.OperationFailed, opState.Phase)
+	require.NotNil(t, opState.DryRunReport)
+	require.Len(t, opState.DryRunReport.Resources, 1)
+	res := opState.DryRunReport.Resources[0]
+	assert.Equal(t, "my-map", res.Name)
+	assert.Equal(t, v1alpha1.DryRunActionCreate, res.Action)
+	assert.NotEmpty(t, res.ValidationError)
+	assert.Empty(t, opState.DryRunReport.Hooks)
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// scheduledSync starts a sync operation of the application if its sync schedule is due, i.e. a scheduled time has
+// passed since the last scheduled sync, or since the schedule was first observed. If the schedule requests a hard
+// refresh, the manifests are refreshed first and the sync is started once the hard refresh completes. The time of the
+// sync is recorded in the status of the application, so a schedule missed while another operation was in progress or
+// syncs were denied by a sync window results in a single sync as soon as possible.
+func (ctrl *ApplicationController) scheduledSync(app *appv1.Application, syncStatus *appv1.SyncStatus, refreshType appv1.RefreshType) time.Duration {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.SyncSchedule == nil {
+		app.Status.LastScheduledSyncAt = nil
+		return 0
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	if app.Status.LastScheduledSyncAt == nil {
+		// do not start a sync for the scheduled times which passed before the schedule was added
+		now := metav1.Now()
+		app.Status.LastScheduledSyncAt = &now
+	}
+	if app.Operation != nil {
+		logCtx.Infof("Skipping scheduled sync: another operation is in progress")
+		return 0
+	}
+	if isAutoSyncSuspendedByRollback(app) {
+		logCtx.Infof("Skipping scheduled sync: application was automatically rolled back and requires a manual sync to resume")
+		return 0
+	}
+	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
+		logCtx.Infof("Skipping scheduled sync: deletion in progress")
+		return 0
+	}
+
+	schedule := app.Spec.SyncPolicy.SyncSchedule
+	next, err := schedule.Next(app.Status.LastScheduledSyncAt.Time)
+	if err != nil {
+		logCtx.WithError(err).Warn("Skipping scheduled sync: invalid sync schedule")
+		return 0
+	}
+	if remaining := time.Until(next); remaining > 0 {
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remaining)
+		return 0
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	if schedule.HardRefresh && refreshType != appv1.RefreshTypeHard {
+		logCtx.Infof("Requesting hard refresh before scheduled sync")
+		if _, err := argo.RefreshApp(appIf, app.Name, appv1.RefreshTypeHard, false); err != nil {
+			logCtx.WithError(err).Error("Failed to request hard refresh before scheduled sync")
+		}
+		return 0
+	}
+
+	source := new(app.Spec.GetSource())
+	if app.Spec.HasMultipleSources() {
+		source = nil
+	}
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Source:      source,
+			Revision:    syncStatus.Revision,
+			Prune:       schedule.Prune,
+			SyncOptions: app.Spec.SyncPolicy.SyncOptions,
+			Sources:     app.Spec.Sources,
+			Revisions:   syncStatus.Revisions,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Info:        []*appv1.Info{{Name: "Reason", Value: "Scheduled sync (" + formatSyncSchedule(schedule) + ")"}},
+	}
+	if app.Spec.SyncPolicy.Retry != nil {
+		op.Retry = *app.Spec.SyncPolicy.Retry
+	}
+
+	start := time.Now()
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	setOpTime := time.Since(start)
+	if err != nil {
+		if stderrors.Is(err, argo.ErrAnotherOperationInProgress) {
+			logCtx.WithError(err).Warn("Failed to initiate scheduled sync")
+			return 0
+		}
+		logCtx.WithError(err).Error("Failed to initiate scheduled sync")
+		return setOpTime
+	}
+	ctrl.writeBackToInformer(updatedApp)
+
+	now := metav1.Now()
+	app.Status.LastScheduledSyncAt = &now
+	message := fmt.Sprintf("Initiated scheduled sync (%s)", formatSyncSchedule(schedule))
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeNormal}, message)
+	logCtx.Info(message)
+	return setOpTime
+}
+
+func formatSyncSchedule(schedule *appv1.SyncSchedule) string {
+	timeZone := schedule.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	return fmt.Sprintf("%s %s", schedule.Schedule, timeZone)
+}
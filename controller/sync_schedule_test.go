@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newFakeAppWithSyncSchedule(lastScheduledSyncAt time.Time) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Operation = nil
+	app.Spec.SyncPolicy.SyncSchedule = &v1alpha1.SyncSchedule{Schedule: "0 2 * * *", TimeZone: "Europe/Berlin", Prune: true}
+	app.Status.LastScheduledSyncAt = &metav1.Time{Time: lastScheduledSyncAt}
+	return app
+}
+
+func TestScheduledSync(t *testing.T) {
+	syncStatus := &v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeSynced,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+
+	t.Run("SyncWhenDue", func(t *testing.T) {
+		lastScheduledSyncAt := time.Now().Add(-48 * time.Hour)
+		app := newFakeAppWithSyncSchedule(lastScheduledSyncAt)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.scheduledSync(app, syncStatus, v1alpha1.RefreshTypeNormal)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, updatedApp.Operation)
+		assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", updatedApp.Operation.Sync.Revision)
+		assert.True(t, updatedApp.Operation.Sync.Prune)
+		assert.True(t, updatedApp.Operation.InitiatedBy.Automated)
+		assert.Equal(t, []*v1alpha1.Info{{Name: "Reason", Value: "Scheduled sync (0 2 * * * Europe/Berlin)"}}, updatedApp.Operation.Info)
+		require.NotNil(t, app.Status.LastScheduledSyncAt)
+		assert.True(t, app.Status.LastScheduledSyncAt.After(lastScheduledSyncAt))
+	})
+
+	t.Run("NoSyncWhenNotDue", func(t *testing.T) {
+		lastScheduledSyncAt := time.Now()
+		app := newFakeAppWithSyncSchedule(lastScheduledSyncAt)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.scheduledSync(app, syncStatus, v1alpha1.RefreshTypeNormal)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+		assert.True(t, app.Status.LastScheduledSyncAt.Time.Equal(lastScheduledSyncAt))
+	})
+
+	t.Run("NoSyncWhenScheduleAdded", func(t *testing.T) {
+		app := newFakeAppWithSyncSchedule(time.Time{})
+		app.CreationTimestamp = metav1.NewTime(time.Now().Add(-48 * time.Hour))
+		app.Status.LastScheduledSyncAt = nil
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.scheduledSync(app, syncStatus, v1alpha1.RefreshTypeNormal)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+		assert.NotNil(t, app.Status.LastScheduledSyncAt)
+	})
+
+	t.Run("HardRefreshBeforeSync", func(t *testing.T) {
+		app := newFakeAppWithSyncSchedule(time.Now().Add(-48 * time.Hour))
+		app.Spec.SyncPolicy.SyncSchedule.HardRefresh = true
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		ctrl.scheduledSync(app, syncStatus, v1alpha1.RefreshTypeNormal)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+		assert.Equal(t, string(v1alpha1.RefreshTypeHard), updatedApp.Annotations[v1alpha1.AnnotationKeyRefresh])
+
+		// the sync is started by the hard refresh
+		ctrl.scheduledSync(app, syncStatus, v1alpha1.RefreshTypeHard)
+
+		updatedApp, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, updatedApp.Operation)
+	})
+}
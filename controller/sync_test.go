@@ -3,6 +3,7 @@ package controller
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync"
 	synccommon "github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
@@ -101,6 +102,42 @@ func TestPersistManagedNamespaceMetadataState(t *testing.T) {
 	assert.Equal(t, app.Spec.SyncPolicy.ManagedNamespaceMetadata, opState.SyncResult.ManagedNamespaceMetadata)
 }
 
+func TestPersistManifestsDigest(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+			Provenance: &apiclient.ManifestProvenance{
+				Revision:        "abc123",
+				ManifestsDigest: "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(app, defaultProject, opState)
+	// Ensure we record the digest of the synced manifests into sync result
+	assert.Equal(t, "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", opState.SyncResult.ManifestsDigest)
+	assert.Empty(t, opState.SyncResult.ManifestsDigests)
+}
+
 func TestPersistRevisionHistoryRollback(t *testing.T) {
 	app := newFakeApp()
 	app.Status.OperationState = nil
@@ -277,6 +314,93 @@ func TestAppStateManager_SyncAppState(t *testing.T) {
 		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
 		assert.Contains(t, opState.Message, "ConfigMap/configmap1 is part of applications fake-argocd-ns/my-app and guestbook")
 	})
+
+	t.Run("will error the sync if the sync wave timeout is invalid", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup(nil)
+		f.project.Spec.SignatureKeys = nil
+
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source:      &v1alpha1.ApplicationSource{},
+				SyncOptions: []string{"SyncWaveTimeout=foo"},
+			},
+		}}
+
+		// when
+		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+
+		// then
+		assert.Equal(t, synccommon.OperationError, opState.Phase)
+		assert.Contains(t, opState.Message, "Failed to parse SyncWaveTimeout sync option")
+	})
+
+	t.Run("will error the sync if the prune budget is invalid", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup(nil)
+		f.project.Spec.SignatureKeys = nil
+
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source:      &v1alpha1.ApplicationSource{},
+				SyncOptions: []string{"PruneBudget=-1"},
+			},
+		}}
+
+		// when
+		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+
+		// then
+		assert.Equal(t, synccommon.OperationError, opState.Phase)
+		assert.Equal(t, "Failed to parse PruneBudget sync option: must not be negative", opState.Message)
+	})
+
+	t.Run("will error the sync if the resource retry backoff is invalid", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup(nil)
+		f.project.Spec.SignatureKeys = nil
+
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source: &v1alpha1.ApplicationSource{},
+			},
+			Retry: v1alpha1.RetryStrategy{Resources: &v1alpha1.ResourceRetryStrategy{Limit: 2, Backoff: &v1alpha1.Backoff{Duration: "foo"}}},
+		}}
+
+		// when
+		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+
+		// then
+		assert.Equal(t, synccommon.OperationError, opState.Phase)
+		assert.Contains(t, opState.Message, "Failed to parse resource retry backoff")
+	})
+}
+
+func TestNextResourceRetryAfter(t *testing.T) {
+	assert.Nil(t, nextResourceRetryAfter(&v1alpha1.OperationState{}))
+	assert.Nil(t, nextResourceRetryAfter(&v1alpha1.OperationState{SyncResult: &v1alpha1.SyncOperationResult{
+		Resources: v1alpha1.ResourceResults{{Name: "synced"}},
+	}}))
+
+	now := time.Now()
+	retryAfter := nextResourceRetryAfter(&v1alpha1.OperationState{SyncResult: &v1alpha1.SyncOperationResult{
+		Resources: v1alpha1.ResourceResults{
+			{Name: "later", NextRetryAt: &metav1.Time{Time: now.Add(time.Hour)}},
+			{Name: "synced"},
+			{Name: "sooner", NextRetryAt: &metav1.Time{Time: now.Add(time.Minute)}},
+		},
+	}})
+	require.NotNil(t, retryAfter)
+	assert.InDelta(t, time.Minute, *retryAfter, float64(time.Second))
+
+	retryAfter = nextResourceRetryAfter(&v1alpha1.OperationState{SyncResult: &v1alpha1.SyncOperationResult{
+		Resources: v1alpha1.ResourceResults{{Name: "overdue", NextRetryAt: &metav1.Time{Time: now.Add(-time.Minute)}}},
+	}})
+	require.NotNil(t, retryAfter)
+	assert.Zero(t, *retryAfter)
 }
 
 func TestSyncWindowDeniesSync(t *testing.T) {
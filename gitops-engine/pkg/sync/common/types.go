@@ -1,6 +1,8 @@
 package common
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -12,6 +14,8 @@ const (
 	AnnotationSyncOptions = "argocd.argoproj.io/sync-options"
 	// AnnotationSyncWave indicates which wave of the sync the resource or hook should be in
 	AnnotationSyncWave = "argocd.argoproj.io/sync-wave"
+	// AnnotationSyncWaveTimeout is the maximum duration the sync wave of the resource or hook may run for before the sync fails
+	AnnotationSyncWaveTimeout = "argocd.argoproj.io/sync-wave-timeout"
 	// AnnotationKeyHook contains the hook type of a resource
 	AnnotationKeyHook = "argocd.argoproj.io/hook"
 	// AnnotationKeyHookDeletePolicy is the policy of deleting a hook
@@ -166,4 +170,14 @@ type ResourceSyncResult struct {
 	HookPhase OperationPhase
 	// indicates the particular phase of the sync that this is for
 	SyncPhase SyncPhase
+	// the sync wave the resource or hook was synced in
+	SyncWave int
+	// the time at which the sync wave of the resource or hook started
+	WaveStartedAt time.Time
+	// the time elapsed in the sync wave of the resource or hook, updated until the wave completes
+	WaveDuration time.Duration
+	// the number of times applying or pruning the resource was retried after a transient error
+	RetryCount int
+	// the time at which applying or pruning the resource is retried after a transient error, zero if no retry is pending
+	NextRetryAt time.Time
 }
@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
@@ -34,6 +35,7 @@ import (
 	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync/hook"
 	resourceutil "github.com/argoproj/argo-cd/gitops-engine/pkg/sync/resource"
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/sync/syncwaves"
 	kubeutil "github.com/argoproj/argo-cd/gitops-engine/pkg/utils/kube"
 )
 
@@ -125,6 +127,15 @@ func WithPruneConfirmed(confirmed bool) SyncOpt {
 	}
 }
 
+// WithPruneBudget sets the maximum number, or percentage of the live resources, of resources a sync operation may
+// prune. The operation fails before running any task if more resources would be pruned, unless prune is confirmed.
+// A nil budget disables the check.
+func WithPruneBudget(budget *intstr.IntOrString) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.pruneBudget = budget
+	}
+}
+
 // WithOperationSettings allows to set sync operation settings
 func WithOperationSettings(dryRun bool, prune bool, force bool, skipHooks bool) SyncOpt {
 	return func(ctx *syncContext) {
@@ -185,6 +196,24 @@ func WithSyncWaveHook(syncWaveHook common.SyncWaveHook) SyncOpt {
 	}
 }
 
+// WithSyncWaveTimeout sets the maximum duration a sync wave may run for, unless overridden by the
+// sync-wave-timeout annotation of a resource in the wave. Zero disables the timeout.
+func WithSyncWaveTimeout(timeout time.Duration) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.syncWaveTimeout = timeout
+	}
+}
+
+// WithResourceRetry sets the maximum number of times applying or pruning a resource is retried when it fails with a
+// transient error, such as a conflict or a webhook timeout, before the resource is marked as failed. The wait between
+// retries is computed using the given backoff. A limit of zero disables the retries.
+func WithResourceRetry(limit int, backoff wait.Backoff) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.resourceRetryLimit = limit
+		ctx.resourceRetryBackoff = backoff
+	}
+}
+
 func WithReplace(replace bool) SyncOpt {
 	return func(ctx *syncContext) {
 		ctx.replace = replace
@@ -370,6 +399,7 @@ type syncContext struct {
 	pruneLast                       bool
 	prunePropagationPolicy          *metav1.DeletionPropagation
 	pruneConfirmed                  bool
+	pruneBudget                     *intstr.IntOrString
 	clientSideApplyMigrationManager string
 	enableClientSideApplyMigration  bool
 
@@ -387,7 +417,11 @@ type syncContext struct {
 	// namespace should be synced
 	syncNamespace func(*unstructured.Unstructured, *unstructured.Unstructured) (bool, error)
 
-	syncWaveHook common.SyncWaveHook
+	syncWaveHook    common.SyncWaveHook
+	syncWaveTimeout time.Duration
+
+	resourceRetryLimit   int
+	resourceRetryBackoff wait.Backoff
 
 	applyOutOfSyncOnly bool
 	// stores whether the resource is modified or not
@@ -466,6 +500,19 @@ func (sc *syncContext) Sync() {
 		// the dry-run for this operation, is if the resource or hook list is empty.
 		dryRunTasks := tasks
 
+		// Refuse to perform the sync if it would prune more resources than allowed, e.g. because of a bad change
+		// of the manifests generating none of the expected resources, until the pruning is confirmed.
+		message, err := sc.checkPruneBudget(tasks)
+		if err != nil {
+			sc.setOperationPhase(common.OperationFailed, err.Error())
+			return
+		}
+		if message != "" {
+			sc.log.WithValues("message", message).Info("Prune budget exceeded, prune requires confirmation")
+			sc.setOperationPhase(common.OperationRunning, "waiting for pruning confirmation: "+message)
+			return
+		}
+
 		// Before doing any validation, we have to create the application namespace if it does not exist.
 		// The validation is expected to fail in multiple scenarios if a namespace does not exist.
 		if nsCreateTask := sc.getNamespaceCreationTask(dryRunTasks); nsCreateTask != nil {
@@ -492,6 +539,9 @@ func (sc *syncContext) Sync() {
 		}
 	}
 
+	// record the elapsed time of the waves that were in progress, including those completing below
+	runningWaveTasks := tasks.Filter(func(t *syncTask) bool { return t.running() })
+
 	// update status of any tasks that are running, note that this must exclude pruning tasks
 	for _, task := range tasks.Filter(func(t *syncTask) bool {
 		// just occasionally, you can be running yet not have a live resource
@@ -525,6 +575,19 @@ func (sc *syncContext) Sync() {
 		}
 	}
 
+	sc.updateWaveDurations(runningWaveTasks)
+
+	// if the current wave has been running for longer than its timeout, fail the tasks that are still running
+	if timedOutTasks, message := sc.getTimedOutTasks(tasks); len(timedOutTasks) > 0 {
+		sc.log.WithValues("tasks", timedOutTasks).Info(message)
+		sc.terminateHooksPreemptively(timedOutTasks)
+		for _, task := range timedOutTasks {
+			if !task.successful() {
+				sc.setResourceResult(task, common.ResultCodeSyncFailed, common.OperationFailed, message)
+			}
+		}
+	}
+
 	// if (a) we are multi-step and we have any running tasks,
 	// or (b) there are any running hooks,
 	// then wait...
@@ -602,6 +665,14 @@ func (sc *syncContext) Sync() {
 	sc.log.WithValues("phase", phase, "wave", wave, "tasks", tasks, "syncFailTasks", syncFailTasks).V(1).Info("Filtering tasks in correct phase and wave")
 	tasks, remainingTasks := tasks.Split(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave })
 
+	// do not run the tasks which are waiting for the retry of a transient error yet
+	now := time.Now()
+	retryingTasks, tasks := tasks.Split(func(t *syncTask) bool { return t.nextRetryAt.After(now) })
+	if len(tasks) == 0 {
+		sc.setRetryingPhase(retryingTasks)
+		return
+	}
+
 	sc.setOperationPhase(common.OperationRunning, "one or more tasks are running")
 
 	sc.log.WithValues("tasks", tasks).V(1).Info("Wet-run")
@@ -619,6 +690,15 @@ func (sc *syncContext) Sync() {
 		}
 	}
 
+	// wait for the retries of the tasks which failed with a transient error before moving to the next wave
+	if runState != failed {
+		retryingTasks = append(retryingTasks, tasks.Filter(func(t *syncTask) bool { return t.retrying() })...)
+		if len(retryingTasks) > 0 {
+			sc.setRetryingPhase(retryingTasks)
+			return
+		}
+	}
+
 	switch runState {
 	case failed:
 		// If we failed to apply at least one resource, we need to start the syncFailTasks and wait
@@ -696,6 +776,72 @@ func (sc *syncContext) GetState() (common.OperationPhase, string, []common.Resou
 	return sc.phase, sc.message, resourceRes
 }
 
+// getTimedOutTasks returns the running tasks of the current sync wave if the wave has been running for longer than its
+// timeout, along with a message describing the timeout. The timeout of a wave is the largest sync-wave-timeout
+// annotation of its resources and hooks, or the operation default if none of them sets one.
+func (sc *syncContext) getTimedOutTasks(tasks syncTasks) (syncTasks, string) {
+	multiStep := tasks.multiStep()
+	runningTasks := tasks.Filter(func(t *syncTask) bool {
+		return (multiStep || t.isHook()) && t.running() && t.phase != common.SyncPhaseSyncFail
+	})
+	if len(runningTasks) == 0 {
+		return nil, ""
+	}
+	phase, wave := runningTasks.phase(), runningTasks.wave()
+
+	timeout := time.Duration(0)
+	for _, task := range tasks.Filter(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave }) {
+		timeout = max(timeout, syncwaves.Timeout(task.obj()))
+	}
+	if timeout == 0 {
+		timeout = sc.syncWaveTimeout
+	}
+	if timeout == 0 {
+		return nil, ""
+	}
+
+	sc.lock.Lock()
+	startedAt := sc.waveStartedAt(phase, wave)
+	sc.lock.Unlock()
+	if startedAt.IsZero() || time.Since(startedAt) < timeout {
+		return nil, ""
+	}
+	message := fmt.Sprintf("sync wave %d of phase %s timed out after %s", wave, phase, timeout)
+	return runningTasks.Filter(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave }), message
+}
+
+// waveStartedAt returns the time at which the first resource or hook of the given sync wave was synced, or the zero
+// time if none was. The caller must hold the lock.
+func (sc *syncContext) waveStartedAt(phase common.SyncPhase, wave int) time.Time {
+	var startedAt time.Time
+	for _, res := range sc.syncRes {
+		if res.SyncPhase != phase || res.SyncWave != wave || res.WaveStartedAt.IsZero() {
+			continue
+		}
+		if startedAt.IsZero() || res.WaveStartedAt.Before(startedAt) {
+			startedAt = res.WaveStartedAt
+		}
+	}
+	return startedAt
+}
+
+// updateWaveDurations updates the elapsed time of the sync waves of the given tasks on the results of the resources
+// and hooks in those waves
+func (sc *syncContext) updateWaveDurations(tasks syncTasks) {
+	if len(tasks) == 0 {
+		return
+	}
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+	for key, res := range sc.syncRes {
+		if res.WaveStartedAt.IsZero() || !tasks.Any(func(t *syncTask) bool { return t.phase == res.SyncPhase && t.wave() == res.SyncWave }) {
+			continue
+		}
+		res.WaveDuration = time.Since(res.WaveStartedAt)
+		sc.syncRes[key] = res
+	}
+}
+
 // filter out out-of-sync tasks
 func (sc *syncContext) filterOutOfSyncTasks(tasks syncTasks) syncTasks {
 	return tasks.Filter(func(t *syncTask) bool {
@@ -1086,6 +1232,8 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 			task.syncStatus = result.Status
 			task.operationState = result.HookPhase
 			task.message = result.Message
+			task.retryCount = result.RetryCount
+			task.nextRetryAt = result.NextRetryAt
 		}
 	}
 
@@ -1406,6 +1554,76 @@ func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dr
 	return common.ResultCodePruned, "pruned"
 }
 
+// transientErrors are fragments of the messages of errors which are expected to go away when applying or pruning a
+// resource is retried. Errors returned by kubectl lose their type, so they can only be identified by their message.
+var transientErrors = []string{
+	"the object has been modified",
+	"failed calling webhook",
+	"context deadline exceeded",
+	"the server was unable to return a response in the time allotted",
+	"the server is currently unable to handle the request",
+	"the server has received too many requests",
+	"etcdserver: request timed out",
+	"etcdserver: leader changed",
+	"http2: server sent GOAWAY and closed the connection",
+	"net/http: TLS handshake timeout",
+	"i/o timeout",
+	"connection reset by peer",
+	"connection refused",
+}
+
+// isTransientError returns whether the given error message of a failed apply or pruning is caused by a transient error
+func isTransientError(message string) bool {
+	for _, fragment := range transientErrors {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// runWithRetry runs the given apply or pruning of the task. If it fails with a transient error, a retry is scheduled
+// using the resource retry backoff and the task is left pending, so that it is run again by a later Sync call rather
+// than blocking the caller until the retry. Dry-runs are never retried since they do not reach the cluster.
+func (sc *syncContext) runWithRetry(t *syncTask, dryRun bool, run func() (common.ResultCode, string)) (_ common.ResultCode, _ string, retrying bool) {
+	t.nextRetryAt = time.Time{}
+	result, message := run()
+	if dryRun || result != common.ResultCodeSyncFailed || t.retryCount >= sc.resourceRetryLimit || !isTransientError(message) {
+		return result, message, false
+	}
+	t.retryCount++
+	t.nextRetryAt = time.Now().Add(sc.resourceRetryDelay(t.retryCount))
+	sc.log.WithValues("task", t, "retryCount", t.retryCount, "nextRetryAt", t.nextRetryAt).Info(fmt.Sprintf("Scheduling retry after transient error: %s", message))
+	sc.setResourceResult(t, "", "", fmt.Sprintf("retrying at %s after transient error: %s", t.nextRetryAt.Format(time.RFC3339), message))
+	return result, message, true
+}
+
+// resourceRetryDelay returns the wait before the given retry of a resource, computed using the resource retry backoff
+func (sc *syncContext) resourceRetryDelay(retry int) time.Duration {
+	backoff := sc.resourceRetryBackoff
+	backoff.Steps = retry
+	var delay time.Duration
+	for range retry {
+		delay = backoff.Step()
+	}
+	return delay
+}
+
+// setRetryingPhase sets the operation as running while waiting for the retries of the given tasks
+func (sc *syncContext) setRetryingPhase(tasks syncTasks) {
+	first := tasks[0]
+	for _, task := range tasks[1:] {
+		if task.nextRetryAt.Before(first.nextRetryAt) {
+			first = task
+		}
+	}
+	message := fmt.Sprintf("waiting for retry of %s/%s/%s after transient error", first.group(), first.kind(), first.name())
+	if len(tasks) > 1 {
+		message = fmt.Sprintf("%s and %d more resources", message, len(tasks)-1)
+	}
+	sc.setOperationPhase(common.OperationRunning, message)
+}
+
 func (sc *syncContext) getDeleteOptions() metav1.DeleteOptions {
 	propagationPolicy := metav1.DeletePropagationForeground
 	if sc.prunePropagationPolicy != nil {
@@ -1507,6 +1725,29 @@ var operationPhases = map[common.ResultCode]common.OperationPhase{
 	common.ResultCodePruneSkipped: common.OperationSucceeded,
 }
 
+// checkPruneBudget returns a message describing why the given tasks exceed the prune budget, or an empty string if
+// they do not or the pruning is confirmed
+func (sc *syncContext) checkPruneBudget(tasks syncTasks) (string, error) {
+	if sc.pruneBudget == nil || !sc.prune || sc.pruneConfirmed {
+		return "", nil
+	}
+	pruneTasks := tasks.Filter(func(t *syncTask) bool {
+		return t.isPrune() && !resourceutil.HasAnnotationOption(t.liveObj, common.AnnotationSyncOptions, common.SyncOptionDisablePrune)
+	})
+	if len(pruneTasks) == 0 {
+		return "", nil
+	}
+	liveTasks := tasks.Filter(func(t *syncTask) bool { return !t.isHook() && t.liveObj != nil })
+	budget, err := intstr.GetScaledValueFromIntOrPercent(sc.pruneBudget, len(liveTasks), false)
+	if err != nil {
+		return "", fmt.Errorf("invalid prune budget %s: %w", sc.pruneBudget.String(), err)
+	}
+	if len(pruneTasks) <= budget {
+		return "", nil
+	}
+	return fmt.Sprintf("%d of %d resources would be pruned, which exceeds the prune budget of %s", len(pruneTasks), len(liveTasks), sc.pruneBudget.String()), nil
+}
+
 // tri-state
 type runState int
 
@@ -1565,7 +1806,12 @@ func (sc *syncContext) runTasks(tasks syncTasks, dryRun bool) runState {
 			ss.Go(func(state runState) runState {
 				logCtx := sc.log.WithValues("dryRun", dryRun, "task", t)
 				logCtx.V(1).Info("Pruning")
-				result, message := sc.pruneObject(t.liveObj, sc.prune, dryRun)
+				result, message, retrying := sc.runWithRetry(t, dryRun, func() (common.ResultCode, string) {
+					return sc.pruneObject(t.liveObj, sc.prune, dryRun)
+				})
+				if retrying {
+					return pending
+				}
 				if result == common.ResultCodeSyncFailed {
 					state = failed
 					logCtx.WithValues("message", message).Info("Pruning failed")
@@ -1646,7 +1892,12 @@ func (sc *syncContext) processCreateTasks(state runState, tasks syncTasks, dryRu
 			logCtx := sc.log.WithValues("dryRun", dryRun, "task", t)
 			logCtx.V(1).Info("Applying")
 			validate := sc.validate && !resourceutil.HasAnnotationOption(t.targetObj, common.AnnotationSyncOptions, common.SyncOptionsDisableValidation)
-			result, message := sc.applyObject(t, dryRun, validate)
+			result, message, retrying := sc.runWithRetry(t, dryRun, func() (common.ResultCode, string) {
+				return sc.applyObject(t, dryRun, validate)
+			})
+			if retrying {
+				return pending
+			}
 			if result == common.ResultCodeSyncFailed {
 				logCtx.WithValues("message", message).Info("Apply failed")
 				state = failed
@@ -1688,6 +1939,9 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 		HookType:    task.hookType(),
 		HookPhase:   task.operationState,
 		SyncPhase:   task.phase,
+		SyncWave:    task.wave(),
+		RetryCount:  task.retryCount,
+		NextRetryAt: task.nextRetryAt,
 	}
 
 	logCtx := sc.log.WithValues("namespace", task.namespace(), "kind", task.kind(), "name", task.name(), "phase", task.phase)
@@ -1703,10 +1957,16 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 			existing.HookPhase = res.HookPhase
 			existing.Message = res.Message
 		}
+		existing.RetryCount = res.RetryCount
+		existing.NextRetryAt = res.NextRetryAt
 		sc.syncRes[task.resultKey()] = existing
 	} else {
 		logCtx.Info(fmt.Sprintf("Adding resource result, status: '%s', phase: '%s', message: '%s'", res.Status, res.HookPhase, res.Message))
 		res.Order = len(sc.syncRes) + 1
+		res.WaveStartedAt = sc.waveStartedAt(res.SyncPhase, res.SyncWave)
+		if res.WaveStartedAt.IsZero() {
+			res.WaveStartedAt = time.Now()
+		}
 		sc.syncRes[task.resultKey()] = res
 	}
 }
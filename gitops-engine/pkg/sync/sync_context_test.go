@@ -21,6 +21,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	fakedisco "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic/fake"
@@ -348,6 +350,213 @@ func TestSyncSuccessfully_Multistep(t *testing.T) {
 	assert.Len(t, resources, 2)
 }
 
+func TestSync_SyncWaveTimeout(t *testing.T) {
+	svc := testingutils.NewService()
+	svc.SetNamespace(testingutils.FakeArgoCDNamespace)
+	testingutils.Annotate(svc, synccommon.AnnotationSyncWave, "0")
+	testingutils.Annotate(svc, synccommon.AnnotationSyncWaveTimeout, "1m")
+
+	svc2 := testingutils.NewService()
+	svc2.SetNamespace(testingutils.FakeArgoCDNamespace)
+	svc2.SetName("new-svc-2")
+	testingutils.Annotate(svc2, synccommon.AnnotationSyncWave, "5")
+
+	syncFailHook := newHook("sync-fail-hook", synccommon.HookTypeSyncFail, synccommon.HookDeletePolicyHookSucceeded)
+
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, true, false, false),
+		WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+			svc.GetName():          health.HealthStatusProgressing,
+			syncFailHook.GetName(): health.HealthStatusHealthy,
+		})),
+		WithInitialState(synccommon.OperationRunning, "", []synccommon.ResourceSyncResult{{
+			ResourceKey:   kube.GetResourceKey(svc),
+			HookPhase:     synccommon.OperationRunning,
+			Status:        synccommon.ResultCodeSynced,
+			SyncPhase:     synccommon.SyncPhaseSync,
+			WaveStartedAt: time.Now().Add(-2 * time.Minute),
+			Order:         1,
+		}}, metav1.Now()))
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{svc, nil},
+		Target: []*unstructured.Unstructured{svc, svc2},
+	})
+	syncCtx.hooks = []*unstructured.Unstructured{syncFailHook}
+	syncCtx.dynamicIf = fake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	// The timed out wave fails the sync and starts the SyncFail hooks
+	syncCtx.Sync()
+	phase, message, resources := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.Equal(t, "waiting for completion of hook /Pod/sync-fail-hook", message)
+	require.Len(t, resources, 2)
+	assert.Equal(t, synccommon.ResultCodeSyncFailed, resources[0].Status)
+	assert.Equal(t, synccommon.OperationFailed, resources[0].HookPhase)
+	assert.Equal(t, "sync wave 0 of phase Sync timed out after 1m0s", resources[0].Message)
+	assert.GreaterOrEqual(t, resources[0].WaveDuration, 2*time.Minute)
+
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{svc, nil, syncFailHook},
+		Target: []*unstructured.Unstructured{svc, svc2, nil},
+	})
+
+	syncCtx.Sync()
+	phase, message, resources = syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	assert.Equal(t, "one or more synchronization tasks completed unsuccessfully, reason: sync wave 0 of phase Sync timed out after 1m0s", message)
+	assert.Len(t, resources, 2)
+}
+
+func TestSync_SyncWaveTimeoutNotExceeded(t *testing.T) {
+	svc := testingutils.NewService()
+	svc.SetNamespace(testingutils.FakeArgoCDNamespace)
+	testingutils.Annotate(svc, synccommon.AnnotationSyncWave, "0")
+
+	svc2 := testingutils.NewService()
+	svc2.SetNamespace(testingutils.FakeArgoCDNamespace)
+	svc2.SetName("new-svc-2")
+	testingutils.Annotate(svc2, synccommon.AnnotationSyncWave, "5")
+
+	startedAt := time.Now().Add(-time.Minute)
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, true, false, false),
+		WithSyncWaveTimeout(5*time.Minute),
+		WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+			svc.GetName(): health.HealthStatusProgressing,
+		})),
+		WithInitialState(synccommon.OperationRunning, "", []synccommon.ResourceSyncResult{{
+			ResourceKey:   kube.GetResourceKey(svc),
+			HookPhase:     synccommon.OperationRunning,
+			Status:        synccommon.ResultCodeSynced,
+			SyncPhase:     synccommon.SyncPhaseSync,
+			WaveStartedAt: startedAt,
+			Order:         1,
+		}}, metav1.Now()))
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{svc, nil},
+		Target: []*unstructured.Unstructured{svc, svc2},
+	})
+
+	syncCtx.Sync()
+	phase, message, resources := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.Equal(t, "waiting for healthy state of /Service/my-service", message)
+	require.Len(t, resources, 1)
+	assert.Equal(t, synccommon.OperationRunning, resources[0].HookPhase)
+	assert.GreaterOrEqual(t, resources[0].WaveDuration, time.Minute)
+
+	// The next wave starts its own timer once the current wave is healthy
+	syncCtx.healthOverride = resourceNameHealthOverride(map[string]health.HealthStatusCode{svc.GetName(): health.HealthStatusHealthy})
+	syncCtx.Sync()
+	_, _, resources = syncCtx.GetState()
+	require.Len(t, resources, 2)
+	assert.Equal(t, 5, resources[1].SyncWave)
+	assert.True(t, resources[1].WaveStartedAt.After(startedAt))
+}
+
+func TestSync_ResourceRetry(t *testing.T) {
+	newSyncCtx := func(err error) *syncContext {
+		syncCtx := newTestSyncCtx(nil, WithResourceRetry(2, wait.Backoff{Duration: time.Millisecond, Factor: 2}), func(ctx *syncContext) {
+			resourceOps := ctx.resourceOps.(*kubetest.MockResourceOps)
+			resourceOps.Commands = map[string]kubetest.KubectlOutput{}
+			resourceOps.Commands[testingutils.NewPod().GetName()] = kubetest.KubectlOutput{Err: err}
+		})
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod()},
+		})
+		return syncCtx
+	}
+
+	t.Run("TransientError", func(t *testing.T) {
+		syncCtx := newSyncCtx(errors.New("Internal error occurred: failed calling webhook \"validate.example.com\": context deadline exceeded"))
+
+		// the sync is not blocked by the retries, which run in the next syncs
+		for retry := 1; retry <= 2; retry++ {
+			syncCtx.Sync()
+			phase, message, resources := syncCtx.GetState()
+			assert.Equal(t, synccommon.OperationRunning, phase)
+			assert.Equal(t, "waiting for retry of /Pod/my-pod after transient error", message)
+			require.Len(t, resources, 1)
+			assert.Empty(t, resources[0].Status)
+			assert.Empty(t, resources[0].HookPhase)
+			assert.Equal(t, retry, resources[0].RetryCount)
+			assert.False(t, resources[0].NextRetryAt.IsZero())
+			time.Sleep(time.Until(resources[0].NextRetryAt))
+		}
+		syncCtx.Sync()
+
+		phase, _, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		require.Len(t, resources, 1)
+		assert.Equal(t, synccommon.ResultCodeSyncFailed, resources[0].Status)
+		assert.Equal(t, 2, resources[0].RetryCount)
+		assert.True(t, resources[0].NextRetryAt.IsZero())
+	})
+
+	t.Run("WaitsForRetry", func(t *testing.T) {
+		syncCtx := newSyncCtx(errors.New("connection refused"))
+		syncCtx.resourceRetryBackoff = wait.Backoff{Duration: time.Hour}
+		syncCtx.Sync()
+		syncCtx.Sync()
+
+		phase, _, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		require.Len(t, resources, 1)
+		assert.Equal(t, 1, resources[0].RetryCount)
+	})
+
+	t.Run("PermanentError", func(t *testing.T) {
+		syncCtx := newSyncCtx(errors.New("spec.containers: Required value"))
+		syncCtx.Sync()
+
+		phase, _, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		require.Len(t, resources, 1)
+		assert.Zero(t, resources[0].RetryCount)
+	})
+}
+
+func TestRunWithRetry(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithResourceRetry(3, wait.Backoff{Duration: time.Second}))
+	task := &syncTask{targetObj: testingutils.NewPod()}
+
+	attempts := 0
+	run := func() (synccommon.ResultCode, string) {
+		attempts++
+		if attempts == 1 {
+			return synccommon.ResultCodeSyncFailed, "Operation cannot be fulfilled on pods \"my-pod\": the object has been modified"
+		}
+		return synccommon.ResultCodeSynced, "pod/my-pod configured"
+	}
+	_, _, retrying := syncCtx.runWithRetry(task, false, run)
+	assert.True(t, retrying)
+	assert.True(t, task.retrying())
+	assert.Equal(t, 1, task.retryCount)
+	assert.WithinDuration(t, time.Now().Add(time.Second), task.nextRetryAt, 100*time.Millisecond)
+
+	result, message, retrying := syncCtx.runWithRetry(task, false, run)
+	assert.False(t, retrying)
+	assert.Equal(t, synccommon.ResultCodeSynced, result)
+	assert.Equal(t, "pod/my-pod configured", message)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, 1, task.retryCount)
+	assert.True(t, task.nextRetryAt.IsZero())
+
+	// dry-runs are never retried
+	result, _, retrying = syncCtx.runWithRetry(&syncTask{targetObj: testingutils.NewPod()}, true, func() (synccommon.ResultCode, string) {
+		return synccommon.ResultCodeSyncFailed, "i/o timeout"
+	})
+	assert.Equal(t, synccommon.ResultCodeSyncFailed, result)
+	assert.False(t, retrying)
+}
+
+func TestResourceRetryDelay(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithResourceRetry(5, wait.Backoff{Duration: time.Second, Factor: 2, Cap: 5 * time.Second}))
+	assert.Equal(t, time.Second, syncCtx.resourceRetryDelay(1))
+	assert.Equal(t, 2*time.Second, syncCtx.resourceRetryDelay(2))
+	assert.Equal(t, 4*time.Second, syncCtx.resourceRetryDelay(3))
+	assert.Equal(t, 5*time.Second, syncCtx.resourceRetryDelay(4))
+}
+
 func TestSyncDeleteSuccessfully(t *testing.T) {
 	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, true, false, false))
 	svc := testingutils.NewService()
@@ -877,6 +1086,75 @@ func TestPruneConfirm(t *testing.T) {
 	assert.Equal(t, "pruned", resources[0].Message)
 }
 
+func TestPruneBudget(t *testing.T) {
+	tests := []struct {
+		name      string
+		budget    intstr.IntOrString
+		confirmed bool
+		phase     synccommon.OperationPhase
+		message   string
+	}{
+		{"WithinCountBudget", intstr.FromInt(3), false, synccommon.OperationSucceeded, "successfully synced (all tasks run)"},
+		{"ExceedsCountBudget", intstr.FromInt(2), false, synccommon.OperationRunning, "waiting for pruning confirmation: 3 of 4 resources would be pruned, which exceeds the prune budget of 2"},
+		{"WithinPercentBudget", intstr.FromString("75%"), false, synccommon.OperationSucceeded, "successfully synced (all tasks run)"},
+		{"ExceedsPercentBudget", intstr.FromString("50%"), false, synccommon.OperationRunning, "waiting for pruning confirmation: 3 of 4 resources would be pruned, which exceeds the prune budget of 50%"},
+		{"Confirmed", intstr.FromInt(0), true, synccommon.OperationSucceeded, "successfully synced (all tasks run)"},
+		{"Invalid", intstr.FromString("many"), false, synccommon.OperationFailed, "invalid prune budget many: invalid value for IntOrString: invalid type: string is not a percentage"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, true, false, false), WithPruneBudget(&tt.budget), WithPruneConfirmed(tt.confirmed))
+			var live, target []*unstructured.Unstructured
+			for _, name := range []string{"pod-1", "pod-2", "pod-3", "pod-4"} {
+				pod := testingutils.NewPod()
+				pod.SetName(name)
+				pod.SetNamespace(testingutils.FakeArgoCDNamespace)
+				live = append(live, pod)
+				target = append(target, nil)
+			}
+			target[0] = live[0]
+			syncCtx.resources = groupResources(ReconciliationResult{Live: live, Target: target})
+
+			syncCtx.Sync()
+
+			phase, message, _ := syncCtx.GetState()
+			assert.Equal(t, tt.phase, phase)
+			assert.Equal(t, tt.message, message)
+		})
+	}
+
+	t.Run("ConfirmedWhileWaiting", func(t *testing.T) {
+		budget := intstr.FromInt(1)
+		syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, true, false, false), WithPruneBudget(&budget))
+		var live, target []*unstructured.Unstructured
+		for _, name := range []string{"pod-1", "pod-2"} {
+			pod := testingutils.NewPod()
+			pod.SetName(name)
+			pod.SetNamespace(testingutils.FakeArgoCDNamespace)
+			live = append(live, pod)
+			target = append(target, nil)
+		}
+		syncCtx.resources = groupResources(ReconciliationResult{Live: live, Target: target})
+
+		syncCtx.Sync()
+
+		phase, message, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.Equal(t, "waiting for pruning confirmation: 2 of 2 resources would be pruned, which exceeds the prune budget of 1", message)
+		assert.Empty(t, resources)
+
+		syncCtx.pruneConfirmed = true
+		syncCtx.Sync()
+
+		phase, _, resources = syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		require.Len(t, resources, 2)
+		for _, res := range resources {
+			assert.Equal(t, synccommon.ResultCodePruned, res.Status)
+		}
+	})
+}
+
 // // make sure Validate=false means we don't validate
 func TestSyncOptionValidate(t *testing.T) {
 	tests := []struct {
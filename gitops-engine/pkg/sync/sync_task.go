@@ -2,6 +2,7 @@ package sync
 
 import (
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -24,6 +25,8 @@ type syncTask struct {
 	operationState common.OperationPhase
 	message        string
 	waveOverride   *int
+	retryCount     int
+	nextRetryAt    time.Time
 }
 
 func ternary(val bool, a, b string) string {
@@ -95,6 +98,11 @@ func (t *syncTask) pending() bool {
 	return t.operationState == ""
 }
 
+// retrying returns whether the task is pending the retry of a transient error
+func (t *syncTask) retrying() bool {
+	return t.pending() && !t.nextRetryAt.IsZero()
+}
+
 func (t *syncTask) running() bool {
 	return t.operationState.Running()
 }
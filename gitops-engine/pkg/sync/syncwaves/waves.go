@@ -2,6 +2,7 @@ package syncwaves
 
 import (
 	"strconv"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -19,3 +20,15 @@ func Wave(obj *unstructured.Unstructured) int {
 	}
 	return helmhook.Weight(obj)
 }
+
+// Timeout returns the sync wave timeout of the object, or zero if the object does not set a valid timeout
+func Timeout(obj *unstructured.Unstructured) time.Duration {
+	text, ok := obj.GetAnnotations()[common.AnnotationSyncWaveTimeout]
+	if ok {
+		val, err := time.ParseDuration(text)
+		if err == nil && val > 0 {
+			return val
+		}
+	}
+	return 0
+}
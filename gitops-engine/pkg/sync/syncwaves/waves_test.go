@@ -2,6 +2,7 @@ package syncwaves
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -13,3 +14,10 @@ func TestWave(t *testing.T) {
 	assert.Equal(t, 1, Wave(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave", "1")))
 	assert.Equal(t, 1, Wave(testingutils.Annotate(testingutils.NewPod(), "helm.sh/hook-weight", "1")))
 }
+
+func TestTimeout(t *testing.T) {
+	assert.Equal(t, time.Duration(0), Timeout(testingutils.NewPod()))
+	assert.Equal(t, 5*time.Minute, Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout", "5m")))
+	assert.Equal(t, time.Duration(0), Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout", "foo")))
+	assert.Equal(t, time.Duration(0), Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout", "-1m")))
+}
@@ -0,0 +1,9 @@
+#!/bin/bash
+set -eux -o pipefail
+
+. "$(dirname "$0")"/../tool-versions.sh
+
+# cue is built from its pinned module version, which is verified by the Go checksum database, on all the platforms of
+# the Argo CD image
+GOBIN=/tmp/cue CGO_ENABLED=0 go install "cuelang.org/go/cmd/cue@v${cue_version}"
+sudo install -m 0755 /tmp/cue/cue "$BIN/cue"
@@ -0,0 +1,9 @@
+#!/bin/bash
+set -eux -o pipefail
+
+. "$(dirname "$0")"/../tool-versions.sh
+
+# helmfile is built from its pinned module version, which is verified by the Go checksum database, on all the
+# platforms of the Argo CD image
+GOBIN=/tmp/helmfile CGO_ENABLED=0 go install "github.com/helmfile/helmfile@v${helmfile_version}"
+sudo install -m 0755 /tmp/helmfile/helmfile "$BIN/helmfile"
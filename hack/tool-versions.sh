@@ -10,9 +10,14 @@
 #
 # Use ./hack/installers/checksums/add-helm-checksums.sh and
 # add-kustomize-checksums.sh to help download checksums.
+#
+# Tools built from source with `go install`, such as helmfile and cue, are verified by
+# the Go checksum database and do not need checksum files.
 ###############################################################################
 helm3_version=3.19.4
 kustomize5_version=5.8.1
 protoc_version=29.3
 oras_version=1.2.0
 git_lfs_version=3.7.1
+helmfile_version=1.1.0
+cue_version=0.13.2
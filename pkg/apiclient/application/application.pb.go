@@ -2489,6 +2489,69 @@ func (m *ApplicationSyncWindowsQuery) GetProject() string {
 	return ""
 }
 
+type ApplicationDryRunReportQuery struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationDryRunReportQuery) Reset()         { *m = ApplicationDryRunReportQuery{} }
+func (m *ApplicationDryRunReportQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationDryRunReportQuery) ProtoMessage()    {}
+func (*ApplicationDryRunReportQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{29}
+}
+func (m *ApplicationDryRunReportQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDryRunReportQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationDryRunReportQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationDryRunReportQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDryRunReportQuery.Merge(m, src)
+}
+func (m *ApplicationDryRunReportQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDryRunReportQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDryRunReportQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDryRunReportQuery proto.InternalMessageInfo
+
+func (m *ApplicationDryRunReportQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationDryRunReportQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationDryRunReportQuery) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
 type ApplicationSyncWindowsResponse struct {
 	ActiveWindows        []*ApplicationSyncWindow `protobuf:"bytes,1,rep,name=activeWindows" json:"activeWindows,omitempty"`
 	AssignedWindows      []*ApplicationSyncWindow `protobuf:"bytes,2,rep,name=assignedWindows" json:"assignedWindows,omitempty"`
@@ -2502,7 +2565,7 @@ func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncW
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{29}
+	return fileDescriptor_df6e82b174b5eaec, []int{30}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2566,7 +2629,7 @@ func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{30}
+	return fileDescriptor_df6e82b174b5eaec, []int{31}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2633,7 +2696,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{31}
+	return fileDescriptor_df6e82b174b5eaec, []int{32}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2662,6 +2725,77 @@ func (m *OperationTerminateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OperationTerminateResponse proto.InternalMessageInfo
 
+type OperationApprovalRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	Message              *string  `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OperationApprovalRequest) Reset()         { *m = OperationApprovalRequest{} }
+func (m *OperationApprovalRequest) String() string { return proto.CompactTextString(m) }
+func (*OperationApprovalRequest) ProtoMessage()    {}
+func (*OperationApprovalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{33}
+}
+func (m *OperationApprovalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationApprovalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationApprovalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperationApprovalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationApprovalRequest.Merge(m, src)
+}
+func (m *OperationApprovalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationApprovalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationApprovalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationApprovalRequest proto.InternalMessageInfo
+
+func (m *OperationApprovalRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *OperationApprovalRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *OperationApprovalRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *OperationApprovalRequest) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
 type ResourcesQuery struct {
 	ApplicationName      *string  `protobuf:"bytes,1,req,name=applicationName" json:"applicationName,omitempty"`
 	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
@@ -2680,7 +2814,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{32}
+	return fileDescriptor_df6e82b174b5eaec, []int{34}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2776,7 +2910,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{33}
+	return fileDescriptor_df6e82b174b5eaec, []int{35}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2827,7 +2961,7 @@ func (m *ApplicationServerSideDiffQuery) Reset()         { *m = ApplicationServe
 func (m *ApplicationServerSideDiffQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationServerSideDiffQuery) ProtoMessage()    {}
 func (*ApplicationServerSideDiffQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{34}
+	return fileDescriptor_df6e82b174b5eaec, []int{36}
 }
 func (m *ApplicationServerSideDiffQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2903,7 +3037,7 @@ func (m *ApplicationServerSideDiffResponse) Reset()         { *m = ApplicationSe
 func (m *ApplicationServerSideDiffResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationServerSideDiffResponse) ProtoMessage()    {}
 func (*ApplicationServerSideDiffResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{35}
+	return fileDescriptor_df6e82b174b5eaec, []int{37}
 }
 func (m *ApplicationServerSideDiffResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2960,7 +3094,7 @@ func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
 func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
 func (*LinkInfo) ProtoMessage()    {}
 func (*LinkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{36}
+	return fileDescriptor_df6e82b174b5eaec, []int{38}
 }
 func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3028,7 +3162,7 @@ func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
 func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
 func (*LinksResponse) ProtoMessage()    {}
 func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{37}
+	return fileDescriptor_df6e82b174b5eaec, []int{39}
 }
 func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3077,7 +3211,7 @@ func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
 func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppLinksRequest) ProtoMessage()    {}
 func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{38}
+	return fileDescriptor_df6e82b174b5eaec, []int{40}
 }
 func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3157,9 +3291,11 @@ func init() {
 	proto.RegisterType((*LogEntry)(nil), "application.LogEntry")
 	proto.RegisterType((*OperationTerminateRequest)(nil), "application.OperationTerminateRequest")
 	proto.RegisterType((*ApplicationSyncWindowsQuery)(nil), "application.ApplicationSyncWindowsQuery")
+	proto.RegisterType((*ApplicationDryRunReportQuery)(nil), "application.ApplicationDryRunReportQuery")
 	proto.RegisterType((*ApplicationSyncWindowsResponse)(nil), "application.ApplicationSyncWindowsResponse")
 	proto.RegisterType((*ApplicationSyncWindow)(nil), "application.ApplicationSyncWindow")
 	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
+	proto.RegisterType((*OperationApprovalRequest)(nil), "application.OperationApprovalRequest")
 	proto.RegisterType((*ResourcesQuery)(nil), "application.ResourcesQuery")
 	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
 	proto.RegisterType((*ApplicationServerSideDiffQuery)(nil), "application.ApplicationServerSideDiffQuery")
@@ -3174,196 +3310,204 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 3017 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xcd, 0x8f, 0x1c, 0x47,
-	0x15, 0xa7, 0x66, 0x76, 0x76, 0x67, 0xdf, 0x78, 0xfd, 0x51, 0xb1, 0x4d, 0x67, 0xbc, 0x31, 0x9b,
-	0xb6, 0x1d, 0x6f, 0xd6, 0xde, 0x19, 0x7b, 0x62, 0x20, 0xd9, 0x24, 0x04, 0x67, 0xed, 0x38, 0x0b,
-	0x6b, 0xc7, 0xf4, 0x3a, 0x31, 0x0a, 0x07, 0xa8, 0x74, 0xd7, 0xcc, 0x34, 0xdb, 0xd3, 0xdd, 0xee,
-	0xee, 0x99, 0xb0, 0x0a, 0xb9, 0x04, 0x21, 0x71, 0x88, 0x82, 0x80, 0x1c, 0x38, 0xf0, 0x99, 0x28,
-	0x08, 0x21, 0x10, 0x17, 0x84, 0x90, 0x10, 0x12, 0x1c, 0x82, 0xe0, 0x80, 0x84, 0xe0, 0x1f, 0x40,
-	0x11, 0xe2, 0xc0, 0x21, 0xb9, 0xe4, 0x8c, 0x50, 0x55, 0x57, 0x75, 0x77, 0xcd, 0x47, 0xcf, 0x2c,
-	0x33, 0x10, 0x4b, 0xdc, 0xfa, 0xd5, 0x74, 0xbf, 0xf7, 0x7b, 0xaf, 0xde, 0x7b, 0xf5, 0xea, 0xbd,
-	0x5d, 0x38, 0x1d, 0xd2, 0xa0, 0x47, 0x83, 0x3a, 0xf1, 0x7d, 0xc7, 0x36, 0x49, 0x64, 0x7b, 0x6e,
-	0xf6, 0xb9, 0xe6, 0x07, 0x5e, 0xe4, 0xe1, 0x4a, 0x66, 0xa9, 0xba, 0xdc, 0xf2, 0xbc, 0x96, 0x43,
-	0xeb, 0xc4, 0xb7, 0xeb, 0xc4, 0x75, 0xbd, 0x88, 0x2f, 0x87, 0xf1, 0xab, 0x55, 0x7d, 0xf7, 0xe1,
-	0xb0, 0x66, 0x7b, 0xfc, 0x57, 0xd3, 0x0b, 0x68, 0xbd, 0x77, 0xb1, 0xde, 0xa2, 0x2e, 0x0d, 0x48,
-	0x44, 0x2d, 0xf1, 0xce, 0xa5, 0xf4, 0x9d, 0x0e, 0x31, 0xdb, 0xb6, 0x4b, 0x83, 0xbd, 0xba, 0xbf,
-	0xdb, 0x62, 0x0b, 0x61, 0xbd, 0x43, 0x23, 0x32, 0xec, 0xab, 0xed, 0x96, 0x1d, 0xb5, 0xbb, 0x2f,
-	0xd4, 0x4c, 0xaf, 0x53, 0x27, 0x41, 0xcb, 0xf3, 0x03, 0xef, 0x8b, 0xfc, 0x61, 0xdd, 0xb4, 0xea,
-	0xbd, 0x87, 0x52, 0x06, 0x59, 0x5d, 0x7a, 0x17, 0x89, 0xe3, 0xb7, 0xc9, 0x20, 0xb7, 0xab, 0x63,
-	0xb8, 0x05, 0xd4, 0xf7, 0x84, 0x6d, 0xf8, 0xa3, 0x1d, 0x79, 0xc1, 0x5e, 0xe6, 0x31, 0x66, 0xa3,
-	0xbf, 0x8f, 0xe0, 0xf0, 0xe5, 0x54, 0xde, 0x67, 0xba, 0x34, 0xd8, 0xc3, 0x18, 0xe6, 0x5c, 0xd2,
-	0xa1, 0x1a, 0x5a, 0x41, 0xab, 0x8b, 0x06, 0x7f, 0xc6, 0x1a, 0x2c, 0x04, 0xb4, 0x19, 0xd0, 0xb0,
-	0xad, 0x15, 0xf8, 0xb2, 0x24, 0x71, 0x15, 0xca, 0x4c, 0x38, 0x35, 0xa3, 0x50, 0x2b, 0xae, 0x14,
-	0x57, 0x17, 0x8d, 0x84, 0xc6, 0xab, 0x70, 0x28, 0xa0, 0xa1, 0xd7, 0x0d, 0x4c, 0xfa, 0x1c, 0x0d,
-	0x42, 0xdb, 0x73, 0xb5, 0x39, 0xfe, 0x75, 0xff, 0x32, 0xe3, 0x12, 0x52, 0x87, 0x9a, 0x91, 0x17,
-	0x68, 0x25, 0xfe, 0x4a, 0x42, 0x33, 0x3c, 0x0c, 0xb8, 0x36, 0x1f, 0xe3, 0x61, 0xcf, 0x58, 0x87,
-	0x03, 0xc4, 0xf7, 0x6f, 0x90, 0x0e, 0x0d, 0x7d, 0x62, 0x52, 0x6d, 0x81, 0xff, 0xa6, 0xac, 0x31,
-	0xcc, 0x02, 0x89, 0x56, 0xe6, 0xc0, 0x24, 0xa9, 0x6f, 0xc2, 0xe2, 0x0d, 0xcf, 0xa2, 0xa3, 0xd5,
-	0xed, 0x67, 0x5f, 0x18, 0x64, 0xaf, 0xbf, 0x8d, 0xe0, 0x98, 0x41, 0x7b, 0x36, 0xc3, 0x7f, 0x9d,
-	0x46, 0xc4, 0x22, 0x11, 0xe9, 0xe7, 0x58, 0x48, 0x38, 0x56, 0xa1, 0x1c, 0x88, 0x97, 0xb5, 0x02,
-	0x5f, 0x4f, 0xe8, 0x01, 0x69, 0xc5, 0x7c, 0x65, 0x62, 0x13, 0x4a, 0x12, 0xaf, 0x40, 0x25, 0xb6,
-	0xe5, 0x96, 0x6b, 0xd1, 0x2f, 0x71, 0xeb, 0x95, 0x8c, 0xec, 0x12, 0x5e, 0x86, 0xc5, 0x5e, 0x6c,
-	0xe7, 0x2d, 0x8b, 0x5b, 0xb1, 0x64, 0xa4, 0x0b, 0xfa, 0x3f, 0x10, 0x9c, 0xcc, 0xf8, 0x80, 0x21,
-	0x76, 0xe6, 0x6a, 0x8f, 0xba, 0x51, 0x38, 0x5a, 0xa1, 0xf3, 0x70, 0x44, 0x6e, 0x62, 0xbf, 0x9d,
-	0x06, 0x7f, 0x60, 0x2a, 0x66, 0x17, 0xa5, 0x8a, 0xd9, 0x35, 0xa6, 0x88, 0xa4, 0x9f, 0xdd, 0xba,
-	0x22, 0xd4, 0xcc, 0x2e, 0x0d, 0x18, 0xaa, 0x94, 0x6f, 0xa8, 0x79, 0xc5, 0x50, 0xfa, 0x3f, 0x11,
-	0x68, 0x19, 0x45, 0xaf, 0x13, 0xd7, 0x6e, 0xd2, 0x30, 0x9a, 0x74, 0xcf, 0xd0, 0x0c, 0xf7, 0x6c,
-	0x15, 0x0e, 0xc5, 0x5a, 0xdd, 0x64, 0xf1, 0xc8, 0xf2, 0x8f, 0x56, 0x5a, 0x29, 0xae, 0x16, 0x8d,
-	0xfe, 0x65, 0xb6, 0x77, 0x52, 0x66, 0xa8, 0xcd, 0x73, 0x37, 0x4e, 0x17, 0x98, 0x04, 0xd7, 0xdb,
-	0x24, 0x66, 0x3b, 0x8e, 0x80, 0xb2, 0x21, 0x49, 0xfd, 0x7e, 0x58, 0x7c, 0xca, 0x76, 0xe8, 0x66,
-	0xbb, 0xeb, 0xee, 0xe2, 0xa3, 0x50, 0x32, 0xd9, 0x03, 0xd7, 0xee, 0x80, 0x11, 0x13, 0xfa, 0x37,
-	0x10, 0xdc, 0x3f, 0xca, 0x1e, 0xb7, 0xed, 0xa8, 0xcd, 0xbe, 0x0f, 0x47, 0x19, 0xc6, 0x6c, 0x53,
-	0x73, 0x37, 0xec, 0x76, 0xa4, 0x33, 0x4b, 0x7a, 0x3a, 0xc3, 0xe8, 0x3f, 0x41, 0xb0, 0x3a, 0x16,
-	0xd3, 0xed, 0x80, 0xf8, 0x3e, 0x0d, 0xf0, 0x53, 0x50, 0xba, 0xc3, 0x7e, 0xe0, 0xa1, 0x5b, 0x69,
-	0xd4, 0x6a, 0xd9, 0xd4, 0x3f, 0x96, 0xcb, 0xd3, 0x1f, 0x32, 0xe2, 0xcf, 0x71, 0x4d, 0x9a, 0xa7,
-	0xc0, 0xf9, 0x1c, 0x57, 0xf8, 0x24, 0x56, 0x64, 0xef, 0xf3, 0xd7, 0x9e, 0x9c, 0x87, 0x39, 0x9f,
-	0x04, 0x91, 0x7e, 0x0c, 0xee, 0x51, 0x03, 0xc7, 0xf7, 0xdc, 0x90, 0xea, 0xbf, 0x56, 0xfd, 0x6c,
-	0x33, 0xa0, 0x24, 0xa2, 0x06, 0xbd, 0xd3, 0xa5, 0x61, 0x84, 0x77, 0x21, 0x7b, 0x1a, 0x71, 0xab,
-	0x56, 0x1a, 0x5b, 0xb5, 0x34, 0x9d, 0xd7, 0x64, 0x3a, 0xe7, 0x0f, 0x9f, 0x37, 0xad, 0x5a, 0xef,
-	0xa1, 0x9a, 0xbf, 0xdb, 0xaa, 0xb1, 0xc3, 0x41, 0x41, 0x26, 0x0f, 0x87, 0xac, 0xaa, 0x46, 0x96,
-	0x3b, 0x3e, 0x0e, 0xf3, 0x5d, 0x3f, 0xa4, 0x41, 0xc4, 0x35, 0x2b, 0x1b, 0x82, 0x62, 0xfb, 0xd7,
-	0x23, 0x8e, 0x6d, 0x91, 0x28, 0xde, 0x9f, 0xb2, 0x91, 0xd0, 0xfa, 0x6f, 0x54, 0xf4, 0xcf, 0xfa,
-	0xd6, 0x07, 0x85, 0x3e, 0x8b, 0xb2, 0xa0, 0xa2, 0xcc, 0x7a, 0x50, 0x51, 0xf5, 0xa0, 0x5f, 0xa8,
-	0xf8, 0xaf, 0x50, 0x87, 0xa6, 0xf8, 0x87, 0x39, 0xb3, 0x06, 0x0b, 0x26, 0x09, 0x4d, 0x62, 0x49,
-	0x29, 0x92, 0x64, 0x29, 0xce, 0x0f, 0x3c, 0x9f, 0xb4, 0x38, 0xa7, 0x9b, 0x9e, 0x63, 0x9b, 0x7b,
-	0x42, 0xdc, 0xe0, 0x0f, 0x03, 0x8e, 0x3f, 0x97, 0xef, 0xf8, 0x25, 0x15, 0xf6, 0x29, 0xa8, 0xec,
-	0xec, 0xb9, 0xe6, 0x33, 0x7e, 0x1c, 0xf6, 0x47, 0xa1, 0x64, 0x47, 0xb4, 0x13, 0x6a, 0x88, 0x87,
-	0x7c, 0x4c, 0xe8, 0xff, 0x2a, 0xc1, 0xf1, 0x8c, 0x6e, 0xec, 0x83, 0x3c, 0xcd, 0xf2, 0xf2, 0xd7,
-	0x71, 0x98, 0xb7, 0x82, 0x3d, 0xa3, 0xeb, 0x0a, 0x07, 0x10, 0x14, 0x13, 0xec, 0x07, 0x5d, 0x37,
-	0x86, 0x5f, 0x36, 0x62, 0x02, 0x37, 0xa1, 0x1c, 0x46, 0xac, 0xfe, 0x68, 0xed, 0x71, 0xe0, 0x95,
-	0xc6, 0xa7, 0xa6, 0xdb, 0x74, 0x06, 0x7d, 0x47, 0x70, 0x34, 0x12, 0xde, 0xf8, 0x0e, 0xcb, 0x76,
-	0x71, 0x0a, 0x0c, 0xb5, 0x85, 0x95, 0xe2, 0x6a, 0xa5, 0xb1, 0x33, 0xbd, 0xa0, 0x67, 0x7c, 0x56,
-	0x3b, 0x65, 0xce, 0x36, 0x23, 0x95, 0xc2, 0x12, 0x6c, 0x47, 0xe4, 0x87, 0x50, 0xd4, 0x09, 0xe9,
-	0x02, 0xfe, 0x2c, 0x94, 0x6c, 0xb7, 0xe9, 0x85, 0xda, 0x22, 0x07, 0xf3, 0xe4, 0x74, 0x60, 0xb6,
-	0xdc, 0xa6, 0x67, 0xc4, 0x0c, 0xf1, 0x1d, 0x58, 0x0a, 0x68, 0x14, 0xec, 0x49, 0x2b, 0x68, 0xc0,
-	0xed, 0xfa, 0xe9, 0xe9, 0x24, 0x18, 0x59, 0x96, 0x86, 0x2a, 0x01, 0x6f, 0x40, 0x25, 0x4c, 0x7d,
-	0x4c, 0xab, 0x70, 0x81, 0x9a, 0xc2, 0x28, 0xe3, 0x83, 0x46, 0xf6, 0xe5, 0x01, 0xef, 0x3e, 0x90,
-	0xef, 0xdd, 0x4b, 0x63, 0xcf, 0xbb, 0x83, 0x13, 0x9c, 0x77, 0x87, 0xfa, 0xce, 0x3b, 0xfd, 0x3d,
-	0x04, 0xcb, 0x03, 0xc9, 0x69, 0xc7, 0xa7, 0xb9, 0x61, 0x40, 0x60, 0x2e, 0xf4, 0xa9, 0xc9, 0x4f,
-	0xaa, 0x4a, 0xe3, 0xfa, 0xcc, 0xb2, 0x15, 0x97, 0xcb, 0x59, 0xe7, 0x25, 0xd4, 0x29, 0xf3, 0xc2,
-	0xf7, 0x11, 0x7c, 0x38, 0x23, 0xf3, 0x26, 0x89, 0xcc, 0x76, 0x9e, 0xb2, 0x2c, 0x7e, 0xd9, 0x3b,
-	0xe2, 0x5c, 0x8e, 0x09, 0x66, 0x55, 0xfe, 0x70, 0x6b, 0xcf, 0x67, 0x00, 0xd9, 0x2f, 0xe9, 0xc2,
-	0x94, 0x65, 0xd5, 0x4f, 0x11, 0x54, 0xb3, 0x39, 0xdc, 0x73, 0x9c, 0x17, 0x88, 0xb9, 0x9b, 0x07,
-	0xf2, 0x20, 0x14, 0x6c, 0x8b, 0x23, 0x2c, 0x1a, 0x05, 0xdb, 0xda, 0x67, 0x32, 0xea, 0x87, 0x3b,
-	0x9f, 0x0f, 0x77, 0x41, 0x85, 0xfb, 0x7e, 0x1f, 0x5c, 0x99, 0x12, 0x72, 0xe0, 0x2e, 0xc3, 0xa2,
-	0xdb, 0x57, 0xe2, 0xa6, 0x0b, 0x43, 0x4a, 0xdb, 0xc2, 0x40, 0x69, 0xab, 0xc1, 0x42, 0x2f, 0xb9,
-	0x00, 0xb1, 0x9f, 0x25, 0xc9, 0x54, 0x6c, 0x05, 0x5e, 0xd7, 0x17, 0x46, 0x8f, 0x09, 0x86, 0x62,
-	0xd7, 0x76, 0x59, 0xb1, 0xce, 0x51, 0xb0, 0xe7, 0xfd, 0x5f, 0x79, 0x14, 0xb5, 0x7f, 0x56, 0x80,
-	0x8f, 0x0c, 0x51, 0x7b, 0xac, 0x3f, 0xdd, 0x1d, 0xba, 0x27, 0x5e, 0xbd, 0x30, 0xd2, 0xab, 0xcb,
-	0xe3, 0xbc, 0x7a, 0x31, 0xdf, 0x5e, 0xa0, 0xda, 0xeb, 0xc7, 0x05, 0x58, 0x19, 0x62, 0xaf, 0xf1,
-	0xe5, 0xc4, 0x5d, 0x63, 0xb0, 0xa6, 0x17, 0x98, 0xf2, 0x5a, 0x10, 0x13, 0x2c, 0xce, 0xbc, 0xc0,
-	0x6f, 0x13, 0x97, 0x7b, 0x47, 0xd9, 0x10, 0xd4, 0x94, 0xa6, 0xba, 0x02, 0x9a, 0x34, 0xcf, 0x65,
-	0x33, 0x4e, 0x52, 0x01, 0xe9, 0xd0, 0x88, 0x06, 0xe1, 0xa8, 0x14, 0xd5, 0x23, 0x4e, 0x97, 0xca,
-	0x14, 0xc5, 0x09, 0xfd, 0xb5, 0x42, 0x3f, 0x1b, 0xa3, 0xeb, 0xde, 0xfd, 0x86, 0x3e, 0x0e, 0xf3,
-	0x84, 0xa3, 0x15, 0xae, 0x29, 0xa8, 0x01, 0x93, 0x96, 0xf3, 0x4d, 0xba, 0xa8, 0x98, 0x74, 0xa3,
-	0xa0, 0x21, 0xfd, 0xbd, 0x02, 0x54, 0x47, 0x19, 0xe4, 0xb9, 0xc6, 0xff, 0x9b, 0x49, 0x30, 0x01,
-	0x2d, 0x18, 0xe1, 0x65, 0x1a, 0xf0, 0xe2, 0xec, 0x8c, 0x72, 0x62, 0x8f, 0x72, 0x49, 0x63, 0x24,
-	0x1b, 0xfd, 0xab, 0x08, 0x4e, 0xa8, 0x9f, 0x85, 0xdb, 0x76, 0x18, 0xc9, 0x8b, 0x1d, 0x6e, 0xc2,
-	0x42, 0xac, 0x4a, 0x5c, 0x96, 0x57, 0x1a, 0xdb, 0xd3, 0x16, 0x6b, 0xca, 0xee, 0x4a, 0xe6, 0xfa,
-	0x23, 0x70, 0x62, 0xe8, 0x09, 0x25, 0x60, 0x54, 0xa1, 0x2c, 0x0b, 0x54, 0xb1, 0xfb, 0x09, 0xad,
-	0xbf, 0x39, 0xa7, 0x96, 0x0b, 0x9e, 0xb5, 0xed, 0xb5, 0x72, 0xba, 0x38, 0xf9, 0x1e, 0xc3, 0x76,
-	0xc3, 0xb3, 0x32, 0x0d, 0x1b, 0x49, 0xb2, 0xef, 0x4c, 0xcf, 0x8d, 0x88, 0xed, 0xd2, 0x40, 0x54,
-	0x34, 0xe9, 0x02, 0xdb, 0xe9, 0xd0, 0x76, 0x4d, 0xba, 0x43, 0x4d, 0xcf, 0xb5, 0x42, 0xee, 0x32,
-	0x45, 0x43, 0x59, 0xc3, 0x4f, 0xc3, 0x22, 0xa7, 0x6f, 0xd9, 0x9d, 0xf8, 0x08, 0xaf, 0x34, 0xd6,
-	0x6a, 0x71, 0x67, 0xb5, 0x96, 0xed, 0xac, 0xa6, 0x36, 0xec, 0xd0, 0x88, 0xd4, 0x7a, 0x17, 0x6b,
-	0xec, 0x0b, 0x23, 0xfd, 0x98, 0x61, 0x89, 0x88, 0xed, 0x6c, 0xdb, 0x2e, 0xbf, 0x34, 0x30, 0x51,
-	0xe9, 0x02, 0xf3, 0xc6, 0xa6, 0xe7, 0x38, 0xde, 0x8b, 0x32, 0xe7, 0xc5, 0x14, 0xfb, 0xaa, 0xeb,
-	0x46, 0xb6, 0xc3, 0xe5, 0xc7, 0xbe, 0x96, 0x2e, 0xf0, 0xaf, 0x6c, 0x27, 0xa2, 0x81, 0x48, 0x76,
-	0x82, 0x4a, 0xfc, 0xbd, 0x12, 0x37, 0x0b, 0x65, 0xae, 0x8d, 0x23, 0xe3, 0x40, 0x36, 0x32, 0xfa,
-	0xa3, 0x6d, 0x69, 0x48, 0xc7, 0x8b, 0xf7, 0x4e, 0x69, 0xcf, 0xf6, 0xba, 0xac, 0x1e, 0xe6, 0x65,
-	0xa3, 0xa4, 0x07, 0xa2, 0xe5, 0x50, 0x7e, 0xb4, 0x1c, 0x56, 0xa3, 0x85, 0xdf, 0x6a, 0x22, 0xb3,
-	0xbd, 0x49, 0x42, 0xaa, 0x1d, 0xe1, 0xac, 0xd3, 0x05, 0xfd, 0xb7, 0x08, 0xca, 0xdb, 0x5e, 0xeb,
-	0xaa, 0x1b, 0x05, 0x7b, 0xfc, 0xfe, 0xeb, 0xb9, 0x11, 0x75, 0xa5, 0x37, 0x49, 0x92, 0x6d, 0x51,
-	0x64, 0x77, 0xe8, 0x4e, 0x44, 0x3a, 0xbe, 0xa8, 0x9e, 0xf7, 0xb5, 0x45, 0xc9, 0xc7, 0xcc, 0x6c,
-	0x0e, 0x09, 0x23, 0x9e, 0x72, 0xca, 0x06, 0x7f, 0x66, 0x0a, 0x26, 0x2f, 0xec, 0x44, 0x81, 0xc8,
-	0x37, 0xca, 0x5a, 0xd6, 0x01, 0x4b, 0x31, 0x36, 0x41, 0xea, 0x1d, 0xb8, 0x37, 0xb9, 0xd6, 0xdd,
-	0xa2, 0x41, 0xc7, 0x76, 0x49, 0xfe, 0xb9, 0x3c, 0x41, 0x4b, 0x37, 0xa7, 0xab, 0xe0, 0x29, 0x21,
-	0xc9, 0x6e, 0x49, 0xb7, 0x6d, 0xd7, 0xf2, 0x5e, 0xcc, 0x09, 0xad, 0xe9, 0x04, 0xfe, 0x45, 0xed,
-	0xca, 0x66, 0x24, 0x26, 0x79, 0xe0, 0x69, 0x58, 0x62, 0x19, 0xa3, 0x47, 0xc5, 0x0f, 0x22, 0x29,
-	0xe9, 0xa3, 0xda, 0x60, 0x29, 0x0f, 0x43, 0xfd, 0x10, 0x6f, 0xc3, 0x21, 0x12, 0x86, 0x76, 0xcb,
-	0xa5, 0x96, 0xe4, 0x55, 0x98, 0x98, 0x57, 0xff, 0xa7, 0x71, 0x43, 0x85, 0xbf, 0x21, 0xf6, 0x5b,
-	0x92, 0xfa, 0x57, 0x10, 0x1c, 0x1b, 0xca, 0x24, 0x89, 0x2b, 0x94, 0x39, 0x47, 0xaa, 0x50, 0x0e,
-	0xcd, 0x36, 0xb5, 0xba, 0x8e, 0x2c, 0x15, 0x12, 0x9a, 0xfd, 0x66, 0x75, 0xe3, 0xdd, 0x17, 0xe7,
-	0x58, 0x42, 0xe3, 0x93, 0x00, 0x1d, 0xe2, 0x76, 0x89, 0xc3, 0x21, 0xcc, 0x71, 0x08, 0x99, 0x15,
-	0x7d, 0x19, 0xaa, 0xc3, 0x5c, 0x47, 0x74, 0xef, 0xde, 0x45, 0x70, 0x50, 0xa6, 0x5c, 0xb1, 0xbb,
-	0xab, 0x70, 0x28, 0x63, 0x86, 0x1b, 0xe9, 0x46, 0xf7, 0x2f, 0x8f, 0x49, 0xa7, 0xd2, 0x4b, 0x8a,
-	0xea, 0x60, 0xa5, 0xa7, 0x8c, 0x46, 0x26, 0x3e, 0x70, 0xd1, 0x8c, 0x6e, 0x06, 0x5f, 0x06, 0xed,
-	0x3a, 0x71, 0x49, 0x8b, 0x5a, 0x89, 0xda, 0x89, 0x8b, 0x7d, 0x21, 0xdb, 0x86, 0x9a, 0xba, 0xe9,
-	0x93, 0x14, 0xd1, 0x76, 0xb3, 0x29, 0x5b, 0x5a, 0xaf, 0x17, 0x54, 0x3f, 0xe7, 0x33, 0xab, 0x1d,
-	0xdb, 0xe2, 0x2f, 0xc5, 0xe6, 0xd7, 0x60, 0x41, 0xa8, 0x22, 0x13, 0x94, 0x20, 0xa7, 0x0b, 0x31,
-	0xec, 0xc3, 0x92, 0x63, 0xf7, 0x68, 0xa2, 0xb5, 0x36, 0x37, 0x73, 0x25, 0x55, 0x01, 0xcc, 0x91,
-	0x22, 0x12, 0xb4, 0x68, 0x74, 0x3d, 0xe9, 0x38, 0x95, 0x78, 0x8b, 0xa3, 0x7f, 0x59, 0xff, 0xa1,
-	0xda, 0x9b, 0x57, 0xcd, 0xf2, 0xbf, 0xdb, 0x1e, 0x5e, 0x6b, 0x78, 0x96, 0xdd, 0xb4, 0x69, 0x7c,
-	0x5f, 0x2f, 0x1b, 0x09, 0xad, 0x07, 0x50, 0xde, 0xb6, 0xdd, 0xdd, 0x2d, 0xb7, 0xe9, 0x31, 0x67,
-	0x8d, 0xec, 0xc8, 0x91, 0x3b, 0x14, 0x13, 0xf8, 0x30, 0x14, 0xbb, 0x81, 0x23, 0x82, 0x97, 0x3d,
-	0xe2, 0x15, 0xa8, 0x58, 0x34, 0x34, 0x03, 0xdb, 0x17, 0xa1, 0xcb, 0x67, 0x3c, 0x99, 0x25, 0x16,
-	0x42, 0xb6, 0xe9, 0xb9, 0x9b, 0x0e, 0x09, 0x43, 0x59, 0x59, 0x24, 0x0b, 0xfa, 0x63, 0xb0, 0xc4,
-	0x64, 0xa6, 0x1e, 0x7a, 0x4e, 0x35, 0xc1, 0x31, 0x45, 0x35, 0x09, 0x4f, 0x3a, 0x1b, 0x81, 0x7b,
-	0x58, 0x41, 0x77, 0xd9, 0xf7, 0x05, 0x93, 0x09, 0x6f, 0x17, 0xc5, 0x61, 0x85, 0xd1, 0xd0, 0x01,
-	0x46, 0xe3, 0xdd, 0xb3, 0x80, 0xfb, 0x36, 0xce, 0x36, 0x29, 0xfe, 0x26, 0x82, 0x39, 0x26, 0x1a,
-	0xdf, 0x37, 0x2a, 0xa3, 0x72, 0x5f, 0xaf, 0xce, 0xae, 0x3b, 0xc5, 0xa4, 0xe9, 0xcb, 0xaf, 0xfc,
-	0xf5, 0xef, 0xdf, 0x2a, 0x1c, 0xc7, 0x47, 0xf9, 0x40, 0xbb, 0x77, 0x31, 0x3b, 0x5c, 0x0e, 0xf1,
-	0xab, 0x08, 0xb0, 0x28, 0x70, 0x33, 0x23, 0x3f, 0x7c, 0x6e, 0x14, 0xc4, 0x21, 0xa3, 0xc1, 0xea,
-	0x7d, 0x99, 0x82, 0xa0, 0x66, 0x7a, 0x01, 0x65, 0xc7, 0x3f, 0x7f, 0x81, 0x03, 0x58, 0xe3, 0x00,
-	0x4e, 0x63, 0x7d, 0x18, 0x80, 0xfa, 0x4b, 0xcc, 0xa2, 0x2f, 0xd7, 0x69, 0x2c, 0xf7, 0x0d, 0x04,
-	0xa5, 0xdb, 0xfc, 0x62, 0x3f, 0xc6, 0x48, 0x3b, 0x33, 0x33, 0x12, 0x17, 0xc7, 0xd1, 0xea, 0xa7,
-	0x38, 0xd2, 0xfb, 0xf0, 0x09, 0x89, 0x34, 0x8c, 0x02, 0x4a, 0x3a, 0x0a, 0xe0, 0x0b, 0x08, 0xbf,
-	0x85, 0x60, 0x3e, 0x9e, 0xe8, 0xe0, 0x33, 0xa3, 0x50, 0x2a, 0x13, 0x9f, 0xea, 0xec, 0xc6, 0x23,
-	0xfa, 0x83, 0x1c, 0xe3, 0x29, 0x7d, 0xe8, 0x76, 0x6e, 0x28, 0xc3, 0x93, 0xd7, 0x11, 0x14, 0xaf,
-	0xd1, 0xb1, 0xfe, 0x36, 0x43, 0x70, 0x03, 0x06, 0x1c, 0xb2, 0xd5, 0xf8, 0x4d, 0x04, 0xf7, 0x5e,
-	0xa3, 0xd1, 0xf0, 0xca, 0x06, 0xaf, 0x8e, 0x2f, 0x37, 0x84, 0xdb, 0x9d, 0x9b, 0xe0, 0xcd, 0xe4,
-	0x48, 0xaf, 0x73, 0x64, 0x0f, 0xe2, 0xb3, 0x79, 0x4e, 0x18, 0xee, 0xb9, 0xe6, 0x8b, 0x02, 0xc7,
-	0x1f, 0x11, 0x1c, 0xee, 0x1f, 0xed, 0x63, 0xbd, 0xef, 0x7a, 0x39, 0x64, 0xf2, 0x5f, 0xbd, 0x31,
-	0x6d, 0x06, 0x56, 0x99, 0xea, 0x97, 0x39, 0xf2, 0x47, 0xf1, 0x23, 0x79, 0xc8, 0x93, 0xf6, 0x78,
-	0xfd, 0x25, 0xf9, 0xf8, 0x32, 0xff, 0x33, 0x14, 0x0e, 0xfb, 0x4f, 0x08, 0x8e, 0x4a, 0xbe, 0x9b,
-	0x6d, 0x12, 0x44, 0x57, 0x28, 0xbb, 0x1c, 0x85, 0x13, 0xe9, 0x33, 0xe5, 0x89, 0x92, 0x95, 0xa7,
-	0x5f, 0xe5, 0xba, 0x3c, 0x81, 0x1f, 0xdf, 0xb7, 0x2e, 0x26, 0x63, 0x63, 0x09, 0xd8, 0x6f, 0x23,
-	0x38, 0x78, 0x8d, 0x46, 0xcf, 0x6c, 0x6e, 0xed, 0x6b, 0x67, 0xa6, 0x74, 0xf4, 0x8c, 0x38, 0xfd,
-	0x0a, 0x57, 0xe4, 0x13, 0xf8, 0xb1, 0x7d, 0x2b, 0xe2, 0x99, 0x76, 0xb2, 0x2f, 0xaf, 0x20, 0x38,
-	0x70, 0x2d, 0x73, 0xe4, 0x8f, 0x4e, 0x27, 0xca, 0xf8, 0xba, 0xba, 0x5c, 0xcb, 0xfc, 0x15, 0x8f,
-	0xfc, 0x29, 0x71, 0xf5, 0x75, 0x8e, 0xed, 0x2c, 0x3e, 0x93, 0x87, 0x2d, 0x1d, 0x6f, 0xbd, 0x81,
-	0xe0, 0x58, 0x16, 0x44, 0x3a, 0xf6, 0xff, 0xe8, 0xfe, 0x86, 0xe9, 0x62, 0x24, 0x3f, 0x06, 0x5d,
-	0x83, 0xa3, 0x3b, 0xaf, 0x0f, 0x0f, 0xc4, 0xce, 0x00, 0x8a, 0x0d, 0xb4, 0xb6, 0x8a, 0xf0, 0xef,
-	0x10, 0xcc, 0xc7, 0x93, 0x9e, 0xd1, 0x36, 0x52, 0xc6, 0xd4, 0xb3, 0xcc, 0x6a, 0xc2, 0x6b, 0xab,
-	0x17, 0x86, 0x1b, 0x34, 0xfb, 0xbd, 0xdc, 0xda, 0x1a, 0xb7, 0xb2, 0x9a, 0x8e, 0x7f, 0x89, 0x00,
-	0xd2, 0x69, 0x15, 0x7e, 0x30, 0x5f, 0x8f, 0xcc, 0x44, 0xab, 0x3a, 0xdb, 0x79, 0x95, 0x5e, 0xe3,
-	0xfa, 0xac, 0x56, 0x57, 0x72, 0x73, 0xa1, 0x4f, 0xcd, 0x8d, 0x78, 0xb2, 0xf5, 0x03, 0x04, 0x25,
-	0x3e, 0x24, 0xc0, 0xa7, 0x47, 0x61, 0xce, 0xce, 0x10, 0x66, 0x69, 0xfa, 0x07, 0x38, 0xd4, 0x95,
-	0x46, 0xde, 0x81, 0xb2, 0x81, 0xd6, 0x70, 0x0f, 0xe6, 0xe3, 0xb6, 0xfc, 0x68, 0xf7, 0x50, 0xda,
-	0xf6, 0xd5, 0x95, 0x9c, 0x02, 0x27, 0x76, 0x54, 0x71, 0x96, 0xad, 0x8d, 0x3b, 0xcb, 0xe6, 0xd8,
-	0x71, 0x83, 0x4f, 0xe5, 0x1d, 0x46, 0xff, 0x05, 0xc3, 0x9c, 0xe3, 0xe8, 0xce, 0xe8, 0x2b, 0xe3,
-	0xce, 0x33, 0x66, 0x9d, 0x6f, 0x23, 0x38, 0xdc, 0x7f, 0xbf, 0xc3, 0x27, 0x86, 0xb6, 0x4a, 0xc5,
-	0xd9, 0xaa, 0x5a, 0x71, 0xd4, 0xdd, 0x50, 0xff, 0x24, 0x47, 0xb1, 0x81, 0x1f, 0x1e, 0x1b, 0x19,
-	0x37, 0x64, 0xd6, 0x61, 0x8c, 0xd6, 0xd3, 0xd1, 0xfb, 0x8f, 0x10, 0x1c, 0x54, 0x6f, 0x36, 0xa3,
-	0x6b, 0xcf, 0x21, 0x17, 0xc3, 0x6a, 0x6d, 0xb2, 0x97, 0x13, 0xc4, 0x1f, 0xe7, 0x88, 0x2f, 0xe2,
-	0xfa, 0x48, 0xc4, 0x31, 0xd2, 0xf8, 0x0f, 0x27, 0xd7, 0x43, 0xdb, 0xa2, 0xeb, 0x16, 0x43, 0xf5,
-	0x2b, 0x04, 0x07, 0xa4, 0x01, 0x6e, 0x05, 0x94, 0xe6, 0xdb, 0x6f, 0x76, 0x11, 0xcb, 0x64, 0xe9,
-	0x8f, 0x71, 0xd4, 0x1f, 0xc3, 0x97, 0x26, 0xb4, 0xb3, 0xb4, 0xef, 0x7a, 0xc4, 0x90, 0xfe, 0x1e,
-	0xc1, 0x91, 0xdb, 0x71, 0x80, 0x7e, 0x40, 0xf8, 0x37, 0x39, 0xfe, 0xc7, 0xf1, 0xa3, 0x39, 0x85,
-	0xf5, 0x38, 0x35, 0x2e, 0x20, 0xfc, 0x73, 0x04, 0x65, 0x39, 0x5b, 0xc6, 0x67, 0x47, 0x46, 0xb0,
-	0x3a, 0x7d, 0x9e, 0x65, 0xd4, 0x89, 0x2a, 0x52, 0x3f, 0x9d, 0x7b, 0xec, 0x0b, 0xf9, 0x2c, 0xf2,
-	0x5e, 0x47, 0x80, 0x93, 0xfe, 0x52, 0xd2, 0x71, 0xc2, 0x0f, 0x28, 0xa2, 0x46, 0x36, 0x31, 0xab,
-	0x67, 0xc7, 0xbe, 0xa7, 0x9e, 0xf9, 0x6b, 0xb9, 0x67, 0xbe, 0x97, 0xc8, 0x7f, 0x0d, 0x41, 0xe5,
-	0x1a, 0x4d, 0x2e, 0x7d, 0x39, 0xb6, 0x54, 0x47, 0xe3, 0xd5, 0xd5, 0xf1, 0x2f, 0x0a, 0x44, 0xe7,
-	0x39, 0xa2, 0x07, 0x70, 0xbe, 0xa9, 0x24, 0x80, 0xef, 0x20, 0x58, 0xba, 0x99, 0x75, 0x51, 0x7c,
-	0x7e, 0x9c, 0x24, 0xe5, 0xc8, 0x99, 0x1c, 0xd7, 0x43, 0x1c, 0xd7, 0xba, 0x3e, 0x11, 0xae, 0x0d,
-	0x31, 0x65, 0xfe, 0x1e, 0x8a, 0xbb, 0x06, 0x7d, 0x93, 0xa1, 0xff, 0xd4, 0x6e, 0x39, 0x03, 0x26,
-	0xfd, 0x12, 0xc7, 0x57, 0xc3, 0xe7, 0x27, 0xc1, 0x57, 0x17, 0xe3, 0x22, 0xfc, 0x5d, 0x04, 0x47,
-	0xf8, 0x68, 0x30, 0xcb, 0x18, 0xe7, 0x4d, 0xc3, 0xd2, 0x41, 0xe2, 0x04, 0x67, 0xe1, 0x13, 0x71,
-	0xfe, 0xd1, 0xf7, 0x05, 0x6a, 0x43, 0x0c, 0xfd, 0xbe, 0x56, 0x40, 0x6c, 0x7f, 0xef, 0x19, 0xc0,
-	0xf7, 0x5c, 0xa3, 0xcf, 0x80, 0xa3, 0x47, 0x9d, 0x13, 0x60, 0xdc, 0xe0, 0x18, 0x2f, 0xe9, 0xf5,
-	0xfd, 0x60, 0xac, 0xf7, 0x1a, 0x2c, 0x4c, 0xbf, 0x8e, 0xe0, 0xa0, 0xac, 0x0f, 0x84, 0xff, 0xad,
-	0x8f, 0xdb, 0xda, 0xfd, 0xd6, 0x13, 0x22, 0x20, 0xd6, 0x26, 0x0b, 0x88, 0xb7, 0x10, 0x2c, 0x88,
-	0xc9, 0x5d, 0x4e, 0xd5, 0x95, 0x19, 0xed, 0x55, 0xfb, 0xda, 0x5e, 0x62, 0xb4, 0xa3, 0x7f, 0x8e,
-	0x8b, 0x7d, 0x16, 0xe7, 0x9a, 0xc5, 0xf7, 0xac, 0xb0, 0xfe, 0x92, 0x98, 0xab, 0xbc, 0x5c, 0x77,
-	0xbc, 0x56, 0xf8, 0xbc, 0x8e, 0x73, 0x6b, 0x0b, 0xf6, 0xce, 0x05, 0x84, 0x23, 0x58, 0x64, 0xee,
-	0xcb, 0x7b, 0x69, 0x78, 0xa5, 0xaf, 0xf3, 0x36, 0xd0, 0x66, 0xab, 0x56, 0x07, 0x7a, 0x73, 0x69,
-	0x31, 0x21, 0x3a, 0x1b, 0xf8, 0xfe, 0x5c, 0xb1, 0x5c, 0xd0, 0xab, 0x08, 0x8e, 0x64, 0xe3, 0x31,
-	0x16, 0x3f, 0x71, 0x34, 0xe6, 0xa1, 0x10, 0xf7, 0x13, 0xbc, 0x36, 0x91, 0x1b, 0x71, 0x38, 0x4f,
-	0x3e, 0xf5, 0x87, 0x77, 0x4e, 0xa2, 0x3f, 0xbf, 0x73, 0x12, 0xfd, 0xed, 0x9d, 0x93, 0xe8, 0xf9,
-	0x87, 0x27, 0xfb, 0x2f, 0x0f, 0xd3, 0xb1, 0xa9, 0x1b, 0x65, 0xd9, 0xff, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0xf3, 0x23, 0xc0, 0x98, 0xcb, 0x32, 0x00, 0x00,
+	// 3141 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x4d, 0x8c, 0x1c, 0x47,
+	0xf5, 0xff, 0xd7, 0xec, 0xce, 0xee, 0xec, 0x1b, 0xaf, 0xd7, 0xae, 0xd8, 0xfe, 0x77, 0xc6, 0x1b,
+	0xb3, 0x29, 0xdb, 0xf1, 0x66, 0xed, 0x9d, 0xb1, 0x27, 0x06, 0x9c, 0x4d, 0x42, 0x70, 0xd6, 0x8e,
+	0x63, 0x58, 0x3b, 0xa6, 0xd7, 0x89, 0x51, 0x38, 0x40, 0xa5, 0xbb, 0x76, 0xb6, 0xd9, 0x99, 0xee,
+	0x76, 0x75, 0xcf, 0x84, 0x55, 0xc8, 0x25, 0x08, 0xc4, 0x21, 0x0a, 0x82, 0x04, 0x89, 0x03, 0x9f,
+	0x89, 0x82, 0x10, 0x02, 0x71, 0x41, 0x08, 0x09, 0x90, 0xe0, 0x10, 0x04, 0x07, 0x24, 0x04, 0x47,
+	0x2e, 0x28, 0x42, 0x1c, 0x38, 0x90, 0x4b, 0xce, 0x08, 0x55, 0x75, 0x55, 0x7f, 0xcc, 0x47, 0xcf,
+	0x2c, 0x33, 0x26, 0x96, 0xb8, 0xf5, 0xab, 0xa9, 0x7e, 0xef, 0x57, 0xaf, 0xde, 0x7b, 0xf5, 0xea,
+	0xbd, 0x1e, 0x38, 0x11, 0x30, 0xde, 0x61, 0xbc, 0x46, 0x7d, 0xbf, 0xe9, 0x58, 0x34, 0x74, 0x3c,
+	0x37, 0xfd, 0x5c, 0xf5, 0xb9, 0x17, 0x7a, 0xb8, 0x9c, 0x1a, 0xaa, 0x2c, 0x36, 0x3c, 0xaf, 0xd1,
+	0x64, 0x35, 0xea, 0x3b, 0x35, 0xea, 0xba, 0x5e, 0x28, 0x87, 0x83, 0x68, 0x6a, 0x85, 0xec, 0x5c,
+	0x08, 0xaa, 0x8e, 0x27, 0x7f, 0xb5, 0x3c, 0xce, 0x6a, 0x9d, 0x73, 0xb5, 0x06, 0x73, 0x19, 0xa7,
+	0x21, 0xb3, 0xd5, 0x9c, 0xf3, 0xc9, 0x9c, 0x16, 0xb5, 0xb6, 0x1d, 0x97, 0xf1, 0xdd, 0x9a, 0xbf,
+	0xd3, 0x10, 0x03, 0x41, 0xad, 0xc5, 0x42, 0xda, 0xef, 0xad, 0x8d, 0x86, 0x13, 0x6e, 0xb7, 0x9f,
+	0xaf, 0x5a, 0x5e, 0xab, 0x46, 0x79, 0xc3, 0xf3, 0xb9, 0xf7, 0x59, 0xf9, 0xb0, 0x6a, 0xd9, 0xb5,
+	0xce, 0x43, 0x09, 0x83, 0xf4, 0x5a, 0x3a, 0xe7, 0x68, 0xd3, 0xdf, 0xa6, 0xbd, 0xdc, 0x2e, 0x0f,
+	0xe1, 0xc6, 0x99, 0xef, 0x29, 0xdd, 0xc8, 0x47, 0x27, 0xf4, 0xf8, 0x6e, 0xea, 0x31, 0x62, 0x43,
+	0xde, 0x43, 0x70, 0xe0, 0x62, 0x22, 0xef, 0x13, 0x6d, 0xc6, 0x77, 0x31, 0x86, 0x69, 0x97, 0xb6,
+	0x98, 0x81, 0x96, 0xd0, 0xf2, 0x9c, 0x29, 0x9f, 0xb1, 0x01, 0xb3, 0x9c, 0x6d, 0x71, 0x16, 0x6c,
+	0x1b, 0x05, 0x39, 0xac, 0x49, 0x5c, 0x81, 0x92, 0x10, 0xce, 0xac, 0x30, 0x30, 0xa6, 0x96, 0xa6,
+	0x96, 0xe7, 0xcc, 0x98, 0xc6, 0xcb, 0xb0, 0xc0, 0x59, 0xe0, 0xb5, 0xb9, 0xc5, 0x9e, 0x65, 0x3c,
+	0x70, 0x3c, 0xd7, 0x98, 0x96, 0x6f, 0x77, 0x0f, 0x0b, 0x2e, 0x01, 0x6b, 0x32, 0x2b, 0xf4, 0xb8,
+	0x51, 0x94, 0x53, 0x62, 0x5a, 0xe0, 0x11, 0xc0, 0x8d, 0x99, 0x08, 0x8f, 0x78, 0xc6, 0x04, 0xf6,
+	0x51, 0xdf, 0xbf, 0x4e, 0x5b, 0x2c, 0xf0, 0xa9, 0xc5, 0x8c, 0x59, 0xf9, 0x5b, 0x66, 0x4c, 0x60,
+	0x56, 0x48, 0x8c, 0x92, 0x04, 0xa6, 0x49, 0xb2, 0x0e, 0x73, 0xd7, 0x3d, 0x9b, 0x0d, 0x5e, 0x6e,
+	0x37, 0xfb, 0x42, 0x2f, 0x7b, 0xf2, 0x36, 0x82, 0xc3, 0x26, 0xeb, 0x38, 0x02, 0xff, 0x35, 0x16,
+	0x52, 0x9b, 0x86, 0xb4, 0x9b, 0x63, 0x21, 0xe6, 0x58, 0x81, 0x12, 0x57, 0x93, 0x8d, 0x82, 0x1c,
+	0x8f, 0xe9, 0x1e, 0x69, 0x53, 0xf9, 0x8b, 0x89, 0x54, 0xa8, 0x49, 0xbc, 0x04, 0xe5, 0x48, 0x97,
+	0x57, 0x5d, 0x9b, 0x7d, 0x4e, 0x6a, 0xaf, 0x68, 0xa6, 0x87, 0xf0, 0x22, 0xcc, 0x75, 0x22, 0x3d,
+	0x5f, 0xb5, 0xa5, 0x16, 0x8b, 0x66, 0x32, 0x40, 0xfe, 0x8e, 0xe0, 0x58, 0xca, 0x06, 0x4c, 0xb5,
+	0x33, 0x97, 0x3b, 0xcc, 0x0d, 0x83, 0xc1, 0x0b, 0x3a, 0x03, 0x07, 0xf5, 0x26, 0x76, 0xeb, 0xa9,
+	0xf7, 0x07, 0xb1, 0xc4, 0xf4, 0xa0, 0x5e, 0x62, 0x7a, 0x4c, 0x2c, 0x44, 0xd3, 0xcf, 0x5c, 0xbd,
+	0xa4, 0x96, 0x99, 0x1e, 0xea, 0x51, 0x54, 0x31, 0x5f, 0x51, 0x33, 0x19, 0x45, 0x91, 0x7f, 0x20,
+	0x30, 0x52, 0x0b, 0xbd, 0x46, 0x5d, 0x67, 0x8b, 0x05, 0xe1, 0xa8, 0x7b, 0x86, 0x26, 0xb8, 0x67,
+	0xcb, 0xb0, 0x10, 0xad, 0xea, 0x86, 0xf0, 0x47, 0x11, 0x7f, 0x8c, 0xe2, 0xd2, 0xd4, 0xf2, 0x94,
+	0xd9, 0x3d, 0x2c, 0xf6, 0x4e, 0xcb, 0x0c, 0x8c, 0x19, 0x69, 0xc6, 0xc9, 0x80, 0x90, 0xe0, 0x7a,
+	0xeb, 0xd4, 0xda, 0x8e, 0x3c, 0xa0, 0x64, 0x6a, 0x92, 0xdc, 0x0f, 0x73, 0x4f, 0x3a, 0x4d, 0xb6,
+	0xbe, 0xdd, 0x76, 0x77, 0xf0, 0x21, 0x28, 0x5a, 0xe2, 0x41, 0xae, 0x6e, 0x9f, 0x19, 0x11, 0xe4,
+	0xab, 0x08, 0xee, 0x1f, 0xa4, 0x8f, 0x5b, 0x4e, 0xb8, 0x2d, 0xde, 0x0f, 0x06, 0x29, 0xc6, 0xda,
+	0x66, 0xd6, 0x4e, 0xd0, 0x6e, 0x69, 0x63, 0xd6, 0xf4, 0x78, 0x8a, 0x21, 0x3f, 0x44, 0xb0, 0x3c,
+	0x14, 0xd3, 0x2d, 0x4e, 0x7d, 0x9f, 0x71, 0xfc, 0x24, 0x14, 0x6f, 0x8b, 0x1f, 0xa4, 0xeb, 0x96,
+	0xeb, 0xd5, 0x6a, 0x3a, 0xf4, 0x0f, 0xe5, 0xf2, 0xd4, 0xff, 0x99, 0xd1, 0xeb, 0xb8, 0xaa, 0xd5,
+	0x53, 0x90, 0x7c, 0x8e, 0x64, 0xf8, 0xc4, 0x5a, 0x14, 0xf3, 0xe5, 0xb4, 0x27, 0x66, 0x60, 0xda,
+	0xa7, 0x3c, 0x24, 0x87, 0xe1, 0x9e, 0xac, 0xe3, 0xf8, 0x9e, 0x1b, 0x30, 0xf2, 0x8b, 0xac, 0x9d,
+	0xad, 0x73, 0x46, 0x43, 0x66, 0xb2, 0xdb, 0x6d, 0x16, 0x84, 0x78, 0x07, 0xd2, 0xa7, 0x91, 0xd4,
+	0x6a, 0xb9, 0x7e, 0xb5, 0x9a, 0x84, 0xf3, 0xaa, 0x0e, 0xe7, 0xf2, 0xe1, 0xd3, 0x96, 0x5d, 0xed,
+	0x3c, 0x54, 0xf5, 0x77, 0x1a, 0x55, 0x71, 0x38, 0x64, 0x90, 0xe9, 0xc3, 0x21, 0xbd, 0x54, 0x33,
+	0xcd, 0x1d, 0x1f, 0x81, 0x99, 0xb6, 0x1f, 0x30, 0x1e, 0xca, 0x95, 0x95, 0x4c, 0x45, 0x89, 0xfd,
+	0xeb, 0xd0, 0xa6, 0x63, 0xd3, 0x30, 0xda, 0x9f, 0x92, 0x19, 0xd3, 0xe4, 0x57, 0x59, 0xf4, 0xcf,
+	0xf8, 0xf6, 0xfb, 0x85, 0x3e, 0x8d, 0xb2, 0x90, 0x45, 0x99, 0xb6, 0xa0, 0xa9, 0xac, 0x05, 0xfd,
+	0x34, 0x8b, 0xff, 0x12, 0x6b, 0xb2, 0x04, 0x7f, 0x3f, 0x63, 0x36, 0x60, 0xd6, 0xa2, 0x81, 0x45,
+	0x6d, 0x2d, 0x45, 0x93, 0x22, 0xc4, 0xf9, 0xdc, 0xf3, 0x69, 0x43, 0x72, 0xba, 0xe1, 0x35, 0x1d,
+	0x6b, 0x57, 0x89, 0xeb, 0xfd, 0xa1, 0xc7, 0xf0, 0xa7, 0xf3, 0x0d, 0xbf, 0x98, 0x85, 0x7d, 0x1c,
+	0xca, 0x9b, 0xbb, 0xae, 0xf5, 0xb4, 0x1f, 0xb9, 0xfd, 0x21, 0x28, 0x3a, 0x21, 0x6b, 0x05, 0x06,
+	0x92, 0x2e, 0x1f, 0x11, 0xe4, 0x5f, 0x45, 0x38, 0x92, 0x5a, 0x9b, 0x78, 0x21, 0x6f, 0x65, 0x79,
+	0xf1, 0xeb, 0x08, 0xcc, 0xd8, 0x7c, 0xd7, 0x6c, 0xbb, 0xca, 0x00, 0x14, 0x25, 0x04, 0xfb, 0xbc,
+	0xed, 0x46, 0xf0, 0x4b, 0x66, 0x44, 0xe0, 0x2d, 0x28, 0x05, 0xa1, 0xc8, 0x3f, 0x1a, 0xbb, 0x12,
+	0x78, 0xb9, 0xfe, 0xb1, 0xf1, 0x36, 0x5d, 0x40, 0xdf, 0x54, 0x1c, 0xcd, 0x98, 0x37, 0xbe, 0x2d,
+	0xa2, 0x5d, 0x14, 0x02, 0x03, 0x63, 0x76, 0x69, 0x6a, 0xb9, 0x5c, 0xdf, 0x1c, 0x5f, 0xd0, 0xd3,
+	0x3e, 0xe3, 0x91, 0x7d, 0x29, 0xde, 0x66, 0x22, 0x45, 0x04, 0xd8, 0x96, 0x8a, 0x0f, 0x81, 0xca,
+	0x13, 0x92, 0x01, 0xfc, 0x49, 0x28, 0x3a, 0xee, 0x96, 0x17, 0x18, 0x73, 0x12, 0xcc, 0x13, 0xe3,
+	0x81, 0xb9, 0xea, 0x6e, 0x79, 0x66, 0xc4, 0x10, 0xdf, 0x86, 0x79, 0xce, 0x42, 0xbe, 0xab, 0xb5,
+	0x60, 0x80, 0xd4, 0xeb, 0xc7, 0xc7, 0x93, 0x60, 0xa6, 0x59, 0x9a, 0x59, 0x09, 0x78, 0x0d, 0xca,
+	0x41, 0x62, 0x63, 0x46, 0x59, 0x0a, 0x34, 0x32, 0x8c, 0x52, 0x36, 0x68, 0xa6, 0x27, 0xf7, 0x58,
+	0xf7, 0xbe, 0x7c, 0xeb, 0x9e, 0x1f, 0x7a, 0xde, 0xed, 0x1f, 0xe1, 0xbc, 0x5b, 0xe8, 0x3a, 0xef,
+	0xc8, 0xbb, 0x08, 0x16, 0x7b, 0x82, 0xd3, 0xa6, 0xcf, 0x72, 0xdd, 0x80, 0xc2, 0x74, 0xe0, 0x33,
+	0x4b, 0x9e, 0x54, 0xe5, 0xfa, 0xb5, 0x89, 0x45, 0x2b, 0x29, 0x57, 0xb2, 0xce, 0x0b, 0xa8, 0x63,
+	0xc6, 0x85, 0xef, 0x20, 0xf8, 0xff, 0x94, 0xcc, 0x1b, 0x34, 0xb4, 0xb6, 0xf3, 0x16, 0x2b, 0xfc,
+	0x57, 0xcc, 0x51, 0xe7, 0x72, 0x44, 0x08, 0xad, 0xca, 0x87, 0x9b, 0xbb, 0xbe, 0x00, 0x28, 0x7e,
+	0x49, 0x06, 0xc6, 0x4c, 0xab, 0x7e, 0x84, 0xa0, 0x92, 0x8e, 0xe1, 0x5e, 0xb3, 0xf9, 0x3c, 0xb5,
+	0x76, 0xf2, 0x40, 0xee, 0x87, 0x82, 0x63, 0x4b, 0x84, 0x53, 0x66, 0xc1, 0xb1, 0xf7, 0x18, 0x8c,
+	0xba, 0xe1, 0xce, 0xe4, 0xc3, 0x9d, 0xcd, 0xc2, 0x7d, 0xaf, 0x0b, 0xae, 0x0e, 0x09, 0x39, 0x70,
+	0x17, 0x61, 0xce, 0xed, 0x4a, 0x71, 0x93, 0x81, 0x3e, 0xa9, 0x6d, 0xa1, 0x27, 0xb5, 0x35, 0x60,
+	0xb6, 0x13, 0x5f, 0x80, 0xc4, 0xcf, 0x9a, 0x14, 0x4b, 0x6c, 0x70, 0xaf, 0xed, 0x2b, 0xa5, 0x47,
+	0x84, 0x40, 0xb1, 0xe3, 0xb8, 0x22, 0x59, 0x97, 0x28, 0xc4, 0xf3, 0xde, 0xaf, 0x3c, 0x99, 0x65,
+	0xff, 0xb8, 0x00, 0x1f, 0xe8, 0xb3, 0xec, 0xa1, 0xf6, 0x74, 0x77, 0xac, 0x3d, 0xb6, 0xea, 0xd9,
+	0x81, 0x56, 0x5d, 0x1a, 0x66, 0xd5, 0x73, 0xf9, 0xfa, 0x82, 0xac, 0xbe, 0x7e, 0x50, 0x80, 0xa5,
+	0x3e, 0xfa, 0x1a, 0x9e, 0x4e, 0xdc, 0x35, 0x0a, 0xdb, 0xf2, 0xb8, 0xa5, 0xaf, 0x05, 0x11, 0x21,
+	0xfc, 0xcc, 0xe3, 0xfe, 0x36, 0x75, 0xa5, 0x75, 0x94, 0x4c, 0x45, 0x8d, 0xa9, 0xaa, 0x4b, 0x60,
+	0x68, 0xf5, 0x5c, 0xb4, 0xa2, 0x20, 0xc5, 0x69, 0x8b, 0x85, 0x8c, 0x07, 0x83, 0x42, 0x54, 0x87,
+	0x36, 0xdb, 0x4c, 0x87, 0x28, 0x49, 0x90, 0x57, 0x0b, 0xdd, 0x6c, 0xcc, 0xb6, 0x7b, 0xf7, 0x2b,
+	0xfa, 0x08, 0xcc, 0x50, 0x89, 0x56, 0x99, 0xa6, 0xa2, 0x7a, 0x54, 0x5a, 0xca, 0x57, 0xe9, 0x5c,
+	0x46, 0xa5, 0x6b, 0x05, 0x03, 0x91, 0x77, 0x0b, 0x50, 0x19, 0xa4, 0x90, 0x67, 0xeb, 0xff, 0x6b,
+	0x2a, 0xc1, 0x14, 0x0c, 0x3e, 0xc0, 0xca, 0x0c, 0x90, 0xc9, 0xd9, 0xc9, 0xcc, 0x89, 0x3d, 0xc8,
+	0x24, 0xcd, 0x81, 0x6c, 0xc8, 0x17, 0x11, 0x1c, 0xcd, 0xbe, 0x16, 0x6c, 0x38, 0x41, 0xa8, 0x2f,
+	0x76, 0x78, 0x0b, 0x66, 0xa3, 0xa5, 0x44, 0x69, 0x79, 0xb9, 0xbe, 0x31, 0x6e, 0xb2, 0x96, 0xd9,
+	0x5d, 0xcd, 0x9c, 0x3c, 0x0c, 0x47, 0xfb, 0x9e, 0x50, 0x0a, 0x46, 0x05, 0x4a, 0x3a, 0x41, 0x55,
+	0xbb, 0x1f, 0xd3, 0xe4, 0xcd, 0xe9, 0x6c, 0xba, 0xe0, 0xd9, 0x1b, 0x5e, 0x23, 0xa7, 0x8a, 0x93,
+	0x6f, 0x31, 0x62, 0x37, 0x3c, 0x3b, 0x55, 0xb0, 0xd1, 0xa4, 0x78, 0xcf, 0xf2, 0xdc, 0x90, 0x3a,
+	0x2e, 0xe3, 0x2a, 0xa3, 0x49, 0x06, 0xc4, 0x4e, 0x07, 0x8e, 0x6b, 0xb1, 0x4d, 0x66, 0x79, 0xae,
+	0x1d, 0x48, 0x93, 0x99, 0x32, 0x33, 0x63, 0xf8, 0x29, 0x98, 0x93, 0xf4, 0x4d, 0xa7, 0x15, 0x1d,
+	0xe1, 0xe5, 0xfa, 0x4a, 0x35, 0xaa, 0xac, 0x56, 0xd3, 0x95, 0xd5, 0x44, 0x87, 0xa2, 0xb2, 0x5a,
+	0xed, 0x9c, 0xab, 0x8a, 0x37, 0xcc, 0xe4, 0x65, 0x81, 0x25, 0xa4, 0x4e, 0x73, 0xc3, 0x71, 0xe5,
+	0xa5, 0x41, 0x88, 0x4a, 0x06, 0x84, 0x35, 0x6e, 0x79, 0xcd, 0xa6, 0xf7, 0x82, 0x8e, 0x79, 0x11,
+	0x25, 0xde, 0x6a, 0xbb, 0xa1, 0xd3, 0x94, 0xf2, 0x23, 0x5b, 0x4b, 0x06, 0xe4, 0x5b, 0x4e, 0x33,
+	0x64, 0x5c, 0x05, 0x3b, 0x45, 0xc5, 0xf6, 0x5e, 0x96, 0xa3, 0x71, 0xac, 0x8d, 0x3c, 0x63, 0x5f,
+	0xda, 0x33, 0xba, 0xbd, 0x6d, 0xbe, 0x4f, 0xc5, 0x4b, 0xd6, 0x4e, 0x59, 0xc7, 0xf1, 0xda, 0x22,
+	0x1f, 0x96, 0x69, 0xa3, 0xa6, 0x7b, 0xbc, 0x65, 0x21, 0xdf, 0x5b, 0x0e, 0x64, 0xbd, 0x45, 0xde,
+	0x6a, 0x42, 0x6b, 0x7b, 0x9d, 0x06, 0xcc, 0x38, 0x28, 0x59, 0x27, 0x03, 0xe4, 0xd7, 0x08, 0x4a,
+	0x1b, 0x5e, 0xe3, 0xb2, 0x1b, 0xf2, 0x5d, 0xc1, 0x44, 0xec, 0x1c, 0x73, 0xb5, 0x35, 0x69, 0x52,
+	0x6c, 0x51, 0xe8, 0xb4, 0xd8, 0x66, 0x48, 0x5b, 0xbe, 0xca, 0x9e, 0xf7, 0xb4, 0x45, 0xf1, 0xcb,
+	0x42, 0x6d, 0x4d, 0x1a, 0x84, 0x32, 0xe4, 0x94, 0x4c, 0xf9, 0x2c, 0x16, 0x18, 0x4f, 0xd8, 0x0c,
+	0xb9, 0x8a, 0x37, 0x99, 0xb1, 0xb4, 0x01, 0x16, 0x23, 0x6c, 0x8a, 0x24, 0x2d, 0xb8, 0x37, 0xbe,
+	0xd6, 0xdd, 0x64, 0xbc, 0xe5, 0xb8, 0x34, 0xff, 0x5c, 0x1e, 0xa1, 0xa4, 0x9b, 0x53, 0x55, 0xf0,
+	0x32, 0x2e, 0x29, 0x6e, 0x49, 0xb7, 0x1c, 0xd7, 0xf6, 0x5e, 0xc8, 0x71, 0xad, 0xf1, 0x04, 0xfa,
+	0x99, 0x8b, 0xce, 0x25, 0x99, 0x0f, 0x9b, 0xcc, 0xf7, 0x78, 0x78, 0xa7, 0x24, 0xfe, 0x29, 0x5b,
+	0x07, 0x4e, 0xad, 0x31, 0x8e, 0x3c, 0x4f, 0xc1, 0xbc, 0x88, 0x51, 0x1d, 0xa6, 0x7e, 0x50, 0x61,
+	0x90, 0x0c, 0x2a, 0xbc, 0x25, 0x3c, 0xcc, 0xec, 0x8b, 0x78, 0x03, 0x16, 0x68, 0x10, 0x38, 0x0d,
+	0x97, 0xd9, 0x9a, 0x57, 0x61, 0x64, 0x5e, 0xdd, 0xaf, 0x46, 0x25, 0x1c, 0x39, 0x43, 0x59, 0x98,
+	0x26, 0xc9, 0x17, 0x10, 0x1c, 0xee, 0xcb, 0x24, 0xf6, 0x64, 0x94, 0x3a, 0xb9, 0x44, 0x17, 0xc2,
+	0xda, 0x66, 0x76, 0xbb, 0xa9, 0x93, 0x93, 0x98, 0x16, 0xbf, 0xd9, 0xed, 0xc8, 0xde, 0xd4, 0xc9,
+	0x19, 0xd3, 0xf8, 0x18, 0x40, 0x8b, 0xba, 0x6d, 0xda, 0x94, 0x10, 0xa6, 0x25, 0x84, 0xd4, 0x08,
+	0x59, 0x84, 0x4a, 0x3f, 0x63, 0x55, 0xf5, 0xc2, 0x2f, 0x21, 0x30, 0xe2, 0x9f, 0x2f, 0xfa, 0x3e,
+	0xf7, 0x3a, 0xb4, 0x79, 0xc7, 0x4c, 0x59, 0xfc, 0xd2, 0x62, 0x41, 0x40, 0x1b, 0xfa, 0x2a, 0xaa,
+	0x49, 0xf2, 0x4f, 0x04, 0xfb, 0xf5, 0x69, 0xa3, 0x0c, 0x7b, 0x19, 0x16, 0x52, 0xfb, 0x71, 0x3d,
+	0x41, 0xd2, 0x3d, 0x3c, 0xe4, 0x24, 0xd1, 0xcb, 0x98, 0xca, 0xf6, 0x94, 0x3a, 0x99, 0xae, 0xd0,
+	0xc8, 0xb9, 0x06, 0x9a, 0xd0, 0xa5, 0xe8, 0xf3, 0x60, 0x5c, 0xa3, 0x2e, 0x6d, 0x30, 0x3b, 0x5e,
+	0x76, 0x6c, 0xeb, 0x9f, 0x49, 0x57, 0xe0, 0xc6, 0xae, 0x77, 0xc5, 0xf7, 0x07, 0x67, 0x6b, 0x4b,
+	0x57, 0xf3, 0x5e, 0x2f, 0x64, 0x1d, 0x4e, 0xb6, 0xeb, 0x36, 0x1d, 0x5b, 0x4e, 0x8a, 0xd4, 0x6f,
+	0xc0, 0xac, 0x5a, 0x8a, 0x8e, 0xcd, 0x8a, 0x1c, 0xd3, 0x06, 0x7c, 0x98, 0x6f, 0x3a, 0x1d, 0x16,
+	0xaf, 0xda, 0x98, 0x9e, 0xf8, 0x22, 0xb3, 0x02, 0x84, 0x21, 0x85, 0x94, 0x37, 0x58, 0x78, 0x2d,
+	0x2e, 0xb6, 0x15, 0x65, 0x75, 0xa7, 0x7b, 0x98, 0x7c, 0x2f, 0xdb, 0x96, 0xc8, 0xaa, 0xe5, 0xbf,
+	0xb7, 0x3d, 0x32, 0xcd, 0xf2, 0x6c, 0x67, 0xcb, 0x61, 0x51, 0xa9, 0xa2, 0x64, 0xc6, 0x34, 0xe1,
+	0x50, 0xda, 0x70, 0xdc, 0x1d, 0x51, 0xcf, 0x13, 0xc6, 0x1a, 0x3a, 0x61, 0x53, 0xef, 0x50, 0x44,
+	0xe0, 0x03, 0x30, 0xd5, 0xe6, 0x4d, 0x15, 0x45, 0xc4, 0xa3, 0x68, 0x6f, 0xd9, 0x2c, 0xb0, 0xb8,
+	0xe3, 0xab, 0x18, 0x22, 0xdb, 0x5b, 0xa9, 0x21, 0xe1, 0x42, 0x8e, 0xe5, 0xb9, 0xeb, 0x4d, 0x1a,
+	0x04, 0x3a, 0xa9, 0x8a, 0x07, 0xc8, 0xa3, 0x30, 0x2f, 0x64, 0x26, 0x16, 0x7a, 0x3a, 0xab, 0x82,
+	0xc3, 0x99, 0xa5, 0x69, 0x78, 0xda, 0xd8, 0x28, 0xdc, 0x23, 0x72, 0xd9, 0x8b, 0xbe, 0xaf, 0x98,
+	0x8c, 0x78, 0xb1, 0x9a, 0xea, 0x97, 0x13, 0xf6, 0xed, 0xdd, 0xd4, 0xff, 0x72, 0x1a, 0x70, 0xd7,
+	0xc6, 0x39, 0x16, 0xc3, 0x5f, 0x43, 0x30, 0x2d, 0x44, 0xe3, 0xfb, 0x06, 0x85, 0x76, 0x69, 0xeb,
+	0x95, 0xc9, 0x15, 0xe6, 0x84, 0x34, 0xb2, 0xf8, 0xf2, 0x9f, 0xff, 0xf6, 0x5a, 0xe1, 0x08, 0x3e,
+	0x24, 0x7b, 0xf9, 0x9d, 0x73, 0xe9, 0xbe, 0x7a, 0x80, 0x5f, 0x41, 0x80, 0x55, 0x6e, 0x9f, 0xea,
+	0x76, 0xe2, 0xd3, 0x83, 0x20, 0xf6, 0xe9, 0x8a, 0x56, 0xee, 0x4b, 0xe5, 0x42, 0x55, 0xcb, 0xe3,
+	0x4c, 0x64, 0x3e, 0x72, 0x82, 0x04, 0xb0, 0x22, 0x01, 0x9c, 0xc0, 0xa4, 0x1f, 0x80, 0xda, 0x8b,
+	0x42, 0xa3, 0x2f, 0xd5, 0x58, 0x24, 0xf7, 0x0d, 0x04, 0xc5, 0x5b, 0xb2, 0xa6, 0x31, 0x44, 0x49,
+	0x9b, 0x13, 0x53, 0x92, 0x14, 0x27, 0xd1, 0x92, 0xe3, 0x12, 0xe9, 0x7d, 0xf8, 0xa8, 0x46, 0x1a,
+	0x84, 0x9c, 0xd1, 0x56, 0x06, 0xf0, 0x59, 0x84, 0xdf, 0x42, 0x30, 0x13, 0x35, 0xb3, 0xf0, 0xc9,
+	0x41, 0x28, 0x33, 0xcd, 0xae, 0xca, 0xe4, 0x3a, 0x43, 0xe4, 0x41, 0x89, 0xf1, 0x38, 0xe9, 0xbb,
+	0x9d, 0x6b, 0x99, 0xbe, 0xd1, 0xeb, 0x08, 0xa6, 0xae, 0xb0, 0xa1, 0xf6, 0x36, 0x41, 0x70, 0x3d,
+	0x0a, 0xec, 0xb3, 0xd5, 0xf8, 0x4d, 0x04, 0xf7, 0x5e, 0x61, 0x61, 0xff, 0x14, 0x0b, 0x2f, 0x0f,
+	0xcf, 0x7b, 0x94, 0xd9, 0x9d, 0x1e, 0x61, 0x66, 0x9c, 0x5b, 0xd4, 0x24, 0xb2, 0x07, 0xf1, 0xa9,
+	0x3c, 0x23, 0x14, 0x75, 0xfe, 0x17, 0x14, 0x8e, 0x5f, 0x22, 0x58, 0xb8, 0xc2, 0xc2, 0x74, 0xc2,
+	0x89, 0x1f, 0x1c, 0x24, 0xb1, 0x27, 0x2d, 0xad, 0x8c, 0x19, 0x87, 0xd3, 0x0c, 0x49, 0x5d, 0x62,
+	0x3f, 0x83, 0x57, 0xf2, 0xb0, 0xdb, 0x7c, 0x77, 0x95, 0xb7, 0xdd, 0x55, 0x1e, 0x41, 0xfd, 0x3d,
+	0x82, 0x03, 0xdd, 0x1f, 0x65, 0x60, 0xd2, 0x55, 0x18, 0xe8, 0xf3, 0xcd, 0x46, 0xe5, 0xfa, 0xb8,
+	0x07, 0x48, 0x96, 0x29, 0xb9, 0x28, 0xc1, 0x3f, 0x82, 0x1f, 0xce, 0x03, 0x1f, 0x37, 0x36, 0x6a,
+	0x2f, 0xea, 0xc7, 0x97, 0x6a, 0x2d, 0xc5, 0x02, 0xff, 0x01, 0xc1, 0x21, 0xcd, 0x77, 0x7d, 0x9b,
+	0xf2, 0xf0, 0x12, 0x13, 0xd7, 0xda, 0x60, 0xa4, 0xf5, 0x8c, 0xb9, 0x11, 0x69, 0x79, 0xe4, 0xb2,
+	0x5c, 0xcb, 0xe3, 0xf8, 0xb1, 0x3d, 0xaf, 0xc5, 0x12, 0x6c, 0x6c, 0x05, 0xfb, 0x6d, 0x04, 0xfb,
+	0xaf, 0xb0, 0xf0, 0xe9, 0xf5, 0xab, 0x7b, 0xda, 0x99, 0x31, 0xfd, 0x34, 0x25, 0x8e, 0x5c, 0x92,
+	0x0b, 0xf9, 0x08, 0x7e, 0x74, 0xcf, 0x0b, 0xf1, 0x2c, 0x27, 0xde, 0x97, 0x97, 0x11, 0xec, 0xbb,
+	0x92, 0xca, 0x58, 0x06, 0x47, 0xc3, 0xcc, 0x87, 0x07, 0x95, 0xc5, 0x6a, 0xea, 0xfb, 0x2b, 0xfd,
+	0x53, 0xec, 0xa9, 0xab, 0x12, 0xdb, 0x29, 0x7c, 0x32, 0x0f, 0x5b, 0xd2, 0x98, 0x7c, 0x03, 0xc1,
+	0xe1, 0x34, 0x88, 0xe4, 0x83, 0x8d, 0x0f, 0xee, 0xed, 0x33, 0x08, 0xf5, 0x31, 0xc5, 0x10, 0x74,
+	0xca, 0x17, 0x49, 0xff, 0x38, 0xd2, 0xea, 0x41, 0xb1, 0x86, 0x56, 0x96, 0x11, 0xfe, 0x0d, 0x82,
+	0x99, 0xa8, 0x47, 0x37, 0x58, 0x47, 0x99, 0x0f, 0x0c, 0x26, 0x19, 0x94, 0x95, 0xd5, 0x56, 0xce,
+	0xf6, 0x57, 0x68, 0xfa, 0x7d, 0xbd, 0xb5, 0x55, 0xa9, 0xe5, 0xec, 0x69, 0xf2, 0x33, 0x04, 0x90,
+	0xf4, 0x19, 0x07, 0xc7, 0xc2, 0x9e, 0x5e, 0x64, 0x65, 0xb2, 0x9d, 0x46, 0x52, 0x95, 0xeb, 0x59,
+	0xae, 0x2c, 0xe5, 0x86, 0x72, 0x9f, 0x59, 0x6b, 0x51, 0x4f, 0xf2, 0xbb, 0x08, 0x8a, 0xb2, 0xbd,
+	0x83, 0x4f, 0x0c, 0xc2, 0x9c, 0xee, 0xfe, 0x4c, 0x52, 0xf5, 0x0f, 0x48, 0xa8, 0x4b, 0xf5, 0xbc,
+	0xf3, 0x70, 0x0d, 0xad, 0xe0, 0x0e, 0xcc, 0x44, 0x0d, 0x95, 0xc1, 0xe6, 0x91, 0x69, 0xb8, 0x54,
+	0x96, 0x72, 0xf2, 0xb3, 0xc8, 0x50, 0xd5, 0x51, 0xbc, 0x32, 0xec, 0x28, 0x9e, 0x16, 0xa7, 0x25,
+	0x3e, 0x9e, 0x77, 0x96, 0xde, 0x01, 0xc5, 0x9c, 0x96, 0xe8, 0x4e, 0x92, 0xa5, 0x61, 0xc7, 0xb1,
+	0xd0, 0xce, 0x37, 0x10, 0x1c, 0xe8, 0xbe, 0x9e, 0xe2, 0xa3, 0x7d, 0x8b, 0xdc, 0x2a, 0x35, 0xc8,
+	0x6a, 0x71, 0xd0, 0xd5, 0x96, 0x7c, 0x54, 0xa2, 0x58, 0xc3, 0x17, 0x86, 0x7a, 0xc6, 0x75, 0x1d,
+	0x75, 0x04, 0xa3, 0xd5, 0xe4, 0xa3, 0x89, 0xef, 0x23, 0xd8, 0x9f, 0xbd, 0x98, 0x0d, 0x4e, 0x9d,
+	0xfb, 0xdc, 0x6b, 0x2b, 0xd5, 0xd1, 0x26, 0xc7, 0x88, 0x3f, 0x2c, 0x11, 0x9f, 0xc3, 0xb5, 0x81,
+	0x88, 0x23, 0xa4, 0xd1, 0x27, 0xaf, 0xab, 0x81, 0x63, 0xb3, 0x55, 0x5b, 0xa0, 0xfa, 0x39, 0x82,
+	0x7d, 0x5a, 0x01, 0x37, 0x39, 0x63, 0xf9, 0xfa, 0x9b, 0x9c, 0xc7, 0x0a, 0x59, 0xe4, 0x51, 0x89,
+	0xfa, 0x43, 0xf8, 0xfc, 0x88, 0x7a, 0xd6, 0xfa, 0x5d, 0x0d, 0x05, 0xd2, 0xdf, 0x22, 0x38, 0x78,
+	0x2b, 0x72, 0xd0, 0xf7, 0x09, 0xff, 0xba, 0xc4, 0xff, 0x18, 0x7e, 0x24, 0xe7, 0x5e, 0x30, 0x6c,
+	0x19, 0x67, 0x11, 0xfe, 0x09, 0x82, 0x92, 0xfe, 0x2a, 0x00, 0x9f, 0x1a, 0xe8, 0xc1, 0xd9, 0xef,
+	0x06, 0x26, 0xe9, 0x75, 0x2a, 0x09, 0x26, 0x27, 0x72, 0x8f, 0x7d, 0x25, 0x5f, 0x78, 0xde, 0xeb,
+	0x08, 0x70, 0x5c, 0xa7, 0x8b, 0x4b, 0x73, 0xf8, 0x81, 0x8c, 0xa8, 0x81, 0xe5, 0xe7, 0xca, 0xa9,
+	0xa1, 0xf3, 0xb2, 0x67, 0xfe, 0x4a, 0xee, 0x99, 0xef, 0xc5, 0xf2, 0xbf, 0x1e, 0x7d, 0xad, 0xcd,
+	0xbd, 0x4e, 0x0a, 0xd4, 0xc9, 0xfe, 0xc2, 0xba, 0xea, 0x88, 0x23, 0x44, 0xce, 0x0b, 0x12, 0x4c,
+	0x9d, 0xac, 0x8e, 0x04, 0xa6, 0x46, 0x23, 0x20, 0x42, 0x5d, 0xaf, 0x21, 0x58, 0x30, 0x99, 0xa8,
+	0x01, 0xdc, 0x01, 0x58, 0xca, 0xf5, 0xc9, 0x99, 0xd1, 0x60, 0x71, 0x89, 0x43, 0xa0, 0x7a, 0x15,
+	0x41, 0xf9, 0x0a, 0x8b, 0x6f, 0xf8, 0x39, 0x96, 0x97, 0xfd, 0x04, 0xa4, 0xb2, 0x3c, 0x7c, 0xa2,
+	0xc2, 0x76, 0x46, 0x62, 0x7b, 0x00, 0xe7, 0x1b, 0x96, 0x06, 0xf0, 0x4d, 0x04, 0xf3, 0x37, 0xd2,
+	0x0e, 0x8d, 0xcf, 0x0c, 0x93, 0x94, 0x39, 0xa0, 0x47, 0xc7, 0xf5, 0x90, 0xc4, 0xb5, 0x4a, 0x46,
+	0xc2, 0xb5, 0xa6, 0xbe, 0xa6, 0xf8, 0x36, 0x8a, 0x4a, 0x44, 0x5d, 0x1d, 0xd0, 0xff, 0x54, 0x6f,
+	0x39, 0x8d, 0x54, 0x72, 0x5e, 0xe2, 0xab, 0xe2, 0x33, 0xa3, 0xe0, 0xab, 0xa9, 0xb6, 0x28, 0xfe,
+	0x16, 0x82, 0x83, 0xf2, 0x76, 0x98, 0x66, 0x8c, 0xf3, 0xba, 0xbe, 0x49, 0xc3, 0x7c, 0x04, 0x43,
+	0x7b, 0x3c, 0x8a, 0xd6, 0x64, 0x4f, 0xa0, 0xd6, 0x54, 0x73, 0xfb, 0xcb, 0x05, 0x24, 0xf6, 0xf7,
+	0x9e, 0x1e, 0x7c, 0xcf, 0xd6, 0xbb, 0x14, 0x38, 0xb8, 0xa5, 0x3f, 0x02, 0xc6, 0x35, 0x89, 0xf1,
+	0x3c, 0xa9, 0xed, 0x05, 0x63, 0xad, 0x53, 0x17, 0xfe, 0xf0, 0x15, 0x04, 0xfb, 0x75, 0x36, 0xa5,
+	0xec, 0x6f, 0x75, 0xd8, 0xd6, 0xee, 0x35, 0xfb, 0x52, 0x0e, 0xb1, 0x32, 0x9a, 0x43, 0xbc, 0x85,
+	0x60, 0x56, 0x75, 0xa8, 0x73, 0x72, 0xd4, 0x54, 0x0b, 0xbb, 0xd2, 0x55, 0xe3, 0x54, 0x2d, 0x4c,
+	0xf2, 0x29, 0x29, 0xf6, 0x19, 0x9c, 0xab, 0x16, 0xdf, 0xb3, 0x83, 0xda, 0x8b, 0xaa, 0x7f, 0xf8,
+	0x52, 0xad, 0xe9, 0x35, 0x82, 0xe7, 0x08, 0xce, 0xcd, 0xc4, 0xc4, 0x9c, 0xb3, 0x08, 0x87, 0x30,
+	0x27, 0xcc, 0x57, 0x16, 0x4e, 0x71, 0x56, 0x09, 0x7d, 0x6a, 0xaa, 0x95, 0x4a, 0x4f, 0x21, 0x36,
+	0x49, 0xbd, 0x54, 0x19, 0x0b, 0xdf, 0x9f, 0x2b, 0x56, 0x0a, 0x7a, 0x05, 0xc1, 0xc1, 0xb4, 0x3f,
+	0x46, 0xe2, 0x47, 0xf6, 0xc6, 0x3c, 0x14, 0x23, 0x55, 0x56, 0x62, 0x33, 0x92, 0x70, 0x9e, 0x78,
+	0xf2, 0x77, 0xef, 0x1c, 0x43, 0x7f, 0x7c, 0xe7, 0x18, 0xfa, 0xeb, 0x3b, 0xc7, 0xd0, 0x73, 0x17,
+	0x46, 0xfb, 0x37, 0x93, 0xd5, 0x74, 0x98, 0x1b, 0xa6, 0xd9, 0xff, 0x7b, 0x00, 0x9c, 0xe4, 0xf9,
+	0xc8, 0xb3, 0x35, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3390,6 +3534,8 @@ type ApplicationServiceClient interface {
 	Get(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// Get returns sync windows of the application
 	GetApplicationSyncWindows(ctx context.Context, in *ApplicationSyncWindowsQuery, opts ...grpc.CallOption) (*ApplicationSyncWindowsResponse, error)
+	// GetDryRunReport returns the report of the last dry-run sync operation of the application
+	GetDryRunReport(ctx context.Context, in *ApplicationDryRunReportQuery, opts ...grpc.CallOption) (*v1alpha1.DryRunReport, error)
 	// Get the meta-data (author, date, tags, message) for a specific revision of the application
 	RevisionMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
 	// Get the chart metadata (description, maintainers, home) for a specific revision of the application
@@ -3422,6 +3568,10 @@ type ApplicationServiceClient interface {
 	Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// TerminateOperation terminates the currently running operation
 	TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error)
+	// ApproveOperation approves the sync operation of an application that is waiting for approvals
+	ApproveOperation(ctx context.Context, in *OperationApprovalRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
+	// RejectOperation rejects the sync operation of an application that is waiting for approvals
+	RejectOperation(ctx context.Context, in *OperationApprovalRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// GetResource returns single application resource
 	GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
 	// PatchResource patch single application resource
@@ -3530,6 +3680,15 @@ func (c *applicationServiceClient) GetApplicationSyncWindows(ctx context.Context
 	return out, nil
 }
 
+func (c *applicationServiceClient) GetDryRunReport(ctx context.Context, in *ApplicationDryRunReportQuery, opts ...grpc.CallOption) (*v1alpha1.DryRunReport, error) {
+	out := new(v1alpha1.DryRunReport)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetDryRunReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) RevisionMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
 	out := new(v1alpha1.RevisionMetadata)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionMetadata", in, out, opts...)
@@ -3722,6 +3881,24 @@ func (c *applicationServiceClient) TerminateOperation(ctx context.Context, in *O
 	return out, nil
 }
 
+func (c *applicationServiceClient) ApproveOperation(ctx context.Context, in *OperationApprovalRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ApproveOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) RejectOperation(ctx context.Context, in *OperationApprovalRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RejectOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
 	out := new(ApplicationResourceResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetResource", in, out, opts...)
@@ -3841,6 +4018,8 @@ type ApplicationServiceServer interface {
 	Get(context.Context, *ApplicationQuery) (*v1alpha1.Application, error)
 	// Get returns sync windows of the application
 	GetApplicationSyncWindows(context.Context, *ApplicationSyncWindowsQuery) (*ApplicationSyncWindowsResponse, error)
+	// GetDryRunReport returns the report of the last dry-run sync operation of the application
+	GetDryRunReport(context.Context, *ApplicationDryRunReportQuery) (*v1alpha1.DryRunReport, error)
 	// Get the meta-data (author, date, tags, message) for a specific revision of the application
 	RevisionMetadata(context.Context, *RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error)
 	// Get the chart metadata (description, maintainers, home) for a specific revision of the application
@@ -3873,6 +4052,10 @@ type ApplicationServiceServer interface {
 	Rollback(context.Context, *ApplicationRollbackRequest) (*v1alpha1.Application, error)
 	// TerminateOperation terminates the currently running operation
 	TerminateOperation(context.Context, *OperationTerminateRequest) (*OperationTerminateResponse, error)
+	// ApproveOperation approves the sync operation of an application that is waiting for approvals
+	ApproveOperation(context.Context, *OperationApprovalRequest) (*ApplicationResponse, error)
+	// RejectOperation rejects the sync operation of an application that is waiting for approvals
+	RejectOperation(context.Context, *OperationApprovalRequest) (*ApplicationResponse, error)
 	// GetResource returns single application resource
 	GetResource(context.Context, *ApplicationResourceRequest) (*ApplicationResourceResponse, error)
 	// PatchResource patch single application resource
@@ -3918,6 +4101,9 @@ func (*UnimplementedApplicationServiceServer) Get(ctx context.Context, req *Appl
 func (*UnimplementedApplicationServiceServer) GetApplicationSyncWindows(ctx context.Context, req *ApplicationSyncWindowsQuery) (*ApplicationSyncWindowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationSyncWindows not implemented")
 }
+func (*UnimplementedApplicationServiceServer) GetDryRunReport(ctx context.Context, req *ApplicationDryRunReportQuery) (*v1alpha1.DryRunReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDryRunReport not implemented")
+}
 func (*UnimplementedApplicationServiceServer) RevisionMetadata(ctx context.Context, req *RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevisionMetadata not implemented")
 }
@@ -3966,6 +4152,12 @@ func (*UnimplementedApplicationServiceServer) Rollback(ctx context.Context, req
 func (*UnimplementedApplicationServiceServer) TerminateOperation(ctx context.Context, req *OperationTerminateRequest) (*OperationTerminateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateOperation not implemented")
 }
+func (*UnimplementedApplicationServiceServer) ApproveOperation(ctx context.Context, req *OperationApprovalRequest) (*ApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOperation not implemented")
+}
+func (*UnimplementedApplicationServiceServer) RejectOperation(ctx context.Context, req *OperationApprovalRequest) (*ApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectOperation not implemented")
+}
 func (*UnimplementedApplicationServiceServer) GetResource(ctx context.Context, req *ApplicationResourceRequest) (*ApplicationResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
 }
@@ -4109,6 +4301,24 @@ func _ApplicationService_GetApplicationSyncWindows_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_GetDryRunReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationDryRunReportQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetDryRunReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/GetDryRunReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetDryRunReport(ctx, req.(*ApplicationDryRunReportQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_RevisionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevisionMetadataQuery)
 	if err := dec(in); err != nil {
@@ -4408,6 +4618,42 @@ func _ApplicationService_TerminateOperation_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_ApproveOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ApproveOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ApproveOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ApproveOperation(ctx, req.(*OperationApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RejectOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RejectOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RejectOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RejectOperation(ctx, req.(*OperationApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationResourceRequest)
 	if err := dec(in); err != nil {
@@ -4597,6 +4843,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetApplicationSyncWindows",
 			Handler:    _ApplicationService_GetApplicationSyncWindows_Handler,
 		},
+		{
+			MethodName: "GetDryRunReport",
+			Handler:    _ApplicationService_GetDryRunReport_Handler,
+		},
 		{
 			MethodName: "RevisionMetadata",
 			Handler:    _ApplicationService_RevisionMetadata_Handler,
@@ -4653,6 +4903,14 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TerminateOperation",
 			Handler:    _ApplicationService_TerminateOperation_Handler,
 		},
+		{
+			MethodName: "ApproveOperation",
+			Handler:    _ApplicationService_ApproveOperation_Handler,
+		},
+		{
+			MethodName: "RejectOperation",
+			Handler:    _ApplicationService_RejectOperation_Handler,
+		},
 		{
 			MethodName: "GetResource",
 			Handler:    _ApplicationService_GetResource_Handler,
@@ -6858,6 +7116,56 @@ func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDryRunReportQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDryRunReportQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDryRunReportQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSyncWindowsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7018,7 +7326,7 @@ func (m *OperationTerminateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *ResourcesQuery) Marshal() (dAtA []byte, err error) {
+func (m *OperationApprovalRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7028,12 +7336,12 @@ func (m *ResourcesQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourcesQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *OperationApprovalRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourcesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OperationApprovalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7042,32 +7350,89 @@ func (m *ResourcesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Message != nil {
+		i -= len(*m.Message)
+		copy(dAtA[i:], *m.Message)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x1a
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x12
 	}
-	if m.Kind != nil {
-		i -= len(*m.Kind)
-		copy(dAtA[i:], *m.Kind)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0xa
 	}
-	if m.Group != nil {
-		i -= len(*m.Group)
-		copy(dAtA[i:], *m.Group)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
-		i--
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourcesQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourcesQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Kind != nil {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
 		dAtA[i] = 0x2a
 	}
 	if m.Version != nil {
@@ -8427,6 +8792,30 @@ func (m *ApplicationSyncWindowsQuery) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDryRunReportQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ApplicationSyncWindowsResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8493,6 +8882,34 @@ func (m *OperationTerminateResponse) Size() (n int) {
 	return n
 }
 
+func (m *OperationApprovalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Message != nil {
+		l = len(*m.Message)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ResourcesQuery) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15439,6 +15856,161 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationDryRunReportQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDryRunReportQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDryRunReportQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
@@ -15823,6 +16395,194 @@ func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OperationApprovalRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationApprovalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationApprovalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Message = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
@@ -106,9 +106,11 @@ type Settings struct {
 	AdditionalURLs            []string                           `protobuf:"bytes,27,rep,name=additionalUrls,proto3" json:"additionalUrls,omitempty"`
 	HydratorEnabled           bool                               `protobuf:"varint,28,opt,name=hydratorEnabled,proto3" json:"hydratorEnabled,omitempty"`
 	SyncWithReplaceAllowed    bool                               `protobuf:"varint,29,opt,name=syncWithReplaceAllowed,proto3" json:"syncWithReplaceAllowed,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}                           `json:"-"`
-	XXX_unrecognized          []byte                             `json:"-"`
-	XXX_sizecache             int32                              `json:"-"`
+	// manifestProvenancePublicKey is the PEM-encoded public key verifying the provenance of generated manifests
+	ManifestProvenancePublicKey string   `protobuf:"bytes,30,opt,name=manifestProvenancePublicKey,proto3" json:"manifestProvenancePublicKey,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
@@ -347,6 +349,13 @@ func (m *Settings) GetSyncWithReplaceAllowed() bool {
 	return false
 }
 
+func (m *Settings) GetManifestProvenancePublicKey() string {
+	if m != nil {
+		return m.ManifestProvenancePublicKey
+	}
+	return ""
+}
+
 type GoogleAnalyticsConfig struct {
 	TrackingID           string   `protobuf:"bytes,1,opt,name=trackingID,proto3" json:"trackingID,omitempty"`
 	AnonymizeUsers       bool     `protobuf:"varint,2,opt,name=anonymizeUsers,proto3" json:"anonymizeUsers,omitempty"`
@@ -780,90 +789,92 @@ func init() {
 func init() { proto.RegisterFile("server/settings/settings.proto", fileDescriptor_a480d494da040caa) }
 
 var fileDescriptor_a480d494da040caa = []byte{
-	// 1318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0x1b, 0x37,
-	0x13, 0x86, 0x22, 0xc7, 0x96, 0xc6, 0xb1, 0x65, 0x33, 0x8e, 0xb3, 0xd1, 0x9b, 0xd8, 0x7a, 0x75,
-	0x08, 0xd4, 0xa2, 0x5d, 0xc5, 0x36, 0xfa, 0x81, 0xa0, 0x41, 0x6b, 0x49, 0x41, 0xa2, 0xc6, 0x49,
-	0x5c, 0x26, 0x4e, 0x81, 0x5e, 0x02, 0x7a, 0x77, 0x2a, 0x6d, 0xbd, 0x22, 0x17, 0x24, 0x57, 0x89,
-	0x72, 0xec, 0x0f, 0xe8, 0xa5, 0xfd, 0x21, 0x3d, 0xf7, 0x5e, 0xb4, 0xc7, 0x02, 0xbd, 0x1b, 0x85,
-	0xd0, 0x1f, 0x52, 0x2c, 0xf7, 0xc3, 0xeb, 0x95, 0x9c, 0x16, 0xc8, 0x8d, 0x7c, 0x9e, 0xf9, 0xe2,
-	0x70, 0x86, 0x1c, 0xd8, 0x52, 0x28, 0xc7, 0x28, 0xdb, 0x0a, 0xb5, 0xf6, 0xf8, 0x40, 0x65, 0x0b,
-	0x3b, 0x90, 0x42, 0x0b, 0xb2, 0xe4, 0xf8, 0xa1, 0xd2, 0x28, 0xeb, 0x1b, 0x03, 0x31, 0x10, 0x06,
-	0x6b, 0x47, 0xab, 0x98, 0xae, 0xdf, 0x1c, 0x08, 0x31, 0xf0, 0xb1, 0xcd, 0x02, 0xaf, 0xcd, 0x38,
-	0x17, 0x9a, 0x69, 0x4f, 0xf0, 0x44, 0xb9, 0x7e, 0x30, 0xf0, 0xf4, 0x30, 0x3c, 0xb6, 0x1d, 0x31,
-	0x6a, 0x33, 0x69, 0xd4, 0xbf, 0x33, 0x8b, 0x0f, 0x1d, 0xb7, 0x3d, 0xde, 0x6b, 0x07, 0x27, 0x83,
-	0x48, 0x53, 0xb5, 0x59, 0x10, 0xf8, 0x9e, 0x63, 0x74, 0xdb, 0xe3, 0x1d, 0xe6, 0x07, 0x43, 0xb6,
-	0xd3, 0x1e, 0x20, 0x47, 0xc9, 0x34, 0xba, 0x89, 0xb5, 0x2f, 0xfe, 0xc5, 0x5a, 0xf1, 0x24, 0xc2,
-	0x73, 0x9d, 0xb6, 0xe3, 0x33, 0x6f, 0x94, 0xc4, 0xd3, 0xac, 0xc1, 0xca, 0xb3, 0x84, 0xfd, 0x2a,
-	0x44, 0x39, 0x69, 0xfe, 0xbc, 0x02, 0x95, 0x14, 0x21, 0x37, 0xa0, 0x1c, 0x4a, 0xdf, 0x2a, 0x35,
-	0x4a, 0xad, 0x6a, 0x67, 0x69, 0x7a, 0xba, 0x5d, 0x3e, 0xa2, 0x07, 0x34, 0xc2, 0xc8, 0x1d, 0xa8,
-	0xba, 0xf8, 0xba, 0x2b, 0xf8, 0xb7, 0xde, 0xc0, 0xba, 0xd4, 0x28, 0xb5, 0x96, 0x77, 0x89, 0x9d,
-	0x64, 0xc6, 0xee, 0xa5, 0x0c, 0x3d, 0x13, 0x22, 0x5d, 0x80, 0xc8, 0x7f, 0xa2, 0x52, 0x36, 0x2a,
-	0x57, 0x33, 0x95, 0xa7, 0xfd, 0x5e, 0x37, 0xa6, 0x3a, 0xab, 0xd3, 0xd3, 0x6d, 0x38, 0xdb, 0xd3,
-	0x9c, 0x1a, 0x69, 0xc0, 0x32, 0x0b, 0x82, 0x03, 0x76, 0x8c, 0xfe, 0x23, 0x9c, 0x58, 0x0b, 0x51,
-	0x64, 0x34, 0x0f, 0x91, 0x17, 0xb0, 0x2e, 0x51, 0x89, 0x50, 0x3a, 0xf8, 0x74, 0x8c, 0x52, 0x7a,
-	0x2e, 0x2a, 0xeb, 0x72, 0xa3, 0xdc, 0x5a, 0xde, 0x6d, 0x65, 0xde, 0xd2, 0x13, 0xda, 0xb4, 0x28,
-	0x7a, 0x9f, 0x6b, 0x39, 0xa1, 0xb3, 0x26, 0x88, 0x0d, 0x44, 0x69, 0xa6, 0x43, 0xd5, 0x61, 0xee,
-	0x00, 0xef, 0x73, 0x76, 0xec, 0xa3, 0x6b, 0x2d, 0x36, 0x4a, 0xad, 0x0a, 0x9d, 0xc3, 0x90, 0x87,
-	0x50, 0x8b, 0x2b, 0x61, 0x9f, 0x33, 0x7f, 0xa2, 0x3d, 0x47, 0x59, 0x4b, 0xe6, 0xcc, 0x5b, 0x59,
-	0x14, 0x0f, 0xce, 0xf3, 0xc9, 0x71, 0x8b, 0x6a, 0xe4, 0x0d, 0xac, 0x9d, 0x84, 0x4a, 0x8b, 0x91,
-	0xf7, 0x06, 0x9f, 0x06, 0xa6, 0x9a, 0xac, 0x8a, 0x31, 0xf5, 0xc4, 0x3e, 0x2b, 0x00, 0x3b, 0x2d,
-	0x00, 0xb3, 0x78, 0xe9, 0xb8, 0xf6, 0x78, 0xcf, 0x0e, 0x4e, 0x06, 0x76, 0x54, 0x4e, 0x76, 0xae,
-	0x9c, 0xec, 0xb4, 0x9c, 0xec, 0x47, 0x05, 0xab, 0x74, 0xc6, 0x0f, 0xf9, 0x3f, 0x2c, 0x0c, 0xd1,
-	0x0f, 0xac, 0xaa, 0xf1, 0xb7, 0x92, 0x85, 0xfe, 0x10, 0xfd, 0x80, 0x1a, 0x8a, 0xbc, 0x07, 0x4b,
-	0x81, 0x1f, 0x0e, 0x3c, 0xae, 0x2c, 0x30, 0x69, 0xae, 0x65, 0x52, 0x87, 0x06, 0xa7, 0x29, 0x1f,
-	0xe5, 0x30, 0x54, 0x28, 0x0f, 0x44, 0xb4, 0xeb, 0x79, 0x2a, 0xce, 0xe1, 0x72, 0x9c, 0xc3, 0x59,
-	0x86, 0xfc, 0x50, 0x82, 0xeb, 0x8e, 0xc9, 0xca, 0x63, 0xc6, 0xd9, 0x00, 0x47, 0xc8, 0xf5, 0x61,
-	0xe2, 0xeb, 0x8a, 0xf1, 0xf5, 0xfc, 0xdd, 0x32, 0xd0, 0x9d, 0x6b, 0x9c, 0x5e, 0xe4, 0x94, 0x7c,
-	0x00, 0xeb, 0x59, 0x8a, 0x5e, 0xa0, 0x54, 0xe6, 0x2e, 0x56, 0x1a, 0xe5, 0x56, 0x95, 0xce, 0x12,
-	0xa4, 0x0e, 0x95, 0xd0, 0xeb, 0x2a, 0x75, 0x44, 0x0f, 0xac, 0x55, 0x53, 0xa9, 0xd9, 0x9e, 0xb4,
-	0xa0, 0x16, 0x7a, 0x1d, 0xc6, 0x39, 0xca, 0xae, 0xe0, 0x1a, 0xb9, 0xb6, 0x6a, 0x46, 0xa4, 0x08,
-	0x47, 0x25, 0x9f, 0x42, 0x91, 0xa1, 0xb5, 0xb8, 0xe4, 0x73, 0x50, 0x64, 0x2b, 0x60, 0x4a, 0xbd,
-	0x12, 0xd2, 0x3d, 0x64, 0x5a, 0xa3, 0xe4, 0xd6, 0x7a, 0x6c, 0xab, 0x00, 0x93, 0xdb, 0xb0, 0xaa,
-	0x25, 0x73, 0x4e, 0x3c, 0x3e, 0x78, 0x8c, 0x7a, 0x28, 0x5c, 0x8b, 0x18, 0xc1, 0x02, 0x1a, 0x9d,
-	0x33, 0x75, 0x70, 0x88, 0x72, 0xc4, 0x78, 0x14, 0xdf, 0x55, 0x73, 0x4f, 0xb3, 0x04, 0x79, 0x1f,
-	0xd6, 0x32, 0x50, 0x28, 0x2f, 0x4a, 0xb1, 0xb5, 0x61, 0xec, 0xce, 0xe0, 0x85, 0x36, 0xa2, 0x42,
-	0xe8, 0x23, 0xe9, 0x5b, 0xd7, 0x8c, 0xf4, 0x1c, 0x26, 0x3a, 0x3d, 0xbe, 0x46, 0x27, 0xed, 0xb7,
-	0x4d, 0x13, 0x43, 0x1e, 0x22, 0x77, 0xe0, 0xaa, 0x23, 0xb8, 0x96, 0xc2, 0xf7, 0x51, 0x3e, 0x61,
-	0x23, 0x54, 0x01, 0x73, 0xd0, 0xba, 0x6e, 0x4c, 0xce, 0xa3, 0xc8, 0x67, 0x70, 0x83, 0x05, 0x81,
-	0xea, 0xf3, 0x7d, 0x3e, 0xc9, 0xd0, 0xd4, 0x83, 0x65, 0x3c, 0x5c, 0x2c, 0x40, 0x76, 0x61, 0xc3,
-	0x1b, 0x05, 0x28, 0x95, 0xe0, 0xa6, 0x9a, 0x52, 0xc5, 0x1b, 0x46, 0x71, 0x2e, 0x17, 0xe5, 0xdd,
-	0xe3, 0x4a, 0x33, 0xdf, 0x37, 0x70, 0xbf, 0x67, 0xd5, 0xe3, 0xbc, 0x9f, 0x47, 0xc9, 0x5d, 0x58,
-	0x65, 0xae, 0x6b, 0x32, 0xc5, 0xfc, 0x23, 0xe9, 0x2b, 0xeb, 0x7f, 0x51, 0x71, 0x75, 0xc8, 0xf4,
-	0x74, 0x7b, 0x75, 0xff, 0x8c, 0xa1, 0x07, 0x8a, 0x16, 0x24, 0xa3, 0x2a, 0x18, 0x4e, 0x5c, 0xc9,
-	0xb4, 0x90, 0x69, 0x48, 0x37, 0x4d, 0x48, 0x45, 0x98, 0x7c, 0x0c, 0x9b, 0x6a, 0xc2, 0x9d, 0xaf,
-	0x3d, 0x3d, 0xa4, 0x18, 0xf8, 0xcc, 0xc1, 0x7d, 0xdf, 0x17, 0xaf, 0xd0, 0xb5, 0x6e, 0x19, 0x85,
-	0x0b, 0xd8, 0xfa, 0x4f, 0x25, 0xd8, 0x9c, 0xff, 0x60, 0x92, 0x35, 0x28, 0x9f, 0xe0, 0x24, 0xfe,
-	0x29, 0x68, 0xb4, 0x24, 0x2e, 0x5c, 0x1e, 0x33, 0x3f, 0xc4, 0xe4, 0x73, 0x78, 0xc7, 0xa7, 0xaa,
-	0xe8, 0x96, 0xc6, 0xc6, 0xef, 0x5e, 0xfa, 0xb4, 0xd4, 0x7c, 0x09, 0xd7, 0xe6, 0xbe, 0xa4, 0x64,
-	0x0b, 0x20, 0xad, 0xeb, 0x7e, 0x2f, 0x89, 0x2d, 0x87, 0x44, 0xb7, 0xc2, 0xb8, 0xe0, 0x93, 0xa8,
-	0x69, 0x8f, 0x14, 0x4a, 0x65, 0x62, 0xad, 0xd0, 0x02, 0xda, 0xec, 0xc1, 0xf5, 0xf4, 0xc3, 0x48,
-	0x1e, 0x02, 0x8a, 0x2a, 0x10, 0x5c, 0x61, 0xfe, 0xf1, 0x2b, 0xbd, 0xfd, 0xf1, 0x6b, 0xfe, 0x52,
-	0x82, 0x85, 0xe8, 0xd9, 0x24, 0x16, 0x2c, 0x39, 0x43, 0x66, 0xea, 0x3e, 0x8e, 0x29, 0xdd, 0x46,
-	0x0f, 0x46, 0xb4, 0x7c, 0x8e, 0xaf, 0xb5, 0x09, 0xa5, 0x4a, 0xb3, 0x3d, 0xb9, 0x07, 0x70, 0xec,
-	0x71, 0x26, 0x27, 0xa6, 0x2c, 0xca, 0xc6, 0xd9, 0xad, 0x73, 0xef, 0xb1, 0xdd, 0xc9, 0xf8, 0xf8,
-	0x17, 0xcb, 0x29, 0xd4, 0xef, 0x41, 0xad, 0x40, 0xcf, 0xb9, 0xb3, 0x8d, 0xfc, 0x9d, 0x55, 0xf3,
-	0x39, 0xbe, 0x09, 0x8b, 0xf1, 0x79, 0x08, 0x81, 0x05, 0xce, 0x46, 0x98, 0xa8, 0x99, 0x75, 0xf3,
-	0x73, 0xa8, 0x66, 0x5f, 0x3e, 0xd9, 0x05, 0x70, 0x04, 0xe7, 0xe8, 0x68, 0x21, 0xd3, 0xac, 0x9c,
-	0x8d, 0x06, 0xdd, 0x94, 0xa2, 0x39, 0xa9, 0xe6, 0x1e, 0x54, 0x33, 0x62, 0x9e, 0x87, 0x08, 0xd3,
-	0x93, 0x20, 0x0d, 0xcc, 0xac, 0x9b, 0xbf, 0x96, 0x21, 0x37, 0x26, 0xcc, 0x55, 0xdb, 0x84, 0x45,
-	0x4f, 0xa9, 0x10, 0x65, 0xa2, 0x98, 0xec, 0x48, 0x0b, 0x2a, 0x8e, 0xef, 0x21, 0xd7, 0xfd, 0x9e,
-	0x99, 0x44, 0xaa, 0x9d, 0x2b, 0xd3, 0xd3, 0xed, 0x4a, 0x37, 0xc1, 0x68, 0xc6, 0x92, 0x1d, 0x58,
-	0x76, 0x7c, 0x2f, 0x25, 0xe2, 0x81, 0xa3, 0x53, 0x9b, 0x9e, 0x6e, 0x2f, 0x77, 0x0f, 0xfa, 0x99,
-	0x7c, 0x5e, 0x26, 0x72, 0xaa, 0x1c, 0x11, 0x24, 0x63, 0x47, 0x95, 0x26, 0x3b, 0xf2, 0x12, 0x56,
-	0x3c, 0xf7, 0xb9, 0x38, 0x41, 0xde, 0x35, 0x23, 0x98, 0xb5, 0x68, 0x72, 0x73, 0x7b, 0xce, 0x0c,
-	0x64, 0xf7, 0xf3, 0x82, 0xe6, 0xba, 0x3a, 0xeb, 0xd3, 0xd3, 0xed, 0x95, 0x7e, 0x2f, 0x87, 0xd3,
-	0xf3, 0xf6, 0xc8, 0x5d, 0xb0, 0xd0, 0xb4, 0xf8, 0xe1, 0xa3, 0xee, 0xfd, 0xfd, 0x50, 0x0f, 0x91,
-	0xeb, 0xa4, 0x93, 0xcc, 0xec, 0x51, 0xa1, 0x17, 0xf2, 0xf5, 0x09, 0x90, 0x59, 0x9f, 0x73, 0x4a,
-	0xe4, 0xf1, 0xf9, 0xb6, 0xfe, 0xe4, 0xad, 0x6d, 0x1d, 0xcf, 0x9f, 0x76, 0x36, 0x40, 0x47, 0x83,
-	0x9c, 0x6d, 0xec, 0xe7, 0x6a, 0x6b, 0xf7, 0xb7, 0x12, 0xd4, 0xd2, 0xfe, 0x7a, 0x86, 0x72, 0xec,
-	0x39, 0x48, 0xbe, 0x84, 0xf2, 0x03, 0xd4, 0x64, 0x73, 0x66, 0x62, 0x33, 0x53, 0x6a, 0x7d, 0x7d,
-	0x06, 0x6f, 0x5a, 0xdf, 0xff, 0xf9, 0xf7, 0x8f, 0x97, 0x08, 0x59, 0x33, 0x93, 0xf7, 0x78, 0x27,
-	0x9b, 0x7a, 0xc9, 0x10, 0xe0, 0x01, 0x66, 0x5f, 0xf8, 0x45, 0x26, 0x1b, 0x33, 0x78, 0xa1, 0xd7,
-	0x9b, 0x0d, 0xe3, 0xa1, 0x4e, 0xac, 0xa2, 0x87, 0x76, 0xd2, 0xe2, 0x9d, 0xee, 0xef, 0xd3, 0xad,
-	0xd2, 0x1f, 0xd3, 0xad, 0xd2, 0x5f, 0xd3, 0xad, 0xd2, 0x37, 0x1f, 0xfd, 0xb7, 0x59, 0x3f, 0x2e,
-	0xb5, 0xcc, 0xd8, 0xf1, 0xa2, 0x99, 0xcc, 0xf7, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x1a,
-	0xe6, 0x75, 0x88, 0x0c, 0x00, 0x00,
+	// 1347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x86, 0x2c, 0xc7, 0x96, 0xc6, 0xb1, 0x65, 0x6f, 0x1c, 0x87, 0x51, 0x12, 0x5b, 0xaf, 0x0e,
+	0x81, 0xde, 0x17, 0x6f, 0xa9, 0xd8, 0x46, 0x3f, 0x10, 0x34, 0x68, 0x2c, 0x29, 0x48, 0xd4, 0x38,
+	0x89, 0xca, 0xc4, 0x29, 0xd0, 0x4b, 0xb0, 0x26, 0x27, 0x14, 0x6b, 0x6a, 0x97, 0xd8, 0x5d, 0x2a,
+	0x51, 0x8e, 0xfd, 0x01, 0xbd, 0xb4, 0xbf, 0xa6, 0xf7, 0xa2, 0x3d, 0x16, 0xe8, 0xdd, 0x28, 0x84,
+	0xfe, 0x82, 0xfe, 0x82, 0x82, 0xcb, 0x0f, 0xd3, 0x94, 0x9c, 0x16, 0x68, 0x6f, 0xbb, 0xcf, 0x33,
+	0x5f, 0x3b, 0x9c, 0x19, 0x8d, 0x60, 0x5b, 0xa2, 0x18, 0xa3, 0x68, 0x4b, 0x54, 0xca, 0x63, 0xae,
+	0xcc, 0x0e, 0x66, 0x20, 0xb8, 0xe2, 0x64, 0xd9, 0xf6, 0x43, 0xa9, 0x50, 0xd4, 0x37, 0x5d, 0xee,
+	0x72, 0x8d, 0xb5, 0xa3, 0x53, 0x4c, 0xd7, 0x6f, 0xba, 0x9c, 0xbb, 0x3e, 0xb6, 0x69, 0xe0, 0xb5,
+	0x29, 0x63, 0x5c, 0x51, 0xe5, 0x71, 0x96, 0x28, 0xd7, 0x0f, 0x5d, 0x4f, 0x0d, 0xc3, 0x63, 0xd3,
+	0xe6, 0xa3, 0x36, 0x15, 0x5a, 0xfd, 0x6b, 0x7d, 0xf8, 0xc0, 0x76, 0xda, 0xe3, 0xfd, 0x76, 0x70,
+	0xe2, 0x46, 0x9a, 0xb2, 0x4d, 0x83, 0xc0, 0xf7, 0x6c, 0xad, 0xdb, 0x1e, 0xef, 0x52, 0x3f, 0x18,
+	0xd2, 0xdd, 0xb6, 0x8b, 0x0c, 0x05, 0x55, 0xe8, 0x24, 0xd6, 0xee, 0xff, 0x85, 0xb5, 0xe2, 0x4b,
+	0xb8, 0xe7, 0xd8, 0x6d, 0xdb, 0xa7, 0xde, 0x28, 0x89, 0xa7, 0x59, 0x83, 0xd5, 0xe7, 0x09, 0xfb,
+	0x45, 0x88, 0x62, 0xd2, 0xfc, 0x63, 0x15, 0x2a, 0x29, 0x42, 0xae, 0x43, 0x39, 0x14, 0xbe, 0x51,
+	0x6a, 0x94, 0x5a, 0xd5, 0xce, 0xf2, 0xf4, 0x74, 0xa7, 0x7c, 0x64, 0x1d, 0x5a, 0x11, 0x46, 0xee,
+	0x40, 0xd5, 0xc1, 0xb7, 0x5d, 0xce, 0x5e, 0x7b, 0xae, 0xb1, 0xd0, 0x28, 0xb5, 0x56, 0xf6, 0x88,
+	0x99, 0x64, 0xc6, 0xec, 0xa5, 0x8c, 0x75, 0x26, 0x44, 0xba, 0x00, 0x91, 0xff, 0x44, 0xa5, 0xac,
+	0x55, 0xae, 0x64, 0x2a, 0xcf, 0xfa, 0xbd, 0x6e, 0x4c, 0x75, 0xd6, 0xa6, 0xa7, 0x3b, 0x70, 0x76,
+	0xb7, 0x72, 0x6a, 0xa4, 0x01, 0x2b, 0x34, 0x08, 0x0e, 0xe9, 0x31, 0xfa, 0x8f, 0x71, 0x62, 0x2c,
+	0x46, 0x91, 0x59, 0x79, 0x88, 0xbc, 0x84, 0x0d, 0x81, 0x92, 0x87, 0xc2, 0xc6, 0x67, 0x63, 0x14,
+	0xc2, 0x73, 0x50, 0x1a, 0x97, 0x1a, 0xe5, 0xd6, 0xca, 0x5e, 0x2b, 0xf3, 0x96, 0xbe, 0xd0, 0xb4,
+	0x8a, 0xa2, 0x0f, 0x98, 0x12, 0x13, 0x6b, 0xd6, 0x04, 0x31, 0x81, 0x48, 0x45, 0x55, 0x28, 0x3b,
+	0xd4, 0x71, 0xf1, 0x01, 0xa3, 0xc7, 0x3e, 0x3a, 0xc6, 0x52, 0xa3, 0xd4, 0xaa, 0x58, 0x73, 0x18,
+	0xf2, 0x08, 0x6a, 0x71, 0x25, 0x1c, 0x30, 0xea, 0x4f, 0x94, 0x67, 0x4b, 0x63, 0x59, 0xbf, 0x79,
+	0x3b, 0x8b, 0xe2, 0xe1, 0x79, 0x3e, 0x79, 0x6e, 0x51, 0x8d, 0xbc, 0x83, 0xf5, 0x93, 0x50, 0x2a,
+	0x3e, 0xf2, 0xde, 0xe1, 0xb3, 0x40, 0x57, 0x93, 0x51, 0xd1, 0xa6, 0x9e, 0x9a, 0x67, 0x05, 0x60,
+	0xa6, 0x05, 0xa0, 0x0f, 0xaf, 0x6c, 0xc7, 0x1c, 0xef, 0x9b, 0xc1, 0x89, 0x6b, 0x46, 0xe5, 0x64,
+	0xe6, 0xca, 0xc9, 0x4c, 0xcb, 0xc9, 0x7c, 0x5c, 0xb0, 0x6a, 0xcd, 0xf8, 0x21, 0xff, 0x81, 0xc5,
+	0x21, 0xfa, 0x81, 0x51, 0xd5, 0xfe, 0x56, 0xb3, 0xd0, 0x1f, 0xa1, 0x1f, 0x58, 0x9a, 0x22, 0xff,
+	0x85, 0xe5, 0xc0, 0x0f, 0x5d, 0x8f, 0x49, 0x03, 0x74, 0x9a, 0x6b, 0x99, 0xd4, 0x40, 0xe3, 0x56,
+	0xca, 0x47, 0x39, 0x0c, 0x25, 0x8a, 0x43, 0x1e, 0xdd, 0x7a, 0x9e, 0x8c, 0x73, 0xb8, 0x12, 0xe7,
+	0x70, 0x96, 0x21, 0xdf, 0x96, 0xe0, 0x9a, 0xad, 0xb3, 0xf2, 0x84, 0x32, 0xea, 0xe2, 0x08, 0x99,
+	0x1a, 0x24, 0xbe, 0x2e, 0x6b, 0x5f, 0x2f, 0xfe, 0x59, 0x06, 0xba, 0x73, 0x8d, 0x5b, 0x17, 0x39,
+	0x25, 0xff, 0x87, 0x8d, 0x2c, 0x45, 0x2f, 0x51, 0x48, 0xfd, 0x2d, 0x56, 0x1b, 0xe5, 0x56, 0xd5,
+	0x9a, 0x25, 0x48, 0x1d, 0x2a, 0xa1, 0xd7, 0x95, 0xf2, 0xc8, 0x3a, 0x34, 0xd6, 0x74, 0xa5, 0x66,
+	0x77, 0xd2, 0x82, 0x5a, 0xe8, 0x75, 0x28, 0x63, 0x28, 0xba, 0x9c, 0x29, 0x64, 0xca, 0xa8, 0x69,
+	0x91, 0x22, 0x1c, 0x95, 0x7c, 0x0a, 0x45, 0x86, 0xd6, 0xe3, 0x92, 0xcf, 0x41, 0x91, 0xad, 0x80,
+	0x4a, 0xf9, 0x86, 0x0b, 0x67, 0x40, 0x95, 0x42, 0xc1, 0x8c, 0x8d, 0xd8, 0x56, 0x01, 0x26, 0xb7,
+	0x61, 0x4d, 0x09, 0x6a, 0x9f, 0x78, 0xcc, 0x7d, 0x82, 0x6a, 0xc8, 0x1d, 0x83, 0x68, 0xc1, 0x02,
+	0x1a, 0xbd, 0x33, 0x75, 0x30, 0x40, 0x31, 0xa2, 0x2c, 0x8a, 0xef, 0x8a, 0xfe, 0x4e, 0xb3, 0x04,
+	0xf9, 0x1f, 0xac, 0x67, 0x20, 0x97, 0x5e, 0x94, 0x62, 0x63, 0x53, 0xdb, 0x9d, 0xc1, 0x0b, 0x6d,
+	0x64, 0x71, 0xae, 0x8e, 0x84, 0x6f, 0x5c, 0xd5, 0xd2, 0x73, 0x98, 0xe8, 0xf5, 0xf8, 0x16, 0xed,
+	0xb4, 0xdf, 0xb6, 0x74, 0x0c, 0x79, 0x88, 0xdc, 0x81, 0x2b, 0x36, 0x67, 0x4a, 0x70, 0xdf, 0x47,
+	0xf1, 0x94, 0x8e, 0x50, 0x06, 0xd4, 0x46, 0xe3, 0x9a, 0x36, 0x39, 0x8f, 0x22, 0x9f, 0xc2, 0x75,
+	0x1a, 0x04, 0xb2, 0xcf, 0x0e, 0xd8, 0x24, 0x43, 0x53, 0x0f, 0x86, 0xf6, 0x70, 0xb1, 0x00, 0xd9,
+	0x83, 0x4d, 0x6f, 0x14, 0xa0, 0x90, 0x9c, 0xe9, 0x6a, 0x4a, 0x15, 0xaf, 0x6b, 0xc5, 0xb9, 0x5c,
+	0x94, 0x77, 0x8f, 0x49, 0x45, 0x7d, 0x5f, 0xc3, 0xfd, 0x9e, 0x51, 0x8f, 0xf3, 0x7e, 0x1e, 0x25,
+	0x77, 0x61, 0x8d, 0x3a, 0x8e, 0xce, 0x14, 0xf5, 0x8f, 0x84, 0x2f, 0x8d, 0x1b, 0x51, 0x71, 0x75,
+	0xc8, 0xf4, 0x74, 0x67, 0xed, 0xe0, 0x8c, 0xb1, 0x0e, 0xa5, 0x55, 0x90, 0x8c, 0xaa, 0x60, 0x38,
+	0x71, 0x04, 0x55, 0x5c, 0xa4, 0x21, 0xdd, 0xd4, 0x21, 0x15, 0x61, 0xf2, 0x11, 0x6c, 0xc9, 0x09,
+	0xb3, 0xbf, 0xf4, 0xd4, 0xd0, 0xc2, 0xc0, 0xa7, 0x36, 0x1e, 0xf8, 0x3e, 0x7f, 0x83, 0x8e, 0x71,
+	0x4b, 0x2b, 0x5c, 0xc0, 0x92, 0xfb, 0x70, 0x63, 0x44, 0x99, 0xf7, 0x1a, 0xa5, 0x1a, 0x08, 0x3e,
+	0x46, 0x46, 0x99, 0x8d, 0x83, 0xf0, 0xd8, 0xf7, 0xec, 0x68, 0x18, 0x6f, 0xeb, 0x27, 0xbd, 0x4f,
+	0xa4, 0xfe, 0x7d, 0x09, 0xb6, 0xe6, 0x8f, 0x5c, 0xb2, 0x0e, 0xe5, 0x13, 0x9c, 0xc4, 0xbf, 0x35,
+	0x56, 0x74, 0x24, 0x0e, 0x5c, 0x1a, 0x53, 0x3f, 0x44, 0x63, 0xe1, 0xdf, 0x18, 0x76, 0x45, 0xb7,
+	0x56, 0x6c, 0xfc, 0xee, 0xc2, 0x27, 0xa5, 0xe6, 0x2b, 0xb8, 0x3a, 0x77, 0x16, 0x93, 0x6d, 0x80,
+	0xb4, 0x33, 0xfa, 0xbd, 0x24, 0xb6, 0x1c, 0x12, 0x7d, 0x57, 0xca, 0x38, 0x9b, 0x44, 0x6d, 0x7f,
+	0x24, 0x51, 0x48, 0x1d, 0x6b, 0xc5, 0x2a, 0xa0, 0xcd, 0x1e, 0x5c, 0x4b, 0x7f, 0x72, 0x92, 0x51,
+	0x62, 0xa1, 0x0c, 0x38, 0x93, 0x98, 0x1f, 0x9f, 0xa5, 0xf7, 0x8f, 0xcf, 0xe6, 0x0f, 0x25, 0x58,
+	0x8c, 0x06, 0x2f, 0x31, 0x60, 0xd9, 0x1e, 0x52, 0xdd, 0x39, 0x71, 0x4c, 0xe9, 0x35, 0x1a, 0x39,
+	0xd1, 0xf1, 0x05, 0xbe, 0x55, 0x3a, 0x94, 0xaa, 0x95, 0xdd, 0xc9, 0x3d, 0x80, 0x63, 0x8f, 0x51,
+	0x31, 0xd1, 0x85, 0x55, 0xd6, 0xce, 0x6e, 0x9d, 0x9b, 0xe8, 0x66, 0x27, 0xe3, 0xe3, 0xdf, 0xc1,
+	0x9c, 0x42, 0xfd, 0x1e, 0xd4, 0x0a, 0xf4, 0x9c, 0x6f, 0xb6, 0x99, 0xff, 0x66, 0xd5, 0x7c, 0x8e,
+	0x6f, 0xc2, 0x52, 0xfc, 0x1e, 0x42, 0x60, 0x91, 0xd1, 0x11, 0x26, 0x6a, 0xfa, 0xdc, 0xfc, 0x0c,
+	0xaa, 0xd9, 0xd2, 0x40, 0xf6, 0x00, 0x6c, 0xce, 0x18, 0xda, 0x8a, 0x8b, 0x34, 0x2b, 0x67, 0xcb,
+	0x45, 0x37, 0xa5, 0xac, 0x9c, 0x54, 0x73, 0x1f, 0xaa, 0x19, 0x31, 0xcf, 0x43, 0x84, 0xa9, 0x49,
+	0x90, 0x06, 0xa6, 0xcf, 0xcd, 0x1f, 0xcb, 0x90, 0x5b, 0x34, 0xe6, 0xaa, 0x6d, 0xc1, 0x92, 0x27,
+	0x65, 0x88, 0x22, 0x51, 0x4c, 0x6e, 0xa4, 0x05, 0x15, 0xdb, 0xf7, 0x90, 0xa9, 0x7e, 0x4f, 0xef,
+	0x32, 0xd5, 0xce, 0xe5, 0xe9, 0xe9, 0x4e, 0xa5, 0x9b, 0x60, 0x56, 0xc6, 0x92, 0x5d, 0x58, 0xb1,
+	0x7d, 0x2f, 0x25, 0xe2, 0x95, 0xa5, 0x53, 0x9b, 0x9e, 0xee, 0xac, 0x74, 0x0f, 0xfb, 0x99, 0x7c,
+	0x5e, 0x26, 0x72, 0x2a, 0x6d, 0x1e, 0x24, 0x8b, 0x4b, 0xd5, 0x4a, 0x6e, 0xe4, 0x15, 0xac, 0x7a,
+	0xce, 0x0b, 0x7e, 0x82, 0xac, 0xab, 0x97, 0x38, 0x63, 0x49, 0xe7, 0xe6, 0xf6, 0x9c, 0x2d, 0xca,
+	0xec, 0xe7, 0x05, 0xf5, 0xe7, 0xea, 0x6c, 0x4c, 0x4f, 0x77, 0x56, 0xfb, 0xbd, 0x1c, 0x6e, 0x9d,
+	0xb7, 0x47, 0xee, 0x82, 0x81, 0x7a, 0x48, 0x0c, 0x1e, 0x77, 0x1f, 0x1c, 0x84, 0x6a, 0x88, 0x4c,
+	0x25, 0x9d, 0xa4, 0xb7, 0x97, 0x8a, 0x75, 0x21, 0x5f, 0x9f, 0x00, 0x99, 0xf5, 0x39, 0xa7, 0x44,
+	0x9e, 0x9c, 0x6f, 0xeb, 0x8f, 0xdf, 0xdb, 0xd6, 0xf1, 0x06, 0x6b, 0x66, 0x2b, 0x78, 0xb4, 0x0a,
+	0x9a, 0xda, 0x7e, 0xae, 0xb6, 0xf6, 0x7e, 0x2a, 0x41, 0x2d, 0xed, 0xaf, 0xe7, 0x28, 0xc6, 0x9e,
+	0x8d, 0xe4, 0x73, 0x28, 0x3f, 0x44, 0x45, 0xb6, 0x66, 0x76, 0x3e, 0xbd, 0xe7, 0xd6, 0x37, 0x66,
+	0xf0, 0xa6, 0xf1, 0xcd, 0xaf, 0xbf, 0x7f, 0xb7, 0x40, 0xc8, 0xba, 0xde, 0xdd, 0xc7, 0xbb, 0xd9,
+	0xde, 0x4c, 0x86, 0x00, 0x0f, 0x31, 0x5b, 0x02, 0x2e, 0x32, 0xd9, 0x98, 0xc1, 0x0b, 0xbd, 0xde,
+	0x6c, 0x68, 0x0f, 0x75, 0x62, 0x14, 0x3d, 0xb4, 0x93, 0x16, 0xef, 0x74, 0x7f, 0x9e, 0x6e, 0x97,
+	0x7e, 0x99, 0x6e, 0x97, 0x7e, 0x9b, 0x6e, 0x97, 0xbe, 0xfa, 0xf0, 0xef, 0xfd, 0x5b, 0x88, 0x4b,
+	0x2d, 0x33, 0x76, 0xbc, 0xa4, 0x77, 0xfb, 0xfd, 0x3f, 0x07, 0x00, 0x72, 0xf7, 0xe2, 0x56, 0xca,
+	0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1037,6 +1048,15 @@ func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ManifestProvenancePublicKey) > 0 {
+		i -= len(m.ManifestProvenancePublicKey)
+		copy(dAtA[i:], m.ManifestProvenancePublicKey)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.ManifestProvenancePublicKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
 	if m.SyncWithReplaceAllowed {
 		i--
 		if m.SyncWithReplaceAllowed {
@@ -1872,6 +1892,10 @@ func (m *Settings) Size() (n int) {
 	if m.SyncWithReplaceAllowed {
 		n += 3
 	}
+	l = len(m.ManifestProvenancePublicKey)
+	if l > 0 {
+		n += 2 + l + sovSettings(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3086,6 +3110,38 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SyncWithReplaceAllowed = bool(v != 0)
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestProvenancePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestProvenancePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -181,6 +181,8 @@ func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 //   - Each window must have a unique identity hash
 //   - Each window must validate successfully
 //   - A window must target at least one of applications, clusters, or namespaces
+//   - ApprovalGates:
+//   - Each gate must require at least one approval
 //   - DestinationServiceAccounts:
 //   - Server and namespace fields must not contain invalid characters or "!"
 //   - Default service account must not be empty or contain disallowed characters
@@ -287,6 +289,12 @@ func (proj *AppProject) ValidateProject() error {
 		}
 	}
 
+	for i, gate := range proj.Spec.ApprovalGates {
+		if gate.RequiredApprovals < 1 {
+			return status.Errorf(codes.InvalidArgument, "approval gate %d must require at least one approval", i+1)
+		}
+	}
+
 	destServiceAccts := make(map[string]bool)
 	for _, destServiceAcct := range proj.Spec.DestinationServiceAccounts {
 		if strings.Contains(destServiceAcct.Server, "!") {
@@ -322,6 +330,39 @@ func (proj *AppProject) ValidateProject() error {
 	return nil
 }
 
+// RequiredApprovals returns the number of approvals that sync operations of the given application require, which is
+// the highest number required by the approval gates matching the application
+func (proj AppProject) RequiredApprovals(app *Application) int64 {
+	var required int64
+	for _, gate := range proj.Spec.ApprovalGates {
+		if gate.Matches(app) {
+			required = max(required, gate.RequiredApprovals)
+		}
+	}
+	return required
+}
+
+// Matches returns true if the gate applies to the given application: its name, destination namespace and destination
+// cluster must each match one of the patterns of the gate, unless the gate has no patterns for them.
+func (g ApprovalGate) Matches(app *Application) bool {
+	matchesAny := func(patterns []string, values ...string) bool {
+		if len(patterns) == 0 {
+			return true
+		}
+		for _, pattern := range patterns {
+			for _, value := range values {
+				if value != "" && globMatch(pattern, value, false) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return matchesAny(g.Applications, app.Name) &&
+		matchesAny(g.Namespaces, app.Spec.Destination.Namespace) &&
+		matchesAny(g.Clusters, app.Spec.Destination.Name, app.Spec.Destination.Server)
+}
+
 // RoleGroupExists checks if a group exists in the role
 func RoleGroupExists(role *ProjectRole) bool {
 	return len(role.Groups) != 0
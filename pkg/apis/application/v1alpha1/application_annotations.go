@@ -6,6 +6,9 @@ const (
 	AnnotationKeyRefresh string = "argocd.argoproj.io/refresh"
 	// AnnotationKeyHydrate is the annotation key which indicates that app needs to be hydrated. Removed by application controller after app is hydrated.
 	AnnotationKeyHydrate string = "argocd.argoproj.io/hydrate"
+	// AnnotationKeyApprovePromotion is the annotation key which approves the promotion of hydrated manifests to the
+	// source hydrator promotion stages with a manual approval gate. Takes comma-separated <target branch>=<dry SHA> pairs.
+	AnnotationKeyApprovePromotion string = "argocd.argoproj.io/approve-promotion"
 	// AnnotationKeyManifestGeneratePaths is an annotation that contains a list of semicolon-separated paths in the
 	// manifests repository that affects the manifest generation. Paths might be either relative or absolute. The
 	// absolute path means an absolute path within the repository and the relative path is relative to the application
@@ -6,6 +6,11 @@ const (
 	DefaultSyncRetryMaxDuration time.Duration = 180000000000 // 3m0s
 	DefaultSyncRetryDuration    time.Duration = 5000000000   // 5s
 	DefaultSyncRetryFactor                    = int64(2)
+
+	DefaultResourceRetryMaxDuration time.Duration = 30000000000 // 30s
+	DefaultResourceRetryDuration    time.Duration = 1000000000  // 1s
+	DefaultResourceRetryFactor                    = int64(2)
+
 	// ResourcesFinalizerName is the finalizer value which we inject to finalize deletion of an application
 	ResourcesFinalizerName string = "resources-finalizer.argocd.argoproj.io"
 
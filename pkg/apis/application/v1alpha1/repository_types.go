@@ -116,6 +116,10 @@ type Repository struct {
 	InsecureOCIForceHttp bool `json:"insecureOCIForceHttp,omitempty" protobuf:"bytes,26,opt,name=insecureOCIForceHttp"` //nolint:revive //FIXME(var-naming)
 	// Depth specifies the depth for shallow clones. A value of 0 or omitting the field indicates a full clone.
 	Depth int64 `json:"depth,omitempty" protobuf:"bytes,27,opt,name=depth"`
+	// PartialClone specifies whether to fetch the repository as a partial clone, which only downloads the contents of files when they are checked out
+	PartialClone bool `json:"partialClone,omitempty" protobuf:"bytes,28,opt,name=partialClone"`
+	// SparseCheckout specifies whether to only check out the paths of the application source and the paths of its manifest-generate-paths annotation when generating manifests
+	SparseCheckout bool `json:"sparseCheckout,omitempty" protobuf:"bytes,29,opt,name=sparseCheckout"`
 }
 
 // IsInsecure returns true if the repository has been configured to skip server verification or set to HTTP only
@@ -333,6 +337,8 @@ func (repo *Repository) CopySettingsFrom(source *Repository) {
 		repo.Insecure = source.Insecure
 		repo.InheritedCreds = source.InheritedCreds
 		repo.Depth = source.Depth
+		repo.PartialClone = source.PartialClone
+		repo.SparseCheckout = source.SparseCheckout
 	}
 }
 
@@ -363,6 +369,8 @@ func (repo *Repository) Sanitized() *Repository {
 		GitHubAppEnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
 		UseAzureWorkloadIdentity:   repo.UseAzureWorkloadIdentity,
 		Depth:                      repo.Depth,
+		PartialClone:               repo.PartialClone,
+		SparseCheckout:             repo.SparseCheckout,
 	}
 }
 
@@ -33,10 +33,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -100,6 +102,27 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn is a list of applications which must be synced and healthy before the application is synced
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,10,rep,name=dependsOn"`
+}
+
+// ApplicationDependency is a reference to an application another application depends on
+type ApplicationDependency struct {
+	// Name is the name of the application
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the application. Defaults to the namespace of the dependent application.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+}
+
+// QualifiedName returns the namespace and name of the application, using the given namespace if the dependency does not
+// specify one
+func (d ApplicationDependency) QualifiedName(defaultNamespace string) string {
+	namespace := d.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	return namespace + "/" + d.Name
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -216,6 +239,10 @@ type ApplicationSource struct {
 	Ref string `json:"ref,omitempty" protobuf:"bytes,13,opt,name=ref"`
 	// Name is used to refer to a source and is displayed in the UI. It is used in multi-source Applications.
 	Name string `json:"name,omitempty" protobuf:"bytes,14,opt,name=name"`
+	// Helmfile holds helmfile specific options
+	Helmfile *ApplicationSourceHelmfile `json:"helmfile,omitempty" protobuf:"bytes,15,opt,name=helmfile"`
+	// Cue holds CUE specific options
+	Cue *ApplicationSourceCue `json:"cue,omitempty" protobuf:"bytes,16,opt,name=cue"`
 }
 
 // ApplicationSources contains list of required information about the sources of an application
@@ -252,15 +279,18 @@ func (spec *ApplicationSpec) GetSource() ApplicationSource {
 	return ApplicationSource{}
 }
 
-// GetHydrateToSource returns the hydrateTo source if it exists, otherwise returns the sync source.
+// GetHydrateToSource returns the hydrateTo source if it exists, otherwise returns the sync source. If a pull request is
+// configured without a hydrateTo source, the generated branch of the pull request is returned instead of the sync branch.
 func (spec *ApplicationSpec) GetHydrateToSource() ApplicationSource {
 	if spec.SourceHydrator != nil {
 		targetRevision := spec.SourceHydrator.SyncSource.TargetBranch
 		if spec.SourceHydrator.HydrateTo != nil {
 			targetRevision = spec.SourceHydrator.HydrateTo.TargetBranch
+		} else if spec.SourceHydrator.PullRequest != nil {
+			targetRevision = HydratorPullRequestBranchPrefix + spec.SourceHydrator.SyncSource.TargetBranch
 		}
 		return ApplicationSource{
-			RepoURL:        spec.SourceHydrator.DrySource.RepoURL,
+			RepoURL:        spec.SourceHydrator.SyncSource.GetRepoURL(spec.SourceHydrator.DrySource),
 			Path:           spec.SourceHydrator.SyncSource.Path,
 			TargetRevision: targetRevision,
 		}
@@ -344,6 +374,8 @@ func (source *ApplicationSource) IsZero() bool {
 			source.TargetRevision == "" &&
 			source.Helm.IsZero() &&
 			source.Kustomize.IsZero() &&
+			source.Helmfile.IsZero() &&
+			source.Cue.IsZero() &&
 			source.Directory.IsZero() &&
 			source.Plugin.IsZero()
 }
@@ -360,6 +392,9 @@ func (source *ApplicationSource) GetNamespaceOrDefault(defaultNamespace string)
 	if source.Kustomize != nil && source.Kustomize.Namespace != "" {
 		return source.Kustomize.Namespace
 	}
+	if source.Helmfile != nil && source.Helmfile.Namespace != "" {
+		return source.Helmfile.Namespace
+	}
 	return defaultNamespace
 }
 
@@ -375,6 +410,9 @@ func (source *ApplicationSource) GetKubeVersionOrDefault(defaultKubeVersion stri
 	if source.Kustomize != nil && source.Kustomize.KubeVersion != "" {
 		return source.Kustomize.KubeVersion
 	}
+	if source.Helmfile != nil && source.Helmfile.KubeVersion != "" {
+		return source.Helmfile.KubeVersion
+	}
 	return defaultKubeVersion
 }
 
@@ -390,6 +428,9 @@ func (source *ApplicationSource) GetAPIVersionsOrDefault(defaultAPIVersions []st
 	if source.Kustomize != nil && len(source.Kustomize.APIVersions) > 0 {
 		return source.Kustomize.APIVersions
 	}
+	if source.Helmfile != nil && len(source.Helmfile.APIVersions) > 0 {
+		return source.Helmfile.APIVersions
+	}
 	return defaultAPIVersions
 }
 
@@ -401,6 +442,8 @@ const (
 	ApplicationSourceTypeKustomize ApplicationSourceType = "Kustomize"
 	ApplicationSourceTypeDirectory ApplicationSourceType = "Directory"
 	ApplicationSourceTypePlugin    ApplicationSourceType = "Plugin"
+	ApplicationSourceTypeHelmfile  ApplicationSourceType = "Helmfile"
+	ApplicationSourceTypeCue       ApplicationSourceType = "CUE"
 )
 
 // SourceHydrator specifies a dry "don't repeat yourself" source for manifests, a sync source from which to sync
@@ -413,13 +456,23 @@ type SourceHydrator struct {
 	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. An external system would then
 	// have to move manifests to the SyncSource, e.g. by pull request.
 	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,3,opt,name=hydrateTo"`
+	// PullRequest configures the hydrator to open a pull request against the SyncSource target branch from the branch
+	// it pushes hydrated manifests to, so that hydrated manifests are reviewed before they are synced.
+	PullRequest *HydratorPullRequest `json:"pullRequest,omitempty" protobuf:"bytes,4,opt,name=pullRequest"`
+	// PromotionStages is an ordered list of branches the hydrated manifests are promoted through after being committed
+	// to the SyncSource target branch. The hydrated manifests are copied from a stage to the next one when the gate of
+	// the next stage passes.
+	PromotionStages []PromotionStage `json:"promotionStages,omitempty" protobuf:"bytes,5,rep,name=promotionStages"`
+	// Layout configures the files the hydrated manifests of the application are written to in the SyncSource path.
+	// The manifests are written to a single manifest.yaml file if not set.
+	Layout *HydratedLayout `json:"layout,omitempty" protobuf:"bytes,6,opt,name=layout"`
 }
 
 // GetSyncSource gets the source from which we should sync when a source hydrator is configured.
 func (s SourceHydrator) GetSyncSource() ApplicationSource {
 	return ApplicationSource{
-		// Pull the RepoURL from the dry source. The SyncSource's RepoURL is assumed to be the same.
-		RepoURL:        s.DrySource.RepoURL,
+		// Unless the SyncSource has its own RepoURL, pull the RepoURL from the dry source.
+		RepoURL:        s.SyncSource.GetRepoURL(s.DrySource),
 		Path:           s.SyncSource.Path,
 		TargetRevision: s.SyncSource.TargetBranch,
 	}
@@ -436,7 +489,7 @@ func (s SourceHydrator) GetDrySource() ApplicationSource {
 
 // DeepEquals returns true if the SourceHydrator is deeply equal to the given SourceHydrator.
 func (s SourceHydrator) DeepEquals(hydrator SourceHydrator) bool {
-	return s.DrySource.Equals(hydrator.DrySource) && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo)
+	return s.DrySource.Equals(hydrator.DrySource) && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo) && reflect.DeepEqual(s.PullRequest, hydrator.PullRequest) && reflect.DeepEqual(s.PromotionStages, hydrator.PromotionStages) && reflect.DeepEqual(s.Layout, hydrator.Layout)
 }
 
 // DrySource specifies a location for dry "don't repeat yourself" manifest source information.
@@ -485,6 +538,24 @@ type SyncSource struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Pattern=`^.{2,}|[^./]$`
 	Path string `json:"path" protobuf:"bytes,2,name=path"`
+	// RepoURL is the URL of an OCI repository, prefixed with oci://, to which hydrated manifests are pushed as OCI
+	// artifacts instead of being committed to the git repository of the DrySource. The artifacts are tagged with the
+	// dry SHA and with the TargetBranch, which must then be a valid OCI tag.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,3,opt,name=repoURL"`
+}
+
+// GetRepoURL returns the URL of the repository hydrated manifests are synced from, which defaults to the repository of
+// the given dry source.
+func (s SyncSource) GetRepoURL(drySource DrySource) string {
+	if s.RepoURL != "" {
+		return s.RepoURL
+	}
+	return drySource.RepoURL
+}
+
+// IsOCI returns true if hydrated manifests are pushed to an OCI repository.
+func (s SyncSource) IsOCI() bool {
+	return strings.HasPrefix(s.RepoURL, "oci://")
 }
 
 // HydrateTo specifies a location to which hydrated manifests should be pushed as a "staging area" before being moved to
@@ -507,6 +578,69 @@ func (in *HydrateTo) DeepEquals(to *HydrateTo) bool {
 	return *in == *to
 }
 
+const (
+	HydratorPullRequestProviderGitHub          = "github"
+	HydratorPullRequestProviderGitLab          = "gitlab"
+	HydratorPullRequestProviderGitea           = "gitea"
+	HydratorPullRequestProviderBitbucketServer = "bitbucketServer"
+	HydratorPullRequestProviderBitbucketCloud  = "bitbucketCloud"
+	HydratorPullRequestProviderAzureDevOps     = "azureDevOps"
+
+	// HydratorPullRequestBranchPrefix is the prefix of the branch hydrated manifests are pushed to when a pull request
+	// is configured without a HydrateTo branch.
+	HydratorPullRequestBranchPrefix = "argocd/hydrator/"
+)
+
+// HydratorPullRequest configures the SCM provider used to open pull requests for hydrated manifests. The pull request
+// is opened from the HydrateTo target branch, or from a branch generated from the SyncSource target branch if HydrateTo
+// is not set, against the SyncSource target branch. The credentials of the repository are used to open it.
+type HydratorPullRequest struct {
+	// Provider is the SCM provider hosting the repository
+	// +kubebuilder:validation:Enum=github;gitlab;gitea;bitbucketServer;bitbucketCloud;azureDevOps
+	Provider string `json:"provider" protobuf:"bytes,1,name=provider"`
+	// API is the URL of the API of the SCM provider. Defaults to the API of the public service of the provider, or to
+	// the API of the server hosting the repository for gitea and bitbucketServer.
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
+	// Labels are added to the pull requests opened by the hydrator. Bitbucket does not support labels.
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
+}
+
+// HydratedLayout configures the files hydrated manifests are written to, in addition to the README.md and
+// hydrator.metadata files
+type HydratedLayout struct {
+	// SplitManifests writes each hydrated resource to its own <kind>-<name>.yaml file instead of writing all of them to
+	// a single manifest.yaml file
+	SplitManifests bool `json:"splitManifests,omitempty" protobuf:"bytes,1,opt,name=splitManifests"`
+	// Kustomization writes a kustomization.yaml file listing the hydrated manifest files as resources, so that the
+	// hydrated manifests can be consumed by Kustomize
+	Kustomization bool `json:"kustomization,omitempty" protobuf:"bytes,2,opt,name=kustomization"`
+	// HelmValues writes a values.yaml file holding the values the Helm chart of the dry source was templated with. It
+	// requires Kustomization, since values.yaml would otherwise be synced as a manifest.
+	HelmValues bool `json:"helmValues,omitempty" protobuf:"bytes,3,opt,name=helmValues"`
+}
+
+// PromotionStage is a branch of the repository of the SyncSource to which hydrated manifests are promoted from the
+// previous stage, or from the SyncSource target branch for the first stage.
+type PromotionStage struct {
+	// TargetBranch is the branch to which hydrated manifests are promoted
+	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// Gate holds the conditions the previous stage must meet before hydrated manifests are promoted to this stage.
+	// Hydrated manifests are promoted as soon as they reach the previous stage if no condition is set.
+	Gate PromotionGate `json:"gate,omitempty" protobuf:"bytes,2,opt,name=gate"`
+}
+
+// PromotionGate holds the conditions which must all be met before hydrated manifests are promoted to a stage
+type PromotionGate struct {
+	// Healthy requires the applications syncing from the path of the previous stage to be synced to the hydrated
+	// manifests and healthy
+	Healthy bool `json:"healthy,omitempty" protobuf:"bytes,1,opt,name=healthy"`
+	// SoakDuration is the time to wait after hydrated manifests reach the previous stage, e.g. 30m or 2h
+	SoakDuration string `json:"soakDuration,omitempty" protobuf:"bytes,2,opt,name=soakDuration"`
+	// ManualApproval requires the promotion of the dry SHA to be approved with the
+	// argocd.argoproj.io/approve-promotion annotation, e.g. env/prod=<dry SHA>
+	ManualApproval bool `json:"manualApproval,omitempty" protobuf:"bytes,3,opt,name=manualApproval"`
+}
+
 // RefreshType specifies how to refresh the sources of a given application
 type RefreshType string
 
@@ -566,6 +700,24 @@ type ApplicationSourceHelm struct {
 	SkipTests bool `json:"skipTests,omitempty" protobuf:"bytes,14,opt,name=skipTests"`
 	// SkipSchemaValidation skips JSON schema validation (Helm's --skip-schema-validation)
 	SkipSchemaValidation bool `json:"skipSchemaValidation,omitempty" protobuf:"bytes,15,opt,name=skipSchemaValidation"`
+	// PostRenderer post-renders the manifests rendered by Helm, e.g. to patch the manifests of a vendor chart
+	PostRenderer *HelmPostRenderer `json:"postRenderer,omitempty" protobuf:"bytes,16,opt,name=postRenderer"`
+}
+
+// HelmPostRendererManifestsFile is the name of the file the manifests rendered by Helm are written to for the
+// post-renderer
+const HelmPostRendererManifestsFile = "helm-output.yaml"
+
+// HelmPostRenderer post-renders the manifests rendered by Helm, which are written to the helm-output.yaml file of the
+// directory it runs in. Exactly one of its fields must be set.
+type HelmPostRenderer struct {
+	// Plugin is the name of the config management plugin which post-renders the manifests. The plugin runs in the
+	// directory of the chart.
+	Plugin string `json:"plugin,omitempty" protobuf:"bytes,1,opt,name=plugin"`
+	// KustomizePath is the path of the Kustomize overlay which post-renders the manifests, relative to the directory of
+	// the chart, or to the root of a source with a ref if it starts with $<ref>/. The overlay must list the
+	// helm-output.yaml file in its resources.
+	KustomizePath string `json:"kustomizePath,omitempty" protobuf:"bytes,2,opt,name=kustomizePath"`
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -647,7 +799,7 @@ func (ash *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (ash *ApplicationSourceHelm) IsZero() bool {
-	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == ""
+	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == "" && ash.PostRenderer == nil
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -863,6 +1015,118 @@ func (rs KustomizeReplicas) FindByName(name string) int {
 	return -1
 }
 
+// ApplicationSourceHelmfile holds options specific to an Application source specific to helmfile
+type ApplicationSourceHelmfile struct {
+	// Environment is the helmfile environment to render the releases of (helmfile's --environment). If omitted, the
+	// default environment is used.
+	Environment string `json:"environment,omitempty" protobuf:"bytes,1,opt,name=environment"`
+	// Selectors select the releases to render by their labels, e.g. tier=frontend (helmfile's --selector)
+	Selectors []string `json:"selectors,omitempty" protobuf:"bytes,2,opt,name=selectors"`
+	// StateValuesFiles is a list of state values files layered on top of the values of the environment (helmfile's
+	// --state-values-file)
+	StateValuesFiles []string `json:"stateValuesFiles,omitempty" protobuf:"bytes,3,opt,name=stateValuesFiles"`
+	// StateValues are state values set on top of the state values files (helmfile's --state-values-set)
+	StateValues map[string]string `json:"stateValues,omitempty" protobuf:"bytes,4,opt,name=stateValues"`
+	// Namespace is the namespace of the releases which do not set one. If left empty, defaults to the app's destination
+	// namespace.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,5,opt,name=namespace"`
+	// KubeVersion specifies the Kubernetes API version to pass to Helm when templating manifests. By default, Argo CD
+	// uses the Kubernetes version of the target cluster.
+	KubeVersion string `json:"kubeVersion,omitempty" protobuf:"bytes,6,opt,name=kubeVersion"`
+	// APIVersions specifies the Kubernetes resource API versions to pass to Helm when templating manifests. By default,
+	// Argo CD uses the API versions of the target cluster. The format is [group/]version/kind.
+	APIVersions []string `json:"apiVersions,omitempty" protobuf:"bytes,7,opt,name=apiVersions"`
+	// SkipTests skips test manifest installation step (helmfile's --skip-tests)
+	SkipTests bool `json:"skipTests,omitempty" protobuf:"bytes,8,opt,name=skipTests"`
+}
+
+// IsZero returns true when the helmfile options are considered empty
+func (h *ApplicationSourceHelmfile) IsZero() bool {
+	return h == nil ||
+		h.Environment == "" &&
+			len(h.Selectors) == 0 &&
+			len(h.StateValuesFiles) == 0 &&
+			len(h.StateValues) == 0 &&
+			h.Namespace == "" &&
+			h.KubeVersion == "" &&
+			len(h.APIVersions) == 0 &&
+			!h.SkipTests
+}
+
+// SetStateValue sets a state value, replacing any existing value with the same name
+func (h *ApplicationSourceHelmfile) SetStateValue(name string, value string) {
+	if h.StateValues == nil {
+		h.StateValues = map[string]string{}
+	}
+	h.StateValues[name] = value
+}
+
+// ApplicationSourceCue holds options specific to applications of type CUE
+type ApplicationSourceCue struct {
+	// Package is the CUE package to export, relative to the path of the application, e.g. ./deploy or .:prod. If
+	// omitted, the package in the path of the application is exported.
+	Package string `json:"package,omitempty" protobuf:"bytes,1,opt,name=package"`
+	// Expression selects the value holding the Kubernetes objects to export (cue's --expression). The value can be a
+	// Kubernetes object, or a list or a struct of Kubernetes objects, which may be nested.
+	Expression string `json:"expression,omitempty" protobuf:"bytes,2,opt,name=expression"`
+	// Tags are the values injected into the fields of the package with a matching @tag attribute (cue's --inject)
+	Tags []CueTag `json:"tags,omitempty" protobuf:"bytes,3,opt,name=tags"`
+	// ValuesFiles is a list of YAML or JSON files unified with the package
+	ValuesFiles []string `json:"valuesFiles,omitempty" protobuf:"bytes,4,opt,name=valuesFiles"`
+	// Values is a block of YAML or JSON unified with the package, after the values files
+	// +patchStrategy=replace
+	Values string `json:"values,omitempty" patchStrategy:"replace" protobuf:"bytes,5,opt,name=values"`
+}
+
+// CueTag is a value injected into the fields of a CUE package with a matching @tag attribute
+type CueTag struct {
+	// Name is the name of the tag
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the tag
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+}
+
+// NewCueTag parses a string in format name=value into a CueTag object and returns it
+func NewCueTag(text string) (*CueTag, error) {
+	parts := strings.SplitN(text, "=", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("expected CUE tag of the form name=value but received: %s", text)
+	}
+	return &CueTag{Name: parts[0], Value: parts[1]}, nil
+}
+
+// IsZero returns true when the CUE options are considered empty
+func (c *ApplicationSourceCue) IsZero() bool {
+	return c == nil ||
+		c.Package == "" &&
+			c.Expression == "" &&
+			len(c.Tags) == 0 &&
+			len(c.ValuesFiles) == 0 &&
+			c.Values == ""
+}
+
+// AddTag adds a tag, replacing any existing tag with the same name
+func (c *ApplicationSourceCue) AddTag(tag CueTag) {
+	for i, t := range c.Tags {
+		if t.Name == tag.Name {
+			c.Tags[i] = tag
+			return
+		}
+	}
+	c.Tags = append(c.Tags, tag)
+}
+
+// RemoveTag removes the tag with the given name, and returns whether it was removed
+func (c *ApplicationSourceCue) RemoveTag(name string) bool {
+	for i, t := range c.Tags {
+		if t.Name == name {
+			c.Tags = append(c.Tags[:i], c.Tags[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 // JsonnetVar represents a variable to be passed to jsonnet during manifest generation
 type JsonnetVar struct {
 	Name  string `json:"name" protobuf:"bytes,1,opt,name=name"`
@@ -1208,6 +1472,9 @@ type ApplicationStatus struct {
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
 	// SourceHydrator stores information about the current state of source hydration
 	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// LastScheduledSyncAt indicates when the last sync operation was started by the sync schedule of the application,
+	// or when the sync schedule was added if no sync was started since
+	LastScheduledSyncAt *metav1.Time `json:"lastScheduledSyncAt,omitempty" protobuf:"bytes,15,opt,name=lastScheduledSyncAt"`
 }
 
 // SourceHydratorStatus contains information about the current state of source hydration
@@ -1216,8 +1483,36 @@ type SourceHydratorStatus struct {
 	LastSuccessfulOperation *SuccessfulHydrateOperation `json:"lastSuccessfulOperation,omitempty" protobuf:"bytes,1,opt,name=lastSuccessfulOperation"`
 	// CurrentOperation holds the status of the hydrate operation
 	CurrentOperation *HydrateOperation `json:"currentOperation,omitempty" protobuf:"bytes,2,opt,name=currentOperation"`
+	// PromotionStages holds the status of the promotion stages, in the order of the spec
+	PromotionStages []PromotionStageStatus `json:"promotionStages,omitempty" protobuf:"bytes,3,rep,name=promotionStages"`
+}
+
+// PromotionStageStatus contains information about the hydrated manifests promoted to a promotion stage
+type PromotionStageStatus struct {
+	// TargetBranch is the branch of the promotion stage
+	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// Phase indicates the status of the promotion to the stage
+	Phase PromotionStagePhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase"`
+	// Message contains a message describing the gate the promotion waits for, or the error of a failed promotion
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// DrySHA holds the revision (sha) of the dry source of the hydrated manifests promoted to the stage
+	DrySHA string `json:"drySHA,omitempty" protobuf:"bytes,4,opt,name=drySHA"`
+	// HydratedSHA holds the revision (sha) of the commit of the stage holding the promoted hydrated manifests
+	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,5,opt,name=hydratedSHA"`
+	// PromotedAt indicates when the hydrated manifests were promoted to the stage
+	PromotedAt *metav1.Time `json:"promotedAt,omitempty" protobuf:"bytes,6,opt,name=promotedAt"`
 }
 
+// PromotionStagePhase indicates the status of the promotion to a promotion stage
+// +kubebuilder:validation:Enum=Waiting;Failed;Promoted
+type PromotionStagePhase string
+
+const (
+	PromotionStagePhaseWaiting  PromotionStagePhase = "Waiting"
+	PromotionStagePhaseFailed   PromotionStagePhase = "Failed"
+	PromotionStagePhasePromoted PromotionStagePhase = "Promoted"
+)
+
 func (status *ApplicationStatus) FindResource(key kube.ResourceKey) (*ResourceStatus, bool) {
 	for i := range status.Resources {
 		res := status.Resources[i]
@@ -1244,6 +1539,9 @@ type HydrateOperation struct {
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
 	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// PullRequestURL is the URL of the pull request opened for the hydrated manifests, if the hydrator is configured
+	// to open pull requests
+	PullRequestURL string `json:"pullRequestURL,omitempty" protobuf:"bytes,8,opt,name=pullRequestURL"`
 }
 
 // SuccessfulHydrateOperation contains information about the most recent successful hydrate operation
@@ -1254,6 +1552,9 @@ type SuccessfulHydrateOperation struct {
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
 	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// PullRequestURL is the URL of the pull request opened for the hydrated manifests, if the hydrator is configured
+	// to open pull requests
+	PullRequestURL string `json:"pullRequestURL,omitempty" protobuf:"bytes,8,opt,name=pullRequestURL"`
 }
 
 // HydrateOperationPhase indicates the status of a hydrate operation
@@ -1318,6 +1619,28 @@ type Operation struct {
 	Info []*Info `json:"info,omitempty" protobuf:"bytes,3,name=info"`
 	// Retry controls the strategy to apply if a sync fails
 	Retry RetryStrategy `json:"retry,omitempty" protobuf:"bytes,4,opt,name=retry"`
+	// Approvals contains the approvals of the operation, which are required before a sync of an application matching an
+	// approval gate of its project is run
+	Approvals []OperationApproval `json:"approvals,omitempty" protobuf:"bytes,5,rep,name=approvals"`
+	// Rejection contains the rejection of the operation, if it was rejected while waiting for approvals
+	Rejection *OperationApproval `json:"rejection,omitempty" protobuf:"bytes,6,opt,name=rejection"`
+}
+
+// OperationApproval contains the approval or rejection of an operation by a user
+type OperationApproval struct {
+	// Username is the name of the user who approved or rejected the operation
+	Username string `json:"username" protobuf:"bytes,1,opt,name=username"`
+	// Time contains the time at which the operation was approved or rejected
+	Time metav1.Time `json:"time" protobuf:"bytes,2,opt,name=time"`
+	// Message contains an optional comment of the user
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+}
+
+// IsApprovedBy returns true if the operation was approved by the given user
+func (o *Operation) IsApprovedBy(username string) bool {
+	return slices.ContainsFunc(o.Approvals, func(approval OperationApproval) bool {
+		return approval.Username == username
+	})
 }
 
 // DryRun returns true if an operation was requested to be performed in dry run mode
@@ -1427,6 +1750,82 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// SyncBatches contains the progress of each batch of a sync operation using the batch sync strategy
+	SyncBatches []SyncBatchStatus `json:"syncBatches,omitempty" protobuf:"bytes,9,rep,name=syncBatches"`
+	// DryRunReport contains the outcome of a dry-run sync operation once it completed
+	DryRunReport *DryRunReport `json:"dryRunReport,omitempty" protobuf:"bytes,10,opt,name=dryRunReport"`
+}
+
+// SyncBatchStatus contains the progress of a batch of a sync operation using the batch sync strategy
+type SyncBatchStatus struct {
+	// Name is the name of the batch
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Phase is the current phase of the batch
+	Phase synccommon.OperationPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase"`
+	// Message contains information about the progress of the batch
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// StartedAt contains the time at which the batch started syncing
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,4,opt,name=startedAt"`
+	// SyncedAt contains the time at which the resources of the batch were synced, after which the batch waits for them to become healthy
+	SyncedAt *metav1.Time `json:"syncedAt,omitempty" protobuf:"bytes,5,opt,name=syncedAt"`
+	// FinishedAt contains the time at which the batch completed
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,6,opt,name=finishedAt"`
+}
+
+// DryRunAction is the action a sync operation would perform on a resource
+type DryRunAction string
+
+const (
+	// DryRunActionCreate means the resource or hook would be created
+	DryRunActionCreate DryRunAction = "Create"
+	// DryRunActionUpdate means the live resource differs from the desired state and would be updated
+	DryRunActionUpdate DryRunAction = "Update"
+	// DryRunActionPrune means the live resource is no longer desired and would be pruned
+	DryRunActionPrune DryRunAction = "Prune"
+	// DryRunActionNone means the resource would be left unchanged
+	DryRunActionNone DryRunAction = "None"
+)
+
+// DryRunReport contains the outcome of a dry-run sync operation
+type DryRunReport struct {
+	// Resources contains the action the sync would perform on each resource
+	Resources []DryRunResourceResult `json:"resources,omitempty" protobuf:"bytes,1,rep,name=resources"`
+	// Hooks contains the hooks the sync would run
+	Hooks []DryRunResourceResult `json:"hooks,omitempty" protobuf:"bytes,2,rep,name=hooks"`
+	// GeneratedAt contains the time at which the report was generated
+	GeneratedAt metav1.Time `json:"generatedAt" protobuf:"bytes,3,opt,name=generatedAt"`
+}
+
+// DryRunResourceResult contains the outcome of a dry-run sync operation for a single resource or hook
+type DryRunResourceResult struct {
+	// Group specifies the API group of the resource
+	Group string `json:"group" protobuf:"bytes,1,opt,name=group"`
+	// Version specifies the API version of the resource
+	Version string `json:"version" protobuf:"bytes,2,opt,name=version"`
+	// Kind specifies the API kind of the resource
+	Kind string `json:"kind" protobuf:"bytes,3,opt,name=kind"`
+	// Namespace specifies the target namespace of the resource
+	Namespace string `json:"namespace" protobuf:"bytes,4,opt,name=namespace"`
+	// Name specifies the name of the resource
+	Name string `json:"name" protobuf:"bytes,5,opt,name=name"`
+	// Action is the action the sync would perform on the resource
+	Action DryRunAction `json:"action" protobuf:"bytes,6,opt,name=action"`
+	// HookType specifies the type of the hook. Empty for non-hook resources
+	HookType synccommon.HookType `json:"hookType,omitempty" protobuf:"bytes,7,opt,name=hookType"`
+	// SyncPhase is the phase of the sync in which the resource would be synced
+	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,8,opt,name=syncPhase"`
+	// SyncWave is the sync wave in which the resource would be synced
+	SyncWave int64 `json:"syncWave,omitempty" protobuf:"bytes,9,opt,name=syncWave"`
+	// Message contains the message returned by the dry-run
+	Message string `json:"message,omitempty" protobuf:"bytes,10,opt,name=message"`
+	// ValidationError contains the error returned by the server-side validation of the resource, if any
+	ValidationError string `json:"validationError,omitempty" protobuf:"bytes,11,opt,name=validationError"`
+}
+
+// HasErrors returns true if the dry-run failed for any resource or hook of the report
+func (r *DryRunReport) HasErrors() bool {
+	hasError := func(res DryRunResourceResult) bool { return res.ValidationError != "" }
+	return slices.ContainsFunc(r.Resources, hasError) || slices.ContainsFunc(r.Hooks, hasError)
 }
 
 type Info struct {
@@ -1461,6 +1860,17 @@ func (o SyncOptions) HasOption(option string) bool {
 	return slices.Contains(o, option)
 }
 
+// GetOptionValue returns the value of the last sync option in the list with the given name, e.g. "10m" for
+// "SyncWaveTimeout=10m", and whether such an option was found
+func (o SyncOptions) GetOptionValue(name string) (string, bool) {
+	for i := len(o) - 1; i >= 0; i-- {
+		if value, ok := strings.CutPrefix(o[i], name+"="); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
 type ManagedNamespaceMetadata struct {
 	Labels      map[string]string `json:"labels,omitempty" protobuf:"bytes,1,opt,name=labels"`
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,opt,name=annotations"`
@@ -1476,6 +1886,10 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// AutoRollback controls automatic rollback to the last healthy revision when a sync fails or the application stays Degraded
+	AutoRollback *AutoRollbackPolicy `json:"autoRollback,omitempty" protobuf:"bytes,5,opt,name=autoRollback"`
+	// SyncSchedule starts sync operations on a cron schedule
+	SyncSchedule *SyncSchedule `json:"syncSchedule,omitempty" protobuf:"bytes,6,opt,name=syncSchedule"`
 	// If you add a field here, be sure to update IsZero.
 }
 
@@ -1489,7 +1903,61 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.AutoRollback == nil && p.SyncSchedule == nil)
+}
+
+// AutoRollbackPolicy controls when the application controller rolls an application back to the most recent revision
+// in its history that was observed to be Healthy. After a rollback, automated sync is suspended until a sync is
+// started manually.
+type AutoRollbackPolicy struct {
+	// OnSyncFailure rolls the application back when a sync operation fails (default: false)
+	OnSyncFailure bool `json:"onSyncFailure,omitempty" protobuf:"bytes,1,opt,name=onSyncFailure"`
+	// DegradedTimeout is the amount of time the application may stay Degraded before it is rolled back, e.g. "10m".
+	// If not set, a Degraded application is not rolled back.
+	DegradedTimeout string `json:"degradedTimeout,omitempty" protobuf:"bytes,2,opt,name=degradedTimeout"`
+}
+
+// DegradedTimeoutDuration returns the parsed DegradedTimeout, or zero if it is not set
+func (p *AutoRollbackPolicy) DegradedTimeoutDuration() (time.Duration, error) {
+	if p.DegradedTimeout == "" {
+		return 0, nil
+	}
+	return parseStringToDuration(p.DegradedTimeout)
+}
+
+// SyncSchedule controls when the application controller starts scheduled sync operations of an application
+type SyncSchedule struct {
+	// Schedule is the cron expression of the times a sync is started, e.g. "0 2 * * *"
+	Schedule string `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+	// TimeZone is the time zone the schedule is evaluated in, e.g. "Europe/Berlin" (default: UTC)
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,2,opt,name=timeZone"`
+	// Prune specifies whether scheduled syncs delete resources that are no longer defined in Git (default: false)
+	Prune bool `json:"prune,omitempty" protobuf:"bytes,3,opt,name=prune"`
+	// HardRefresh specifies whether the manifests are regenerated, bypassing the manifest cache, before a scheduled
+	// sync is started (default: false)
+	HardRefresh bool `json:"hardRefresh,omitempty" protobuf:"bytes,4,opt,name=hardRefresh"`
+}
+
+// Next returns the first time a sync is scheduled after the given time
+func (s *SyncSchedule) Next(after time.Time) (time.Time, error) {
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(s.Schedule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse schedule '%s': %w", s.Schedule, err)
+	}
+	loc := time.UTC
+	if s.TimeZone != "" {
+		if loc, err = time.LoadLocation(s.TimeZone); err != nil {
+			return time.Time{}, fmt.Errorf("cannot load time zone '%s': %w", s.TimeZone, err)
+		}
+	}
+	return schedule.Next(after.In(loc)), nil
+}
+
+// Validate checks that the schedule and time zone of the sync schedule are valid
+func (s *SyncSchedule) Validate() error {
+	_, err := s.Next(time.Now())
+	return err
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1500,6 +1968,44 @@ type RetryStrategy struct {
 	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff,casttype=Backoff"`
 	// Refresh indicates if the latest revision should be used on retry instead of the initial one (default: false)
 	Refresh bool `json:"refresh,omitempty" protobuf:"bytes,3,opt,name=refresh"`
+	// Resources controls retries of individual resources that fail to sync with a transient error. Failed resources are
+	// retried before the sync operation fails, and without running the other resources and hooks again.
+	Resources *ResourceRetryStrategy `json:"resources,omitempty" protobuf:"bytes,4,opt,name=resources"`
+}
+
+// ResourceRetryStrategy contains information about the strategy to apply when a resource fails to sync with a transient
+// error, such as a conflict or a webhook timeout
+type ResourceRetryStrategy struct {
+	// Limit is the maximum number of attempts for retrying a failed resource. If set to 0, no retries will be performed.
+	Limit int64 `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
+	// Backoff controls how to backoff on subsequent retries of failed resources
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff,casttype=Backoff"`
+}
+
+// RetryBackoff returns the backoff to wait for between retries of a failed resource
+func (r *ResourceRetryStrategy) RetryBackoff() (wait.Backoff, error) {
+	backoff := wait.Backoff{
+		Duration: DefaultResourceRetryDuration,
+		Factor:   float64(DefaultResourceRetryFactor),
+		Cap:      DefaultResourceRetryMaxDuration,
+	}
+	var err error
+	if r.Backoff != nil {
+		if r.Backoff.Duration != "" {
+			if backoff.Duration, err = parseStringToDuration(r.Backoff.Duration); err != nil {
+				return wait.Backoff{}, err
+			}
+		}
+		if r.Backoff.MaxDuration != "" {
+			if backoff.Cap, err = parseStringToDuration(r.Backoff.MaxDuration); err != nil {
+				return wait.Backoff{}, err
+			}
+		}
+		if r.Backoff.Factor != nil {
+			backoff.Factor = float64(*r.Backoff.Factor)
+		}
+	}
+	return backoff, nil
 }
 
 func parseStringToDuration(durationString string) (time.Duration, error) {
@@ -1566,6 +2072,67 @@ type SyncPolicyAutomated struct {
 	AllowEmpty bool `json:"allowEmpty,omitempty" protobuf:"bytes,3,opt,name=allowEmpty"`
 	// Enable allows apps to explicitly control automated sync
 	Enabled *bool `json:"enabled,omitempty" protobuf:"bytes,4,opt,name=enabled"`
+	// SelfHealPolicy limits self-heal to some of the resources of the application. Drift in the other resources is only
+	// reported.
+	SelfHealPolicy *SelfHealPolicy `json:"selfHealPolicy,omitempty" protobuf:"bytes,5,opt,name=selfHealPolicy"`
+}
+
+// SelfHealPolicy selects the resources of an application whose drift is corrected by self-heal
+type SelfHealPolicy struct {
+	// Include selects the resources which are self-healed. If empty, all the resources are self-healed unless excluded.
+	Include []SelfHealResourceSelector `json:"include,omitempty" protobuf:"bytes,1,rep,name=include"`
+	// Exclude selects the resources which are not self-healed, even if they are included
+	Exclude []SelfHealResourceSelector `json:"exclude,omitempty" protobuf:"bytes,2,rep,name=exclude"`
+}
+
+// SelfHealResourceSelector selects resources by group, kind, name and labels. Empty fields match all resources.
+type SelfHealResourceSelector struct {
+	// Group is the API group of the resources, supports glob patterns
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind is the kind of the resources, supports glob patterns
+	Kind string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	// Name is the name of the resources, supports glob patterns
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// LabelSelector selects the resources by label
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,4,opt,name=labelSelector"`
+}
+
+// Matches returns true if the selector selects the resource with the given group, kind, name and labels
+func (s SelfHealResourceSelector) Matches(group, kind, name string, resourceLabels map[string]string) bool {
+	if (s.Group != "" && !globMatch(s.Group, group, false)) ||
+		(s.Kind != "" && !globMatch(s.Kind, kind, false)) ||
+		(s.Name != "" && !globMatch(s.Name, name, false)) {
+		return false
+	}
+	if s.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(s.LabelSelector)
+		if err != nil || !selector.Matches(labels.Set(resourceLabels)) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSelfHealed returns true if drift in the resource with the given group, kind, name and labels is corrected by
+// self-heal
+func (p *SelfHealPolicy) IsSelfHealed(group, kind, name string, resourceLabels map[string]string) bool {
+	if p == nil {
+		return true
+	}
+	for _, selector := range p.Exclude {
+		if selector.Matches(group, kind, name, resourceLabels) {
+			return false
+		}
+	}
+	if len(p.Include) == 0 {
+		return true
+	}
+	for _, selector := range p.Include {
+		if selector.Matches(group, kind, name, resourceLabels) {
+			return true
+		}
+	}
+	return false
 }
 
 // SyncStrategy controls the manner in which a sync is performed
@@ -1574,6 +2141,8 @@ type SyncStrategy struct {
 	Apply *SyncStrategyApply `json:"apply,omitempty" protobuf:"bytes,1,opt,name=apply"`
 	// Hook will submit any referenced resources to perform the sync. This is the default strategy
 	Hook *SyncStrategyHook `json:"hook,omitempty" protobuf:"bytes,2,opt,name=hook"`
+	// Batch will sync the resources in label-selected batches, using hooks, waiting for each batch to become healthy before syncing the next one
+	Batch *SyncStrategyBatch `json:"batch,omitempty" protobuf:"bytes,3,opt,name=batch"`
 }
 
 // Force returns true if the sync strategy specifies to perform a forced sync
@@ -1585,6 +2154,8 @@ func (m *SyncStrategy) Force() bool {
 		return m.Apply.Force
 	case m.Hook != nil:
 		return m.Hook.Force
+	case m.Batch != nil:
+		return m.Batch.Force
 	}
 	return false
 }
@@ -1605,6 +2176,28 @@ type SyncStrategyHook struct {
 	SyncStrategyApply `json:",inline" protobuf:"bytes,1,opt,name=syncStrategyApply"`
 }
 
+// SyncStrategyBatch will perform a sync using hooks in batches of resources selected by label. The resources of a
+// batch must be synced and healthy, and its analysis hook must succeed, before the next batch is synced. Resources and
+// hooks that are not selected by any batch are synced in a final batch.
+type SyncStrategyBatch struct {
+	// Embed SyncStrategyHook type to inherit any `hook` options
+	// +optional
+	SyncStrategyHook `json:",inline" protobuf:"bytes,1,opt,name=syncStrategyHook"`
+	// Batches is the ordered list of batches to sync
+	Batches []SyncBatch `json:"batches" protobuf:"bytes,2,rep,name=batches"`
+}
+
+// SyncBatch selects the resources and hooks synced in a batch of a sync operation using the batch sync strategy
+type SyncBatch struct {
+	// Name is the name of the batch
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Selector selects the resources and hooks of the batch by label
+	Selector metav1.LabelSelector `json:"selector" protobuf:"bytes,2,opt,name=selector"`
+	// AnalysisHook is the name of a PostSync hook, typically a Job, that is run once the resources of the batch are
+	// healthy. The next batch is only synced if the hook succeeds.
+	AnalysisHook string `json:"analysisHook,omitempty" protobuf:"bytes,3,opt,name=analysisHook"`
+}
+
 // CommitMetadata contains metadata about a commit that is related in some way to another commit.
 type CommitMetadata struct {
 	// Author is the author of the commit, i.e. `git show -s --format=%an <%ae>`.
@@ -1692,6 +2285,11 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// ManifestsDigest holds the digest of the manifests generated from the source this sync operation was performed to,
+	// as recorded by their provenance
+	ManifestsDigest string `json:"manifestsDigest,omitempty" protobuf:"bytes,7,opt,name=manifestsDigest"`
+	// ManifestsDigests holds the digest of the manifests generated from the respective indexed source in sources field
+	ManifestsDigests []string `json:"manifestsDigests,omitempty" protobuf:"bytes,8,opt,name=manifestsDigests"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1719,6 +2317,18 @@ type ResourceResult struct {
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
 	// Images contains the images related to the ResourceResult
 	Images []string `json:"images,omitempty" protobuf:"bytes,11,opt,name=images"`
+	// SyncWave is the sync wave the resource was synced in
+	SyncWave int64 `json:"syncWave,omitempty" protobuf:"bytes,12,opt,name=syncWave"`
+	// WaveStartedAt contains the time at which the sync wave of the resource started
+	WaveStartedAt *metav1.Time `json:"waveStartedAt,omitempty" protobuf:"bytes,13,opt,name=waveStartedAt"`
+	// WaveDuration contains the time elapsed in the sync wave of the resource, updated until the wave completes
+	WaveDuration *metav1.Duration `json:"waveDuration,omitempty" protobuf:"bytes,14,opt,name=waveDuration"`
+	// SyncBatch is the name of the batch the resource was synced in when using the batch sync strategy
+	SyncBatch string `json:"syncBatch,omitempty" protobuf:"bytes,15,opt,name=syncBatch"`
+	// RetryCount is the number of times syncing the resource was retried after a transient error
+	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,16,opt,name=retryCount"`
+	// NextRetryAt contains the time at which syncing the resource is retried after a transient error
+	NextRetryAt *metav1.Time `json:"nextRetryAt,omitempty" protobuf:"bytes,17,opt,name=nextRetryAt"`
 }
 
 // GroupVersionKind returns the GVK schema information for a given resource within a sync result
@@ -1772,6 +2382,8 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// HealthyAt holds the time the application was first observed Healthy after this revision was deployed
+	HealthyAt *metav1.Time `json:"healthyAt,omitempty" protobuf:"bytes,11,opt,name=healthyAt"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1839,6 +2451,12 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionManifestPolicyWarning indicates that the generated manifests violate a manifest policy of the project
+	ApplicationConditionManifestPolicyWarning = "ManifestPolicyWarning"
+	// ApplicationConditionAutoRollbackWarning indicates that controller rolled the application back to a previously healthy revision
+	ApplicationConditionAutoRollbackWarning = "AutoRollbackWarning"
+	// ApplicationConditionWaitingForDependencies indicates that the application is not synced until its dependencies are synced and healthy
+	ApplicationConditionWaitingForDependencies = "WaitingForDependencies"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -1960,12 +2578,26 @@ type ApplicationTree struct {
 	// ShardsCount represents the total number of shards the application tree is split into.
 	// This is used to distribute resource processing across multiple shards.
 	ShardsCount int64 `json:"shardsCount,omitempty" protobuf:"bytes,4,opt,name=shardsCount"`
+	// Dependencies contains the applications the application depends on, directly or through other dependencies
+	Dependencies []ApplicationDependencyNode `json:"dependencies,omitempty" protobuf:"bytes,5,rep,name=dependencies"`
+}
+
+// ApplicationDependencyNode contains the status of an application in the dependency graph of another application
+type ApplicationDependencyNode struct {
+	ApplicationDependency `json:",inline" protobuf:"bytes,1,opt,name=applicationDependency"`
+	// SyncStatus is the sync status of the application. Empty if the application does not exist.
+	SyncStatus SyncStatusCode `json:"syncStatus,omitempty" protobuf:"bytes,2,opt,name=syncStatus,casttype=SyncStatusCode"`
+	// HealthStatus is the health status of the application. Empty if the application does not exist.
+	HealthStatus health.HealthStatusCode `json:"healthStatus,omitempty" protobuf:"bytes,3,opt,name=healthStatus"`
+	// DependsOn contains the applications the application directly depends on
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,4,rep,name=dependsOn"`
 }
 
 func (t *ApplicationTree) Merge(other *ApplicationTree) {
 	t.Nodes = append(t.Nodes, other.Nodes...)
 	t.OrphanedNodes = append(t.OrphanedNodes, other.OrphanedNodes...)
 	t.Hosts = append(t.Hosts, other.Hosts...)
+	t.Dependencies = append(t.Dependencies, other.Dependencies...)
 	t.Normalize()
 }
 
@@ -1995,6 +2627,12 @@ func (t *ApplicationTree) GetShards(size int64) []*ApplicationTree {
 			shard.Hosts = append(shard.Hosts, item)
 		})
 	}
+	for i := range t.Dependencies {
+		item := t.Dependencies[i]
+		items = append(items, func(shard *ApplicationTree) {
+			shard.Dependencies = append(shard.Dependencies, item)
+		})
+	}
 	var shards []*ApplicationTree
 	for len(items) > 0 {
 		shard := &ApplicationTree{}
@@ -2026,6 +2664,9 @@ func (t *ApplicationTree) Normalize() {
 	sort.Slice(t.Hosts, func(i, j int) bool {
 		return t.Hosts[i].Name < t.Hosts[j].Name
 	})
+	sort.Slice(t.Dependencies, func(i, j int) bool {
+		return t.Dependencies[i].QualifiedName("") < t.Dependencies[j].QualifiedName("")
+	})
 }
 
 // ApplicationSummary contains information about URLs and container images used by an application
@@ -2608,6 +3249,7 @@ var validActions = map[string]bool{
 	rbac.ActionDelete:   true,
 	rbac.ActionSync:     true,
 	rbac.ActionOverride: true,
+	rbac.ActionApprove:  true,
 	"*":                 true,
 }
 
@@ -2761,6 +3403,51 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// ApprovalGates define the number of approvals that sync operations of matching applications require before they are run
+	ApprovalGates []ApprovalGate `json:"approvalGates,omitempty" protobuf:"bytes,15,rep,name=approvalGates"`
+	// ManifestPolicies are the policies validating the manifests generated for the applications of the project
+	ManifestPolicies []ManifestPolicy `json:"manifestPolicies,omitempty" protobuf:"bytes,16,rep,name=manifestPolicies"`
+}
+
+const (
+	// ManifestPolicyActionWarn reports violations of a manifest policy as application warning conditions
+	ManifestPolicyActionWarn = "warn"
+	// ManifestPolicyActionDeny fails the manifest generation of applications violating a manifest policy
+	ManifestPolicyActionDeny = "deny"
+)
+
+// ManifestPolicy references a ConfigMap holding CEL policies the manifests generated for the applications of the
+// project are validated against
+type ManifestPolicy struct {
+	// ConfigMap is the name of the ConfigMap in the Argo CD namespace holding the policies, one per key. It must be
+	// labeled with app.kubernetes.io/part-of: argocd.
+	ConfigMap string `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	// Action is taken when the manifests violate a policy: warn (the default) or deny
+	// +kubebuilder:validation:Enum=warn;deny
+	Action string `json:"action,omitempty" protobuf:"bytes,2,opt,name=action"`
+}
+
+// GetAction returns the action taken when the manifests violate the policy
+func (p ManifestPolicy) GetAction() string {
+	if p.Action == "" {
+		return ManifestPolicyActionWarn
+	}
+	return p.Action
+}
+
+// ApprovalGate requires sync operations of the matching applications to be approved by a number of users before they
+// are run. A gate without applications, namespaces and clusters matches all applications of the project.
+type ApprovalGate struct {
+	// Applications contains a list of applications that the gate will apply to
+	Applications []string `json:"applications,omitempty" protobuf:"bytes,1,rep,name=applications"`
+	// Namespaces contains a list of namespaces that the gate will apply to
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,2,rep,name=namespaces"`
+	// Clusters contains a list of clusters that the gate will apply to
+	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,3,rep,name=clusters"`
+	// RequiredApprovals is the number of distinct users who must approve a sync operation before it is run
+	RequiredApprovals int64 `json:"requiredApprovals" protobuf:"bytes,4,opt,name=requiredApprovals"`
+	// Description of the gate, can be used to add any information such as the change management rule it implements
+	Description string `json:"description,omitempty" protobuf:"bytes,5,opt,name=description"`
 }
 
 // ClusterResourceRestrictionItem is a cluster resource that is restricted by the project's whitelist or blacklist
@@ -3354,6 +4041,22 @@ func (app *Application) IsHydrateRequested() bool {
 	return false
 }
 
+// IsPromotionApproved returns whether the promotion of the dry SHA to the target branch of a promotion stage has been
+// approved with the approve-promotion annotation, which holds comma-separated <target branch>=<dry SHA> pairs.
+func (app *Application) IsPromotionApproved(targetBranch string, drySHA string) bool {
+	approvals, ok := app.GetAnnotations()[AnnotationKeyApprovePromotion]
+	if !ok || drySHA == "" {
+		return false
+	}
+	for approval := range strings.SplitSeq(approvals, ",") {
+		branch, sha, found := strings.Cut(strings.TrimSpace(approval), "=")
+		if found && branch == targetBranch && sha == drySHA {
+			return true
+		}
+	}
+	return false
+}
+
 func (app *Application) HasPreDeleteFinalizer(stage ...string) bool {
 	return getFinalizerIndex(app.ObjectMeta, strings.Join(append([]string{PreDeleteFinalizerName}, stage...), "/")) > -1
 }
@@ -3542,6 +4245,12 @@ func (source *ApplicationSource) ExplicitType() (*ApplicationSourceType, error)
 	if source.Helm != nil {
 		appTypes = append(appTypes, ApplicationSourceTypeHelm)
 	}
+	if source.Helmfile != nil {
+		appTypes = append(appTypes, ApplicationSourceTypeHelmfile)
+	}
+	if source.Cue != nil {
+		appTypes = append(appTypes, ApplicationSourceTypeCue)
+	}
 	if source.Directory != nil {
 		appTypes = append(appTypes, ApplicationSourceTypeDirectory)
 	}
@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 func TestAppProject_IsSourcePermitted(t *testing.T) {
@@ -930,6 +931,48 @@ func TestAppProject_ValidateSyncWindowList(t *testing.T) {
 	})
 }
 
+func TestAppProject_ValidateApprovalGates(t *testing.T) {
+	p := newTestProject()
+	p.Spec.ApprovalGates = []ApprovalGate{{Namespaces: []string{"prod-*"}, RequiredApprovals: 2}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.ApprovalGates = append(p.Spec.ApprovalGates, ApprovalGate{Applications: []string{"guestbook"}})
+	err := p.ValidateProject()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "approval gate 2 must require at least one approval")
+}
+
+func TestAppProject_RequiredApprovals(t *testing.T) {
+	app := &Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+		Spec:       ApplicationSpec{Destination: ApplicationDestination{Server: "https://prod.example.com", Namespace: "prod-guestbook"}},
+	}
+	p := newTestProject()
+	assert.Equal(t, int64(0), p.RequiredApprovals(app))
+
+	p.Spec.ApprovalGates = []ApprovalGate{
+		{Namespaces: []string{"prod-*"}, RequiredApprovals: 1},
+		{Applications: []string{"guest*"}, Clusters: []string{"https://prod.*"}, RequiredApprovals: 2},
+		{Applications: []string{"guestbook"}, Clusters: []string{"staging"}, RequiredApprovals: 3},
+	}
+	assert.Equal(t, int64(2), p.RequiredApprovals(app))
+
+	app.Spec.Destination.Server = "https://staging.example.com"
+	assert.Equal(t, int64(1), p.RequiredApprovals(app))
+
+	app.Spec.Destination.Namespace = "default"
+	assert.Equal(t, int64(0), p.RequiredApprovals(app))
+
+	p.Spec.ApprovalGates = append(p.Spec.ApprovalGates, ApprovalGate{RequiredApprovals: 1})
+	assert.Equal(t, int64(1), p.RequiredApprovals(app))
+}
+
+func TestOperation_IsApprovedBy(t *testing.T) {
+	op := &Operation{Approvals: []OperationApproval{{Username: "alice"}}}
+	assert.True(t, op.IsApprovedBy("alice"))
+	assert.False(t, op.IsApprovedBy("bob"))
+}
+
 // TestInvalidPolicyRules checks various errors in policy rules
 func TestAppProject_InvalidPolicyRules(t *testing.T) {
 	p := newTestProject()
@@ -1754,6 +1797,7 @@ func TestApplicationSourceHelm_IsZero(t *testing.T) {
 		{"Parameters", &ApplicationSourceHelm{Parameters: []HelmParameter{{}}}, false},
 		{"ReleaseName", &ApplicationSourceHelm{ReleaseName: "foa"}, false},
 		{"FileParameters", &ApplicationSourceHelm{FileParameters: []HelmFileParameter{{}}}, false},
+		{"PostRenderer", &ApplicationSourceHelm{PostRenderer: &HelmPostRenderer{KustomizePath: "overlay"}}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3529,6 +3573,30 @@ func TestSyncOptions_RemoveOption(t *testing.T) {
 	assert.Empty(t, options.RemoveOption("a=1").RemoveOption("a=1"))
 }
 
+func TestSyncOptions_GetOptionValue(t *testing.T) {
+	var nilOptions SyncOptions
+	_, ok := nilOptions.GetOptionValue("a")
+	assert.False(t, ok)
+	value, ok := SyncOptions{"a=1", "ab=2"}.GetOptionValue("a")
+	assert.True(t, ok)
+	assert.Equal(t, "1", value)
+	value, ok = SyncOptions{"a=1", "a=2"}.GetOptionValue("a")
+	assert.True(t, ok)
+	assert.Equal(t, "2", value)
+	_, ok = SyncOptions{"ab=1"}.GetOptionValue("a")
+	assert.False(t, ok)
+}
+
+func TestDryRunReport_HasErrors(t *testing.T) {
+	report := &DryRunReport{
+		Resources: []DryRunResourceResult{{Kind: "ConfigMap", Name: "a", Action: DryRunActionCreate}},
+		Hooks:     []DryRunResourceResult{{Kind: "Job", Name: "b", Action: DryRunActionCreate}},
+	}
+	assert.False(t, report.HasErrors())
+	report.Hooks[0].ValidationError = "invalid spec"
+	assert.True(t, report.HasErrors())
+}
+
 func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Empty(t, RevisionHistories{}.Trunc(1))
 	assert.Len(t, RevisionHistories{{}}.Trunc(1), 1)
@@ -3667,6 +3735,70 @@ func TestRetryStrategy_NextRetryAtCustomBackoff(t *testing.T) {
 	}
 }
 
+func TestSyncSchedule_Next(t *testing.T) {
+	after := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	t.Run("UTC", func(t *testing.T) {
+		next, err := (&SyncSchedule{Schedule: "0 2 * * *"}).Next(after)
+		require.NoError(t, err)
+		assert.True(t, next.Equal(time.Date(2024, 3, 2, 2, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("TimeZone", func(t *testing.T) {
+		next, err := (&SyncSchedule{Schedule: "0 2 * * *", TimeZone: "Asia/Tokyo"}).Next(after)
+		require.NoError(t, err)
+		// 02:00 in Tokyo is 17:00 UTC on the previous day
+		assert.True(t, next.Equal(time.Date(2024, 3, 1, 17, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("InvalidSchedule", func(t *testing.T) {
+		_, err := (&SyncSchedule{Schedule: "nightly"}).Next(after)
+		require.ErrorContains(t, err, "cannot parse schedule 'nightly'")
+	})
+
+	t.Run("InvalidTimeZone", func(t *testing.T) {
+		err := (&SyncSchedule{Schedule: "0 2 * * *", TimeZone: "Mars/Olympus"}).Validate()
+		require.ErrorContains(t, err, "cannot load time zone 'Mars/Olympus'")
+	})
+}
+
+func TestSelfHealPolicy_IsSelfHealed(t *testing.T) {
+	policy := &SelfHealPolicy{
+		Include: []SelfHealResourceSelector{
+			{Group: "networking.k8s.io", Kind: "NetworkPolicy"},
+			{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"critical": "true"}}},
+		},
+		Exclude: []SelfHealResourceSelector{{Kind: "NetworkPolicy", Name: "debug-*"}},
+	}
+
+	assert.True(t, policy.IsSelfHealed("networking.k8s.io", "NetworkPolicy", "deny-all", nil))
+	assert.False(t, policy.IsSelfHealed("networking.k8s.io", "NetworkPolicy", "debug-allow-all", nil))
+	assert.True(t, policy.IsSelfHealed("apps", "Deployment", "guestbook", map[string]string{"critical": "true"}))
+	assert.False(t, policy.IsSelfHealed("apps", "Deployment", "guestbook", map[string]string{"critical": "false"}))
+	assert.False(t, policy.IsSelfHealed("", "ConfigMap", "guestbook", nil))
+
+	// without includes, all resources which are not excluded are self-healed
+	policy.Include = nil
+	assert.True(t, policy.IsSelfHealed("", "ConfigMap", "guestbook", nil))
+	assert.False(t, policy.IsSelfHealed("networking.k8s.io", "NetworkPolicy", "debug-allow-all", nil))
+
+	// without policy, all resources are self-healed
+	assert.True(t, (*SelfHealPolicy)(nil).IsSelfHealed("", "ConfigMap", "guestbook", nil))
+}
+
+func TestResourceRetryStrategy_RetryBackoff(t *testing.T) {
+	backoff, err := (&ResourceRetryStrategy{Limit: 3}).RetryBackoff()
+	require.NoError(t, err)
+	assert.Equal(t, wait.Backoff{Duration: DefaultResourceRetryDuration, Factor: 2, Cap: DefaultResourceRetryMaxDuration}, backoff)
+
+	backoff, err = (&ResourceRetryStrategy{Limit: 3, Backoff: &Backoff{Duration: "2", Factor: new(int64(3)), MaxDuration: "1m"}}).RetryBackoff()
+	require.NoError(t, err)
+	assert.Equal(t, wait.Backoff{Duration: 2 * time.Second, Factor: 3, Cap: time.Minute}, backoff)
+
+	_, err = (&ResourceRetryStrategy{Limit: 3, Backoff: &Backoff{Duration: "foo"}}).RetryBackoff()
+	require.Error(t, err)
+}
+
 func TestSourceAllowsConcurrentProcessing_KustomizeParams(t *testing.T) {
 	t.Run("Has NameSuffix", func(t *testing.T) {
 		src := ApplicationSource{Path: ".", Kustomize: &ApplicationSourceKustomize{
@@ -3868,6 +4000,7 @@ func TestIsValidAction(t *testing.T) {
 		{"ValidDelete", "delete", true},
 		{"ValidSync", "sync", true},
 		{"ValidOverride", "override", true},
+		{"ValidApprove", "approve", true},
 		{"ValidWildcard", "*", true},
 
 		// pattern matches
@@ -4943,6 +5076,8 @@ func TestSourceHydrator_Equals(t *testing.T) {
 	}{
 		{"different SourceHydrators", SourceHydrator{}, SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, false},
 		{"equal SourceHydrators", SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, true},
+		{"different pull requests", SourceHydrator{PullRequest: &HydratorPullRequest{Provider: "github"}}, SourceHydrator{PullRequest: &HydratorPullRequest{Provider: "gitlab"}}, false},
+		{"equal pull requests", SourceHydrator{PullRequest: &HydratorPullRequest{Provider: "github"}}, SourceHydrator{PullRequest: &HydratorPullRequest{Provider: "github"}}, true},
 	}
 
 	for _, testCase := range tests {
@@ -5023,3 +5158,41 @@ func TestIgnoreDifferences_Equals(t *testing.T) {
 		})
 	}
 }
+
+func TestApplicationSpec_GetHydrateToSource(t *testing.T) {
+	spec := &ApplicationSpec{SourceHydrator: &SourceHydrator{
+		DrySource:  DrySource{RepoURL: "https://example.com/repo"},
+		SyncSource: SyncSource{TargetBranch: "env/prod", Path: "app"},
+	}}
+	assert.Equal(t, ApplicationSource{RepoURL: "https://example.com/repo", Path: "app", TargetRevision: "env/prod"}, spec.GetHydrateToSource())
+
+	spec.SourceHydrator.PullRequest = &HydratorPullRequest{Provider: HydratorPullRequestProviderGitHub}
+	assert.Equal(t, "argocd/hydrator/env/prod", spec.GetHydrateToSource().TargetRevision)
+
+	spec.SourceHydrator.HydrateTo = &HydrateTo{TargetBranch: "env/prod-next"}
+	assert.Equal(t, "env/prod-next", spec.GetHydrateToSource().TargetRevision)
+}
+
+func TestApplication_IsPromotionApproved(t *testing.T) {
+	app := &Application{}
+	assert.False(t, app.IsPromotionApproved("env/prod", "abc123"))
+
+	app.SetAnnotations(map[string]string{AnnotationKeyApprovePromotion: "env/staging=def456, env/prod=abc123"})
+	assert.True(t, app.IsPromotionApproved("env/prod", "abc123"))
+	assert.True(t, app.IsPromotionApproved("env/staging", "def456"))
+	assert.False(t, app.IsPromotionApproved("env/prod", "def456"))
+	assert.False(t, app.IsPromotionApproved("env/dev", "abc123"))
+	assert.False(t, app.IsPromotionApproved("env/prod", ""))
+}
+
+func TestSourceHydrator_OCISyncSource(t *testing.T) {
+	spec := &ApplicationSpec{SourceHydrator: &SourceHydrator{
+		DrySource:  DrySource{RepoURL: "https://example.com/repo"},
+		SyncSource: SyncSource{RepoURL: "oci://registry.example.com/hydrated", TargetBranch: "env-prod", Path: "app"},
+		HydrateTo:  &HydrateTo{TargetBranch: "env-prod-next"},
+	}}
+	assert.True(t, spec.SourceHydrator.SyncSource.IsOCI())
+	assert.Equal(t, ApplicationSource{RepoURL: "oci://registry.example.com/hydrated", Path: "app", TargetRevision: "env-prod"}, spec.SourceHydrator.GetSyncSource())
+	assert.Equal(t, ApplicationSource{RepoURL: "oci://registry.example.com/hydrated", Path: "app", TargetRevision: "env-prod-next"}, spec.GetHydrateToSource())
+	assert.Equal(t, "https://example.com/repo", spec.SourceHydrator.GetDrySource().RepoURL)
+}
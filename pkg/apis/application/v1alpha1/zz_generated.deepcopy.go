@@ -194,6 +194,18 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.ApprovalGates != nil {
+		in, out := &in.ApprovalGates, &out.ApprovalGates
+		*out = make([]ApprovalGate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManifestPolicies != nil {
+		in, out := &in.ManifestPolicies, &out.ManifestPolicies
+		*out = make([]ManifestPolicy, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -283,6 +295,44 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependencyNode) DeepCopyInto(out *ApplicationDependencyNode) {
+	*out = *in
+	out.ApplicationDependency = in.ApplicationDependency
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependencyNode.
+func (in *ApplicationDependencyNode) DeepCopy() *ApplicationDependencyNode {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependencyNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -1084,6 +1134,16 @@ func (in *ApplicationSource) DeepCopyInto(out *ApplicationSource) {
 		*out = new(ApplicationSourcePlugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Helmfile != nil {
+		in, out := &in.Helmfile, &out.Helmfile
+		*out = new(ApplicationSourceHelmfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cue != nil {
+		in, out := &in.Cue, &out.Cue
+		*out = new(ApplicationSourceCue)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1097,6 +1157,32 @@ func (in *ApplicationSource) DeepCopy() *ApplicationSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourceCue) DeepCopyInto(out *ApplicationSourceCue) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]CueTag, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValuesFiles != nil {
+		in, out := &in.ValuesFiles, &out.ValuesFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourceCue.
+func (in *ApplicationSourceCue) DeepCopy() *ApplicationSourceCue {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourceCue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSourceDirectory) DeepCopyInto(out *ApplicationSourceDirectory) {
 	*out = *in
@@ -1142,6 +1228,11 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PostRenderer != nil {
+		in, out := &in.PostRenderer, &out.PostRenderer
+		*out = new(HelmPostRenderer)
+		**out = **in
+	}
 	return
 }
 
@@ -1155,6 +1246,44 @@ func (in *ApplicationSourceHelm) DeepCopy() *ApplicationSourceHelm {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourceHelmfile) DeepCopyInto(out *ApplicationSourceHelmfile) {
+	*out = *in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StateValuesFiles != nil {
+		in, out := &in.StateValuesFiles, &out.StateValuesFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StateValues != nil {
+		in, out := &in.StateValues, &out.StateValues
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.APIVersions != nil {
+		in, out := &in.APIVersions, &out.APIVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourceHelmfile.
+func (in *ApplicationSourceHelmfile) DeepCopy() *ApplicationSourceHelmfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourceHelmfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSourceJsonnet) DeepCopyInto(out *ApplicationSourceJsonnet) {
 	*out = *in
@@ -1395,6 +1524,11 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1454,6 +1588,10 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		copy(*out, *in)
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.LastScheduledSyncAt != nil {
+		in, out := &in.LastScheduledSyncAt, &out.LastScheduledSyncAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1517,6 +1655,13 @@ func (in *ApplicationTree) DeepCopyInto(out *ApplicationTree) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ApplicationDependencyNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1547,6 +1692,53 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalGate) DeepCopyInto(out *ApprovalGate) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalGate.
+func (in *ApprovalGate) DeepCopy() *ApprovalGate {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoRollbackPolicy) DeepCopyInto(out *AutoRollbackPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRollbackPolicy.
+func (in *AutoRollbackPolicy) DeepCopy() *AutoRollbackPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRollbackPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -1983,6 +2175,65 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CueTag) DeepCopyInto(out *CueTag) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CueTag.
+func (in *CueTag) DeepCopy() *CueTag {
+	if in == nil {
+		return nil
+	}
+	out := new(CueTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunReport) DeepCopyInto(out *DryRunReport) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]DryRunResourceResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]DryRunResourceResult, len(*in))
+		copy(*out, *in)
+	}
+	in.GeneratedAt.DeepCopyInto(&out.GeneratedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunReport.
+func (in *DryRunReport) DeepCopy() *DryRunReport {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunResourceResult) DeepCopyInto(out *DryRunResourceResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunResourceResult.
+func (in *DryRunResourceResult) DeepCopy() *DryRunResourceResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunResourceResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DrySource) DeepCopyInto(out *DrySource) {
 	*out = *in
@@ -2301,6 +2552,22 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmPostRenderer) DeepCopyInto(out *HelmPostRenderer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPostRenderer.
+func (in *HelmPostRenderer) DeepCopy() *HelmPostRenderer {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmPostRenderer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
@@ -2384,6 +2651,43 @@ func (in *HydrateTo) DeepCopy() *HydrateTo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratedLayout) DeepCopyInto(out *HydratedLayout) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratedLayout.
+func (in *HydratedLayout) DeepCopy() *HydratedLayout {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratedLayout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratorPullRequest) DeepCopyInto(out *HydratorPullRequest) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratorPullRequest.
+func (in *HydratorPullRequest) DeepCopy() *HydratorPullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratorPullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in IgnoreDifferences) DeepCopyInto(out *IgnoreDifferences) {
 	{
@@ -2755,6 +3059,22 @@ func (in *ManagedNamespaceMetadata) DeepCopy() *ManagedNamespaceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestPolicy) DeepCopyInto(out *ManifestPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestPolicy.
+func (in *ManifestPolicy) DeepCopy() *ManifestPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MatrixGenerator) DeepCopyInto(out *MatrixGenerator) {
 	*out = *in
@@ -2896,6 +3216,18 @@ func (in *Operation) DeepCopyInto(out *Operation) {
 		}
 	}
 	in.Retry.DeepCopyInto(&out.Retry)
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]OperationApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rejection != nil {
+		in, out := &in.Rejection, &out.Rejection
+		*out = new(OperationApproval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2909,6 +3241,23 @@ func (in *Operation) DeepCopy() *Operation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationApproval) DeepCopyInto(out *OperationApproval) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationApproval.
+func (in *OperationApproval) DeepCopy() *OperationApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationInitiator) DeepCopyInto(out *OperationInitiator) {
 	*out = *in
@@ -2939,6 +3288,18 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.SyncBatches != nil {
+		in, out := &in.SyncBatches, &out.SyncBatches
+		*out = make([]SyncBatchStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DryRunReport != nil {
+		in, out := &in.DryRunReport, &out.DryRunReport
+		*out = new(DryRunReport)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3192,6 +3553,59 @@ func (in *ProjectRole) DeepCopy() *ProjectRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionGate) DeepCopyInto(out *PromotionGate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionGate.
+func (in *PromotionGate) DeepCopy() *PromotionGate {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStage) DeepCopyInto(out *PromotionStage) {
+	*out = *in
+	out.Gate = in.Gate
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStage.
+func (in *PromotionStage) DeepCopy() *PromotionStage {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStageStatus) DeepCopyInto(out *PromotionStageStatus) {
+	*out = *in
+	if in.PromotedAt != nil {
+		in, out := &in.PromotedAt, &out.PromotedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStageStatus.
+func (in *PromotionStageStatus) DeepCopy() *PromotionStageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestGenerator) DeepCopyInto(out *PullRequestGenerator) {
 	*out = *in
@@ -3917,6 +4331,19 @@ func (in *ResourceResult) DeepCopyInto(out *ResourceResult) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.WaveStartedAt != nil {
+		in, out := &in.WaveStartedAt, &out.WaveStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.WaveDuration != nil {
+		in, out := &in.WaveDuration, &out.WaveDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NextRetryAt != nil {
+		in, out := &in.NextRetryAt, &out.NextRetryAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -3956,6 +4383,27 @@ func (in ResourceResults) DeepCopy() ResourceResults {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRetryStrategy) DeepCopyInto(out *ResourceRetryStrategy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRetryStrategy.
+func (in *ResourceRetryStrategy) DeepCopy() *ResourceRetryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRetryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 	*out = *in
@@ -3985,6 +4433,11 @@ func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 		*out = new(Backoff)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRetryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4042,6 +4495,10 @@ func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 		copy(*out, *in)
 	}
 	out.InitiatedBy = in.InitiatedBy
+	if in.HealthyAt != nil {
+		in, out := &in.HealthyAt, &out.HealthyAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -4409,6 +4866,57 @@ func (in *SecretRef) DeepCopy() *SecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelfHealPolicy) DeepCopyInto(out *SelfHealPolicy) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]SelfHealResourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]SelfHealResourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfHealPolicy.
+func (in *SelfHealPolicy) DeepCopy() *SelfHealPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfHealPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelfHealResourceSelector) DeepCopyInto(out *SelfHealResourceSelector) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfHealResourceSelector.
+func (in *SelfHealResourceSelector) DeepCopy() *SelfHealResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfHealResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SignatureKey) DeepCopyInto(out *SignatureKey) {
 	*out = *in
@@ -4435,6 +4943,21 @@ func (in *SourceHydrator) DeepCopyInto(out *SourceHydrator) {
 		*out = new(HydrateTo)
 		**out = **in
 	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratorPullRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PromotionStages != nil {
+		in, out := &in.PromotionStages, &out.PromotionStages
+		*out = make([]PromotionStage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Layout != nil {
+		in, out := &in.Layout, &out.Layout
+		*out = new(HydratedLayout)
+		**out = **in
+	}
 	return
 }
 
@@ -4461,6 +4984,13 @@ func (in *SourceHydratorStatus) DeepCopyInto(out *SourceHydratorStatus) {
 		*out = new(HydrateOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PromotionStages != nil {
+		in, out := &in.PromotionStages, &out.PromotionStages
+		*out = make([]PromotionStageStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4491,6 +5021,51 @@ func (in *SuccessfulHydrateOperation) DeepCopy() *SuccessfulHydrateOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncBatch) DeepCopyInto(out *SyncBatch) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncBatch.
+func (in *SyncBatch) DeepCopy() *SyncBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncBatchStatus) DeepCopyInto(out *SyncBatchStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.SyncedAt != nil {
+		in, out := &in.SyncedAt, &out.SyncedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncBatchStatus.
+func (in *SyncBatchStatus) DeepCopy() *SyncBatchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncBatchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -4592,6 +5167,11 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManifestsDigests != nil {
+		in, out := &in.ManifestsDigests, &out.ManifestsDigests
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4648,6 +5228,16 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(AutoRollbackPolicy)
+		**out = **in
+	}
+	if in.SyncSchedule != nil {
+		in, out := &in.SyncSchedule, &out.SyncSchedule
+		*out = new(SyncSchedule)
+		**out = **in
+	}
 	return
 }
 
@@ -4669,6 +5259,11 @@ func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SelfHealPolicy != nil {
+		in, out := &in.SelfHealPolicy, &out.SelfHealPolicy
+		*out = new(SelfHealPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4682,6 +5277,22 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncSchedule) DeepCopyInto(out *SyncSchedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncSchedule.
+func (in *SyncSchedule) DeepCopy() *SyncSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSource) DeepCopyInto(out *SyncSource) {
 	*out = *in
@@ -4733,6 +5344,11 @@ func (in *SyncStrategy) DeepCopyInto(out *SyncStrategy) {
 		*out = new(SyncStrategyHook)
 		**out = **in
 	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(SyncStrategyBatch)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4762,6 +5378,30 @@ func (in *SyncStrategyApply) DeepCopy() *SyncStrategyApply {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncStrategyBatch) DeepCopyInto(out *SyncStrategyBatch) {
+	*out = *in
+	out.SyncStrategyHook = in.SyncStrategyHook
+	if in.Batches != nil {
+		in, out := &in.Batches, &out.Batches
+		*out = make([]SyncBatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncStrategyBatch.
+func (in *SyncStrategyBatch) DeepCopy() *SyncStrategyBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncStrategyBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncStrategyHook) DeepCopyInto(out *SyncStrategyHook) {
 	*out = *in
@@ -0,0 +1,94 @@
+package apiclient
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	cryptoutil "github.com/argoproj/argo-cd/v3/util/crypto"
+)
+
+// ManifestDigest returns the SHA-256 digest of a manifest
+func ManifestDigest(manifest string) string {
+	digest := sha256.Sum256([]byte(manifest))
+	return "sha256:" + hex.EncodeToString(digest[:])
+}
+
+// SetManifests records the digests of the given manifests
+func (p *ManifestProvenance) SetManifests(manifests []string) {
+	p.ManifestDigests = make([]string, 0, len(manifests))
+	for _, manifest := range manifests {
+		p.ManifestDigests = append(p.ManifestDigests, ManifestDigest(manifest))
+	}
+	p.ManifestsDigest = manifestsDigest(p.ManifestDigests)
+}
+
+func manifestsDigest(manifestDigests []string) string {
+	return ManifestDigest(strings.Join(manifestDigests, "\n"))
+}
+
+// payload returns the data the record is signed over, which is the JSON encoding of the record without its signature
+func (p *ManifestProvenance) payload() ([]byte, error) {
+	unsigned := *p
+	unsigned.Signature = ""
+	data, err := json.Marshal(&unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest provenance: %w", err)
+	}
+	return data, nil
+}
+
+// Sign signs the record with the given key
+func (p *ManifestProvenance) Sign(key crypto.Signer) error {
+	keyID, err := cryptoutil.KeyID(key.Public())
+	if err != nil {
+		return err
+	}
+	p.KeyID = keyID
+	p.Signature = ""
+	data, err := p.payload()
+	if err != nil {
+		return err
+	}
+	signature, err := cryptoutil.Sign(key, data)
+	if err != nil {
+		return fmt.Errorf("failed to sign manifest provenance: %w", err)
+	}
+	p.Signature = base64.StdEncoding.EncodeToString(signature)
+	return nil
+}
+
+// Verify verifies that the record is signed with the private key of the given public key, and that its manifest
+// digests match its manifests digest
+func (p *ManifestProvenance) Verify(key crypto.PublicKey) error {
+	if p.Signature == "" {
+		return errors.New("manifest provenance is not signed")
+	}
+	keyID, err := cryptoutil.KeyID(key)
+	if err != nil {
+		return err
+	}
+	if p.KeyID != keyID {
+		return fmt.Errorf("manifest provenance is signed with key %s, not %s", p.KeyID, keyID)
+	}
+	signature, err := base64.StdEncoding.DecodeString(p.Signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode manifest provenance signature: %w", err)
+	}
+	data, err := p.payload()
+	if err != nil {
+		return err
+	}
+	if err := cryptoutil.Verify(key, data, signature); err != nil {
+		return fmt.Errorf("failed to verify manifest provenance signature: %w", err)
+	}
+	if p.ManifestsDigest != manifestsDigest(p.ManifestDigests) {
+		return errors.New("manifest provenance digests do not match")
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package apiclient
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestProvenance_SignVerify(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	newProvenance := func(t *testing.T) *ManifestProvenance {
+		t.Helper()
+		provenance := &ManifestProvenance{RepoURL: "https://github.com/argoproj/argocd-example-apps", Revision: "abc123", Tools: map[string]string{"helm": "v3.17.0"}}
+		provenance.SetManifests([]string{`{"kind":"ConfigMap"}`, `{"kind":"Service"}`})
+		require.NoError(t, provenance.Sign(key))
+		return provenance
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		provenance := newProvenance(t)
+		assert.Len(t, provenance.ManifestDigests, 2)
+		assert.Equal(t, ManifestDigest(`{"kind":"ConfigMap"}`), provenance.ManifestDigests[0])
+		assert.NotEmpty(t, provenance.KeyID)
+		require.NoError(t, provenance.Verify(key.Public()))
+	})
+	t.Run("Unsigned", func(t *testing.T) {
+		provenance := newProvenance(t)
+		provenance.Signature = ""
+		require.EqualError(t, provenance.Verify(key.Public()), "manifest provenance is not signed")
+	})
+	t.Run("OtherKey", func(t *testing.T) {
+		require.ErrorContains(t, newProvenance(t).Verify(otherKey.Public()), "manifest provenance is signed with key")
+	})
+	t.Run("TamperedRevision", func(t *testing.T) {
+		provenance := newProvenance(t)
+		provenance.Revision = "def456"
+		require.ErrorContains(t, provenance.Verify(key.Public()), "failed to verify manifest provenance signature")
+	})
+	t.Run("TamperedDigests", func(t *testing.T) {
+		provenance := newProvenance(t)
+		provenance.ManifestDigests[1] = ManifestDigest(`{"kind":"Secret"}`)
+		require.ErrorContains(t, provenance.Verify(key.Public()), "failed to verify manifest provenance signature")
+	})
+}
@@ -1,5 +1,7 @@
 package apiclient
 
+import "fmt"
+
 func (q *ManifestRequest) GetValuesFileSchemes() []string {
 	if q.HelmOptions == nil {
 		return nil
@@ -13,3 +15,15 @@ func (q *RepoServerAppDetailsQuery) GetValuesFileSchemes() []string {
 	}
 	return q.HelmOptions.ValuesFileSchemes
 }
+
+// Describe returns a human-readable description of the violation
+func (v *ManifestPolicyViolation) Describe() string {
+	if v.Kind == "" {
+		return fmt.Sprintf("policy %s: %s", v.Policy, v.Message)
+	}
+	name := v.Name
+	if v.Namespace != "" {
+		name = v.Namespace + "/" + name
+	}
+	return fmt.Sprintf("policy %s: %s/%s %s: %s", v.Policy, v.Group, v.Kind, name, v.Message)
+}
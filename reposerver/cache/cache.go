@@ -29,6 +29,9 @@ var (
 	ErrCacheKeyLocked = cacheutil.ErrCacheKeyLocked
 )
 
+// manifestCacheKeyPrefix is the prefix of the keys of generated manifests
+const manifestCacheKeyPrefix = "mfst|"
+
 type Cache struct {
 	cache                    *cacheutil.Cache
 	repoCacheExpiration      time.Duration
@@ -81,6 +84,12 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...cacheutil.Options) func() (*
 	}
 }
 
+// SetDiskCache persists generated manifests in the given disk cache, in addition to the shared cache, so that they
+// survive a flush of the shared cache or a restart of the repo server
+func (c *Cache) SetDiskCache(diskCache *cacheutil.DiskCache, registry cacheutil.TierMetricsRegistry) {
+	c.cache.SetClient(cacheutil.NewPersistentClient(c.cache.GetClient(), diskCache, []string{manifestCacheKeyPrefix}, registry))
+}
+
 type refTargetForCacheKey struct {
 	RepoURL        string `json:"repoURL"`
 	Project        string `json:"project"`
@@ -332,7 +341,7 @@ func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs
 	//       when the _resolved_ revisions are already part of the key.
 
 	trackingKey := trackingKey(appLabelKey, trackingMethod)
-	key := fmt.Sprintf("mfst|%s|%s|%s|%s|%d", trackingKey, appName, revision, namespace, appSourceKey(appSrc, srcRefs, refSourceCommitSHAs)+clusterRuntimeInfoKey(info))
+	key := fmt.Sprintf("%s%s|%s|%s|%s|%d", manifestCacheKeyPrefix, trackingKey, appName, revision, namespace, appSourceKey(appSrc, srcRefs, refSourceCommitSHAs)+clusterRuntimeInfoKey(info))
 	if installationID != "" {
 		key = fmt.Sprintf("%s|%s", key, installationID)
 	}
@@ -8,6 +8,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 )
 
 type MetricsServer struct {
@@ -17,8 +19,11 @@ type MetricsServer struct {
 	gitRequestCounter             *prometheus.CounterVec
 	gitRequestHistogram           *prometheus.HistogramVec
 	repoPendingRequestsGauge      *prometheus.GaugeVec
+	manifestQueueDepthGauge       *prometheus.GaugeVec
+	manifestQueueHistogram        *prometheus.HistogramVec
 	redisRequestCounter           *prometheus.CounterVec
 	redisRequestHistogram         *prometheus.HistogramVec
+	cacheTierRequestCounter       *prometheus.CounterVec
 	ociExtractFailCounter         *prometheus.CounterVec
 	ociResolveRevisionFailCounter *prometheus.CounterVec
 	ociDigestMetadataCounter      *prometheus.CounterVec
@@ -88,6 +93,25 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(repoPendingRequestsGauge)
 
+	manifestQueueDepthGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_server_manifest_queue_depth",
+			Help: "Number of manifest generation requests waiting to be scheduled",
+		},
+		[]string{"repo", "project", "priority"},
+	)
+	registry.MustRegister(manifestQueueDepthGauge)
+
+	manifestQueueHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_repo_server_manifest_queue_duration_seconds",
+			Help:    "Duration manifest generation requests waited to be scheduled.",
+			Buckets: []float64{0.1, 0.25, .5, 1, 2, 4, 10, 20, 60, 120},
+		},
+		[]string{"priority"},
+	)
+	registry.MustRegister(manifestQueueHistogram)
+
 	redisRequestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_redis_request_total",
@@ -107,6 +131,15 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(redisRequestHistogram)
 
+	cacheTierRequestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_server_cache_request_total",
+			Help: "Number of requests for persisted cache entries by the cache tier which served them.",
+		},
+		[]string{"tier"},
+	)
+	registry.MustRegister(cacheTierRequestCounter)
+
 	ociExtractFailCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_oci_extract_fail_total",
@@ -178,8 +211,11 @@ func NewMetricsServer() *MetricsServer {
 		gitRequestCounter:             gitRequestCounter,
 		gitRequestHistogram:           gitRequestHistogram,
 		repoPendingRequestsGauge:      repoPendingRequestsGauge,
+		manifestQueueDepthGauge:       manifestQueueDepthGauge,
+		manifestQueueHistogram:        manifestQueueHistogram,
 		redisRequestCounter:           redisRequestCounter,
 		redisRequestHistogram:         redisRequestHistogram,
+		cacheTierRequestCounter:       cacheTierRequestCounter,
 		ociRequestCounter:             ociRequestCounter,
 		ociRequestHistogram:           ociRequestHistogram,
 		ociExtractFailCounter:         ociExtractFailCounter,
@@ -220,6 +256,21 @@ func (m *MetricsServer) DecPendingRepoRequest(repo string) {
 	m.repoPendingRequestsGauge.WithLabelValues(repo).Dec()
 }
 
+// IncManifestQueueDepth increments the number of queued manifest generation requests
+func (m *MetricsServer) IncManifestQueueDepth(repo string, project string, priority string) {
+	m.manifestQueueDepthGauge.WithLabelValues(repo, project, priority).Inc()
+}
+
+// DecManifestQueueDepth decrements the number of queued manifest generation requests
+func (m *MetricsServer) DecManifestQueueDepth(repo string, project string, priority string) {
+	m.manifestQueueDepthGauge.WithLabelValues(repo, project, priority).Dec()
+}
+
+// ObserveManifestQueueDuration observes the duration a manifest generation request waited to be scheduled
+func (m *MetricsServer) ObserveManifestQueueDuration(priority string, duration time.Duration) {
+	m.manifestQueueHistogram.WithLabelValues(priority).Observe(duration.Seconds())
+}
+
 func (m *MetricsServer) IncRedisRequest(failed bool) {
 	m.redisRequestCounter.WithLabelValues("argocd-repo-server", strconv.FormatBool(failed)).Inc()
 }
@@ -228,6 +279,11 @@ func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues("argocd-repo-server").Observe(duration.Seconds())
 }
 
+// IncCacheTierRequest increments the counter of requests served by the given cache tier
+func (m *MetricsServer) IncCacheTierRequest(tier cacheutil.CacheTier) {
+	m.cacheTierRequestCounter.WithLabelValues(string(tier)).Inc()
+}
+
 // IncOCIRequest increments the OCI requests counter
 func (m *MetricsServer) IncOCIRequest(repo string, requestType OCIRequestType) {
 	m.ociRequestCounter.WithLabelValues(repo, string(requestType)).Inc()
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/cue"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+)
+
+// cueExport exports the Kubernetes objects of the CUE package at the given path, unified with the values files and
+// values of the application
+func cueExport(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, gitRepoPaths utilio.TempPaths) ([]*unstructured.Unstructured, string, error) {
+	exportOpts := &cue.ExportOpts{
+		Tags: map[string]string{},
+	}
+	if appCue := q.ApplicationSource.Cue; appCue != nil {
+		exportOpts.Package = env.Envsubst(appCue.Package)
+		exportOpts.Expression = appCue.Expression
+		for _, tag := range appCue.Tags {
+			exportOpts.Tags[tag.Name] = env.Envsubst(tag.Value)
+		}
+		var err error
+		// URLs are not supported, since cue only reads local files
+		exportOpts.Files, err = getResolvedValueFiles(appPath, repoRoot, env, []string{}, appCue.ValuesFiles, q.RefSources, gitRepoPaths, false)
+		if err != nil {
+			return nil, "", fmt.Errorf("error resolving cue values files: %w", err)
+		}
+		if appCue.Values != "" {
+			// cue reads the values as YAML, which is a superset of JSON
+			var values any
+			if err := yaml.Unmarshal([]byte(appCue.Values), &values); err != nil {
+				return nil, "", fmt.Errorf("error parsing cue values: %w", err)
+			}
+			file, err := os.CreateTemp("", "cue-values-*.yaml")
+			if err != nil {
+				return nil, "", fmt.Errorf("error creating temp file for cue values: %w", err)
+			}
+			defer func() { _ = os.RemoveAll(file.Name()) }()
+			_, err = file.WriteString(appCue.Values)
+			utilio.Close(file)
+			if err != nil {
+				return nil, "", fmt.Errorf("error writing cue values to temp file: %w", err)
+			}
+			exportOpts.Files = append(exportOpts.Files, pathutil.ResolvedFilePath(file.Name()))
+		}
+	}
+
+	c := cue.NewCueApp(appPath, env.Environ())
+	objs, command, err := c.Export(exportOpts)
+	return objs, redactPaths(command, gitRepoPaths, ""), err
+}
+
+// populateCueAppDetails lists the tags of the CUE package of the application, so that they can be set as parameters
+func populateCueAppDetails(res *apiclient.RepoAppDetailsResponse, appPath string, q *apiclient.RepoServerAppDetailsQuery) error {
+	dir := appPath
+	if q.Source.Cue != nil {
+		var err error
+		dir, err = cue.PackageDir(appPath, q.Source.Cue.Package)
+		if err != nil {
+			return err
+		}
+	}
+	tags, err := cue.GetTags(dir)
+	if err != nil {
+		return fmt.Errorf("failed to get cue tags: %w", err)
+	}
+	res.Cue = &apiclient.CueAppSpec{}
+	for _, tag := range tags {
+		res.Cue.Tags = append(res.Cue.Tags, &apiclient.CueTagAnnouncement{
+			Name:    tag.Name,
+			Type:    tag.Type,
+			Options: tag.Options,
+			Default: tag.Default,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func TestPopulateCueAppDetails(t *testing.T) {
+	appPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(appPath, "prod"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "prod", "guestbook.cue"), []byte(`package guestbook
+
+env:      *"prod" | string @tag(env,short=staging|prod)
+replicas: *3 | int         @tag(replicas,type=int)
+`), 0o644))
+
+	res := &apiclient.RepoAppDetailsResponse{}
+	err := populateCueAppDetails(res, appPath, &apiclient.RepoServerAppDetailsQuery{
+		Source: &v1alpha1.ApplicationSource{Cue: &v1alpha1.ApplicationSourceCue{Package: "./prod:guestbook"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*apiclient.CueTagAnnouncement{
+		{Name: "env", Type: "string", Options: []string{"staging", "prod"}, Default: "prod"},
+		{Name: "replicas", Type: "int", Default: "3"},
+	}, res.Cue.Tags)
+
+	err = populateCueAppDetails(res, appPath, &apiclient.RepoServerAppDetailsQuery{
+		Source: &v1alpha1.ApplicationSource{Cue: &v1alpha1.ApplicationSourceCue{Package: "../other"}},
+	})
+	require.ErrorContains(t, err, "must be a relative path within the application path")
+}
+
+func TestCueExport_InvalidValues(t *testing.T) {
+	appPath := t.TempDir()
+	_, _, err := cueExport(appPath, appPath, &v1alpha1.Env{}, &apiclient.ManifestRequest{
+		ApplicationSource: &v1alpha1.ApplicationSource{Cue: &v1alpha1.ApplicationSourceCue{Values: "replicas: [3"}},
+	}, nil)
+	require.ErrorContains(t, err, "error parsing cue values")
+}
+
+func TestCueExport_RemoteValuesFile(t *testing.T) {
+	appPath := t.TempDir()
+	_, _, err := cueExport(appPath, appPath, &v1alpha1.Env{}, &apiclient.ManifestRequest{
+		ApplicationSource: &v1alpha1.ApplicationSource{Cue: &v1alpha1.ApplicationSourceCue{ValuesFiles: []string{"https://example.com/values.yaml"}}},
+	}, nil)
+	require.ErrorContains(t, err, "error resolving cue values files")
+}
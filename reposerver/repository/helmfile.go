@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/argo-cd/gitops-engine/pkg/utils/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	"github.com/argoproj/argo-cd/v3/util/helmfile"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// helmfileTemplate renders the releases of the helmfile state at the given path. The charts are pulled from the Helm
+// repositories declared by the state with the credentials of the matching Argo CD repositories.
+func helmfileTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, gitRepoPaths utilio.TempPaths) ([]*unstructured.Unstructured, string, error) {
+	kubeVersion, err := parseKubeVersion(q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion))
+	if err != nil {
+		return nil, "", fmt.Errorf("could not parse kubernetes version %s: %w", q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion), err)
+	}
+
+	templateOpts := &helmfile.TemplateOpts{
+		Namespace:   q.ApplicationSource.GetNamespaceOrDefault(q.Namespace),
+		KubeVersion: kubeVersion,
+		APIVersions: q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
+		StateValues: map[string]string{},
+	}
+	if appHelmfile := q.ApplicationSource.Helmfile; appHelmfile != nil {
+		templateOpts.Environment = env.Envsubst(appHelmfile.Environment)
+		for _, selector := range appHelmfile.Selectors {
+			templateOpts.Selectors = append(templateOpts.Selectors, env.Envsubst(selector))
+		}
+		templateOpts.StateValuesFiles, err = getResolvedValueFiles(appPath, repoRoot, env, q.GetValuesFileSchemes(), appHelmfile.StateValuesFiles, q.RefSources, gitRepoPaths, false)
+		if err != nil {
+			return nil, "", fmt.Errorf("error resolving helmfile state values files: %w", err)
+		}
+		for name, value := range appHelmfile.StateValues {
+			templateOpts.StateValues[name] = env.Envsubst(value)
+		}
+		templateOpts.SkipTests = appHelmfile.SkipTests
+	}
+
+	var proxy, noProxy string
+	if q.Repo != nil {
+		proxy = q.Repo.Proxy
+		noProxy = q.Repo.NoProxy
+	}
+	h, err := helmfile.NewHelmfileApp(appPath, env.Environ(), proxy, noProxy)
+	if err != nil {
+		return nil, "", fmt.Errorf("error initializing helmfile app object: %w", err)
+	}
+	defer h.Dispose()
+
+	// helmfile builds the dependencies of local charts within the application directory
+	manifestGenerateLock.Lock(appPath)
+	defer manifestGenerateLock.Unlock(appPath)
+
+	declaredRepos, err := h.Repositories(templateOpts)
+	if err != nil {
+		return nil, "", err
+	}
+	helmRepos := getHelmfileRepos(declaredRepos, q.Repos, q.HelmRepoCreds)
+
+	out, command, err := h.Template(templateOpts, helmRepos)
+	if err != nil {
+		var reposNotPermitted []string
+		// We do a sanity check here to give a nicer error message in case any of the Helm repositories are not permitted by
+		// the AppProject which the application is a part of
+		for _, repo := range helmRepos {
+			if !isSourcePermitted(repo.Repo, q.ProjectSourceRepos) {
+				reposNotPermitted = append(reposNotPermitted, repo.Repo)
+			}
+		}
+		if len(reposNotPermitted) > 0 {
+			return nil, "", status.Errorf(codes.PermissionDenied, "helm repos %s are not permitted in project '%s'", strings.Join(reposNotPermitted, ", "), q.ProjectName)
+		}
+		return nil, "", err
+	}
+	objs, err := kube.SplitYAML([]byte(out))
+
+	redactedCommand := redactPaths(command, gitRepoPaths, "")
+
+	return objs, redactedCommand, err
+}
+
+// getHelmfileRepos returns the Helm repositories declared by a helmfile state with the credentials of the matching Argo
+// CD repositories or repository credentials, matched by URL only. The repositories keep the name they are declared
+// with, which helmfile reads their credentials by.
+func getHelmfileRepos(declaredRepos []helmfile.Repository, repositories []*v1alpha1.Repository, helmRepoCreds []*v1alpha1.RepoCreds) []helm.HelmRepository {
+	dependencies := make([]*v1alpha1.Repository, 0, len(declaredRepos))
+	for _, repo := range declaredRepos {
+		dependencies = append(dependencies, &v1alpha1.Repository{
+			// trimming oci:// prefix since it is currently not supported by Argo CD (OCI repos just have no scheme)
+			Repo:      strings.TrimPrefix(repo.URL, ociPrefix),
+			EnableOCI: repo.OCI || strings.HasPrefix(repo.URL, ociPrefix),
+		})
+	}
+	helmRepos := resolveHelmRepos(dependencies, repositories, helmRepoCreds)
+	for i := range helmRepos {
+		helmRepos[i].Name = declaredRepos[i].Name
+	}
+	return helmRepos
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/helmfile"
+)
+
+func TestGetHelmfileRepos(t *testing.T) {
+	repos := getHelmfileRepos([]helmfile.Repository{
+		{Name: "bitnami", URL: "https://charts.bitnami.com/bitnami"},
+		{Name: "private", URL: "https://charts.example.com/private"},
+		{Name: "registry", URL: "registry.example.com/charts", OCI: true},
+		{Name: "stable", URL: "https://charts.helm.sh/stable"},
+	}, []*v1alpha1.Repository{
+		{Name: "bitnami-charts", Repo: "https://charts.bitnami.com/bitnami", Username: "bitnami-user", Password: "bitnami-password"},
+		{Name: "registry", Repo: "registry.example.com/charts", EnableOCI: true, Username: "registry-user", Password: "registry-password"},
+		// a repository is never matched by the name it is declared with in the helmfile state
+		{Name: "stable", Repo: "https://charts.example.com/stable", Username: "stable-user", Password: "stable-password"},
+	}, []*v1alpha1.RepoCreds{
+		{URL: "https://charts.example.com", Username: "creds-user", Password: "creds-password"},
+	})
+
+	type repoCreds struct {
+		name      string
+		repo      string
+		username  string
+		password  string
+		enableOci bool
+	}
+	var actual []repoCreds
+	for _, repo := range repos {
+		password, err := repo.GetPassword()
+		require.NoError(t, err)
+		actual = append(actual, repoCreds{name: repo.Name, repo: repo.Repo, username: repo.GetUsername(), password: password, enableOci: repo.EnableOci})
+	}
+	assert.Equal(t, []repoCreds{
+		{name: "bitnami", repo: "https://charts.bitnami.com/bitnami", username: "bitnami-user", password: "bitnami-password"},
+		{name: "private", repo: "https://charts.example.com/private", username: "creds-user", password: "creds-password"},
+		{name: "registry", repo: "registry.example.com/charts", username: "registry-user", password: "registry-password", enableOci: true},
+		{name: "stable", repo: "https://charts.helm.sh/stable"},
+	}, actual)
+}
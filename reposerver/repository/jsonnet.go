@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	gosync "sync"
+	"time"
+
+	"github.com/argoproj/pkg/v2/sync"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
+	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/jsonnet"
+)
+
+// jsonnetVendorCacheDirName is the directory of the root directory of the repo-server the vendored jsonnet-bundler
+// dependencies are cached in
+const jsonnetVendorCacheDirName = ".jsonnet-vendor"
+
+// jsonnetVendorCacheTTL is the duration after which the vendored dependencies of a lock file which have not been used
+// are removed from the cache
+const jsonnetVendorCacheTTL = 24 * time.Hour
+
+var jsonnetVendorLock = sync.NewKeyLock()
+
+// jsonnetVendorCache tracks the last use of the vendored dependencies of each lock file hash in the cache directory, so
+// that those which are not used anymore can be removed. The cache directory is cleared when the repo-server starts, so
+// all of its entries are tracked.
+type jsonnetVendorCache struct {
+	lock     gosync.Mutex
+	lastUsed map[string]time.Time
+}
+
+func newJsonnetVendorCache() *jsonnetVendorCache {
+	return &jsonnetVendorCache{lastUsed: map[string]time.Time{}}
+}
+
+// use records the use of the vendored dependencies of the given lock file hash
+func (c *jsonnetVendorCache) use(hash string, now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.lastUsed[hash] = now
+}
+
+// expired returns the lock file hashes whose vendored dependencies have not been used since the given time
+func (c *jsonnetVendorCache) expired(before time.Time) []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var hashes []string
+	for hash, lastUsed := range c.lastUsed {
+		if lastUsed.Before(before) {
+			hashes = append(hashes, hash)
+		}
+	}
+	return hashes
+}
+
+// remove forgets the given lock file hash if its vendored dependencies have still not been used since the given time,
+// and returns whether it did
+func (c *jsonnetVendorCache) remove(hash string, before time.Time) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if lastUsed, ok := c.lastUsed[hash]; !ok || !lastUsed.Before(before) {
+		return false
+	}
+	delete(c.lastUsed, hash)
+	return true
+}
+
+// vendorJsonnetDependencies vendors the jsonnet-bundler dependencies pinned by the lock file of the application at the
+// given path and returns the vendor directory, or an empty path if the application has no lock file or commits its
+// vendor directory. The dependencies are fetched from the given Git repositories, using their credentials, into a
+// cache directory keyed by the hash of the lock file, so they are only fetched once for each version of the lock file.
+// Since the cache is shared by all projects, the repositories of the dependencies must be permitted even when they
+// are cached.
+func (s *Service) vendorJsonnetDependencies(appPath string, repos []*v1alpha1.Repository) (string, error) {
+	lockFile, hash, err := jsonnet.ReadLockFile(appPath)
+	if err != nil || lockFile == nil {
+		return "", err
+	}
+	if _, err := os.Stat(filepath.Join(appPath, jsonnet.VendorDirName)); err == nil {
+		return "", nil
+	}
+
+	depRepos := make([]*v1alpha1.Repository, len(lockFile.Dependencies))
+	for i, dep := range lockFile.Dependencies {
+		if dep.Source.Local != nil {
+			return "", fmt.Errorf("local jsonnet-bundler dependency %s is not supported: use the libs of the jsonnet options of the application instead", dep.Source.Local.Directory)
+		}
+		depRepos[i] = getJsonnetDependencyRepo(dep, repos)
+		if depRepos[i] == nil {
+			return "", fmt.Errorf("jsonnet-bundler dependency %s is not a Git repository registered in Argo CD and permitted by the project", dep.Source.Git.Remote)
+		}
+	}
+
+	defer s.pruneJsonnetVendorCache()
+	jsonnetVendorLock.Lock(hash)
+	defer jsonnetVendorLock.Unlock(hash)
+
+	cacheDir := filepath.Join(s.rootDir, jsonnetVendorCacheDirName)
+	vendorDir := filepath.Join(cacheDir, hash)
+	if _, err := os.Stat(vendorDir); err == nil {
+		s.jsonnetVendorCache.use(hash, s.now())
+		return vendorDir, nil
+	}
+	// like the root directory, the cache directory cannot be listed
+	if err := os.MkdirAll(cacheDir, 0o300); err != nil {
+		return "", fmt.Errorf("failed to create jsonnet vendor cache directory: %w", err)
+	}
+	tempDir, err := os.MkdirTemp(cacheDir, hash+"-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary jsonnet vendor directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			log.Warnf("Failed to remove temporary jsonnet vendor directory %s: %v", tempDir, err)
+		}
+	}()
+
+	tempVendorDir := filepath.Join(tempDir, jsonnet.VendorDirName)
+	for i, dep := range lockFile.Dependencies {
+		err := s.vendorJsonnetDependency(dep, tempVendorDir, filepath.Join(tempDir, strconv.Itoa(i)), depRepos[i], lockFile.IsLegacyImportsEnabled())
+		if err != nil {
+			return "", err
+		}
+	}
+	if err := os.MkdirAll(tempVendorDir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create jsonnet vendor directory: %w", err)
+	}
+	if err := os.Rename(tempVendorDir, vendorDir); err != nil {
+		return "", fmt.Errorf("failed to move jsonnet vendor directory: %w", err)
+	}
+	s.jsonnetVendorCache.use(hash, s.now())
+	return vendorDir, nil
+}
+
+// pruneJsonnetVendorCache removes the vendored dependencies which have not been used for the cache TTL
+func (s *Service) pruneJsonnetVendorCache() {
+	before := s.now().Add(-jsonnetVendorCacheTTL)
+	for _, hash := range s.jsonnetVendorCache.expired(before) {
+		// the lock of the hash prevents the removal of vendored dependencies which are being used
+		jsonnetVendorLock.Lock(hash)
+		if s.jsonnetVendorCache.remove(hash, before) {
+			if err := os.RemoveAll(filepath.Join(s.rootDir, jsonnetVendorCacheDirName, hash)); err != nil {
+				log.Warnf("Failed to remove jsonnet vendor directory %s: %v", hash, err)
+			}
+		}
+		jsonnetVendorLock.Unlock(hash)
+	}
+}
+
+// removeJsonnetVendorCache removes the given cache directory, which cannot be listed
+func removeJsonnetVendorCache(cacheDir string) error {
+	if err := os.Chmod(cacheDir, 0o700); err != nil {
+		return err
+	}
+	return os.RemoveAll(cacheDir)
+}
+
+// getJsonnetDependencyRepo returns the repository of the given Git dependency among the given repositories, or nil if
+// it is not one of them
+func getJsonnetDependencyRepo(dep jsonnet.Dependency, repos []*v1alpha1.Repository) *v1alpha1.Repository {
+	for _, r := range repos {
+		if git.SameURL(r.Repo, dep.Source.Git.Remote) {
+			return r
+		}
+	}
+	return nil
+}
+
+// vendorJsonnetDependency checks out the given dependency from its repository into the clone directory, and moves it
+// into the vendor directory
+func (s *Service) vendorJsonnetDependency(dep jsonnet.Dependency, vendorDir string, cloneDir string, repo *v1alpha1.Repository, legacyImports bool) error {
+	gitClient, err := s.newGitClient(repo.Repo, cloneDir, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy,
+		git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)),
+		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig))
+	if err != nil {
+		return fmt.Errorf("failed to create git client for jsonnet-bundler dependency %s: %w", dep.Source.Git.Remote, err)
+	}
+	if err := checkoutRevision(gitClient, dep.Version, false, 0); err != nil {
+		return fmt.Errorf("failed to checkout version %s of jsonnet-bundler dependency %s: %w", dep.Version, dep.Source.Git.Remote, err)
+	}
+	if !s.initConstants.AllowOutOfBoundsSymlinks {
+		if err := apppathutil.CheckOutOfBoundsSymlinks(cloneDir); err != nil {
+			oobError := &apppathutil.OutOfBoundsSymlinkError{}
+			if errors.As(err, &oobError) {
+				log.WithFields(log.Fields{
+					common.SecurityField: common.SecurityHigh,
+					"repo":               repo.Repo,
+					"revision":           dep.Version,
+					"file":               oobError.File,
+				}).Warn("jsonnet-bundler dependency contains out-of-bounds symlink")
+				return fmt.Errorf("jsonnet-bundler dependency %s contains out-of-bounds symlinks. file: %s", dep.Source.Git.Remote, oobError.File)
+			}
+			return err
+		}
+	}
+	if err := os.RemoveAll(filepath.Join(cloneDir, ".git")); err != nil {
+		return fmt.Errorf("failed to remove git directory of jsonnet-bundler dependency %s: %w", dep.Source.Git.Remote, err)
+	}
+
+	srcDir := filepath.Join(cloneDir, filepath.FromSlash(dep.Source.Git.Subdir))
+	if info, err := os.Lstat(srcDir); err != nil || !info.IsDir() {
+		return fmt.Errorf("jsonnet-bundler dependency %s has no directory %q at version %s", dep.Source.Git.Remote, dep.Source.Git.Subdir, dep.Version)
+	}
+	importPath, err := dep.ImportPath()
+	if err != nil {
+		return err
+	}
+	dstDir := filepath.Join(vendorDir, filepath.FromSlash(importPath))
+	if err := os.MkdirAll(filepath.Dir(dstDir), 0o700); err != nil {
+		return fmt.Errorf("failed to create jsonnet vendor directory: %w", err)
+	}
+	if err := os.Rename(srcDir, dstDir); err != nil {
+		return fmt.Errorf("failed to vendor jsonnet-bundler dependency %s: %w", dep.Source.Git.Remote, err)
+	}
+	if !legacyImports {
+		return nil
+	}
+
+	legacyImportPath, err := dep.LegacyImportPath()
+	if err != nil {
+		return err
+	}
+	legacyDir := filepath.Join(vendorDir, filepath.FromSlash(legacyImportPath))
+	if legacyDir == dstDir {
+		return nil
+	}
+	if _, err := os.Lstat(legacyDir); err == nil {
+		log.Warnf("Skipping legacy import path %s of jsonnet-bundler dependency %s: path already exists", legacyImportPath, dep.Source.Git.Remote)
+		return nil
+	}
+	target, err := filepath.Rel(filepath.Dir(legacyDir), dstDir)
+	if err != nil {
+		return fmt.Errorf("failed to get legacy import path of jsonnet-bundler dependency %s: %w", dep.Source.Git.Remote, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(legacyDir), 0o700); err != nil {
+		return fmt.Errorf("failed to create jsonnet vendor directory: %w", err)
+	}
+	if err := os.Symlink(target, legacyDir); err != nil {
+		return fmt.Errorf("failed to link legacy import path of jsonnet-bundler dependency %s: %w", dep.Source.Git.Remote, err)
+	}
+	return nil
+}
+
+// getJsonnetDependencies returns the jsonnet-bundler dependencies pinned by the lock file of the application at the
+// given path
+func getJsonnetDependencies(appPath string) ([]*apiclient.JsonnetDependency, error) {
+	lockFile, _, err := jsonnet.ReadLockFile(appPath)
+	if err != nil || lockFile == nil {
+		return nil, err
+	}
+	dependencies := make([]*apiclient.JsonnetDependency, 0, len(lockFile.Dependencies))
+	for _, dep := range lockFile.Dependencies {
+		importPath, err := dep.ImportPath()
+		if err != nil {
+			return nil, err
+		}
+		dependency := &apiclient.JsonnetDependency{ImportPath: importPath, Version: dep.Version}
+		if dep.Source.Git != nil {
+			dependency.Remote = dep.Source.Git.Remote
+			dependency.Subdir = dep.Source.Git.Subdir
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies, nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// newJsonnetDependencyRepo creates a Git repository with a jsonnet library in the lib directory, and returns the URL and
+// the revision of the repository
+func newJsonnetDependencyRepo(t *testing.T) (string, string) {
+	t.Helper()
+	repoPath := t.TempDir()
+	runGit(t, repoPath, "init")
+	require.NoError(t, os.MkdirAll(filepath.Join(repoPath, "lib"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "lib", "configmap.libsonnet"), []byte(`{
+  configMap(name):: { apiVersion: 'v1', kind: 'ConfigMap', metadata: { name: name } },
+}
+`), 0o644))
+	runGit(t, repoPath, "add", ".")
+	runGit(t, repoPath, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-m", "lib")
+	revision := strings.TrimSpace(runGit(t, repoPath, "rev-parse", "HEAD"))
+	return "file://" + repoPath, revision
+}
+
+func newJsonnetApp(t *testing.T, remote string, revision string) string {
+	t.Helper()
+	appPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "jsonnetfile.lock.json"), fmt.Appendf(nil, `{
+  "version": 1,
+  "dependencies": [
+    {
+      "source": {"git": {"remote": %q, "subdir": "lib"}},
+      "version": %q
+    }
+  ],
+  "legacyImports": true
+}
+`, remote, revision), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "main.jsonnet"), []byte(`local lib = import 'lib/configmap.libsonnet';
+lib.configMap('my-config')
+`), 0o644))
+	return appPath
+}
+
+func newJsonnetVendorService(t *testing.T) *Service {
+	t.Helper()
+	cacheMocks := newCacheMocks()
+	t.Cleanup(cacheMocks.mockCache.StopRedisCallback)
+	return NewService(metrics.NewMetricsServer(), cacheMocks.cache, RepoServerInitConstants{ParallelismLimit: 1}, &git.NoopCredsStore{}, t.TempDir())
+}
+
+func TestVendorJsonnetDependencies(t *testing.T) {
+	remote, revision := newJsonnetDependencyRepo(t)
+
+	t.Run("GenerateManifests", func(t *testing.T) {
+		service := newJsonnetVendorService(t)
+		appPath := newJsonnetApp(t, remote, revision)
+		q := &apiclient.ManifestRequest{
+			Repo:              &v1alpha1.Repository{},
+			ApplicationSource: &v1alpha1.ApplicationSource{Directory: &v1alpha1.ApplicationSourceDirectory{}},
+			GitRepos:          []*v1alpha1.Repository{{Repo: remote}},
+		}
+
+		res, err := GenerateManifests(t.Context(), appPath, appPath, revision, q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil, WithJsonnetVendor(service.vendorJsonnetDependencies))
+		require.NoError(t, err)
+		require.Len(t, res.Manifests, 1)
+		assert.Contains(t, res.Manifests[0], `"name":"my-config"`)
+	})
+
+	t.Run("CachedByLockFile", func(t *testing.T) {
+		service := newJsonnetVendorService(t)
+		appPath := newJsonnetApp(t, remote, revision)
+		repos := []*v1alpha1.Repository{{Repo: remote}}
+
+		vendorDir, err := service.vendorJsonnetDependencies(appPath, repos)
+		require.NoError(t, err)
+		assert.FileExists(t, filepath.Join(vendorDir, "lib", "configmap.libsonnet"))
+
+		// the dependencies are not fetched again for the same lock file
+		otherAppPath := newJsonnetApp(t, remote, revision)
+		cachedVendorDir, err := service.vendorJsonnetDependencies(otherAppPath, repos)
+		require.NoError(t, err)
+		assert.Equal(t, vendorDir, cachedVendorDir)
+
+		// but the project of the application must still be permitted to use the repositories of the dependencies
+		_, err = service.vendorJsonnetDependencies(otherAppPath, nil)
+		require.ErrorContains(t, err, "is not a Git repository registered in Argo CD")
+	})
+
+	t.Run("PrunedAfterTTL", func(t *testing.T) {
+		service := newJsonnetVendorService(t)
+		now := time.Now()
+		service.now = func() time.Time { return now }
+		repos := []*v1alpha1.Repository{{Repo: remote}}
+
+		vendorDir, err := service.vendorJsonnetDependencies(newJsonnetApp(t, remote, revision), repos)
+		require.NoError(t, err)
+		assert.DirExists(t, vendorDir)
+
+		now = now.Add(jsonnetVendorCacheTTL - time.Minute)
+		service.pruneJsonnetVendorCache()
+		assert.DirExists(t, vendorDir)
+
+		now = now.Add(2 * time.Minute)
+		service.pruneJsonnetVendorCache()
+		assert.NoDirExists(t, vendorDir)
+	})
+
+	t.Run("ClearedOnInit", func(t *testing.T) {
+		service := newJsonnetVendorService(t)
+		t.Cleanup(func() { _ = os.Chmod(service.rootDir, 0o700) })
+
+		vendorDir, err := service.vendorJsonnetDependencies(newJsonnetApp(t, remote, revision), []*v1alpha1.Repository{{Repo: remote}})
+		require.NoError(t, err)
+		require.NoError(t, service.Init())
+		assert.NoDirExists(t, vendorDir)
+	})
+
+	t.Run("UnregisteredRepository", func(t *testing.T) {
+		service := newJsonnetVendorService(t)
+		appPath := newJsonnetApp(t, remote, revision)
+
+		_, err := service.vendorJsonnetDependencies(appPath, []*v1alpha1.Repository{{Repo: "https://github.com/argoproj/argocd-example-apps"}})
+		require.ErrorContains(t, err, "is not a Git repository registered in Argo CD")
+	})
+
+	t.Run("CommittedVendorDirectory", func(t *testing.T) {
+		service := newJsonnetVendorService(t)
+		appPath := newJsonnetApp(t, remote, revision)
+		require.NoError(t, os.Mkdir(filepath.Join(appPath, "vendor"), 0o755))
+
+		vendorDir, err := service.vendorJsonnetDependencies(appPath, nil)
+		require.NoError(t, err)
+		assert.Empty(t, vendorDir)
+	})
+
+	t.Run("NoLockFile", func(t *testing.T) {
+		service := newJsonnetVendorService(t)
+
+		vendorDir, err := service.vendorJsonnetDependencies(t.TempDir(), nil)
+		require.NoError(t, err)
+		assert.Empty(t, vendorDir)
+	})
+}
+
+func TestGetJsonnetDependencies(t *testing.T) {
+	appPath := newJsonnetApp(t, "https://github.com/grafana/grafonnet-lib.git", "3626fc4dc2326931c530861ac5bebe39444f6cbf")
+
+	dependencies, err := getJsonnetDependencies(appPath)
+	require.NoError(t, err)
+	assert.Equal(t, []*apiclient.JsonnetDependency{{
+		ImportPath: "github.com/grafana/grafonnet-lib/lib",
+		Remote:     "https://github.com/grafana/grafonnet-lib.git",
+		Subdir:     "lib",
+		Version:    "3626fc4dc2326931c530861ac5bebe39444f6cbf",
+	}}, dependencies)
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/manifestpolicy"
+)
+
+// validateManifestPolicies validates the generated manifests against the manifest policies of the project. The
+// violations of the policies with the warn action are returned in the response, while the violations of the policies
+// with the deny action fail the request. A policy which cannot be compiled or evaluated is violated by all manifests.
+func validateManifestPolicies(res *apiclient.ManifestResponse, policies []*apiclient.ManifestPolicy) error {
+	if res == nil || len(policies) == 0 {
+		return nil
+	}
+	objs := make([]*unstructured.Unstructured, 0, len(res.Manifests))
+	for _, manifest := range res.Manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		objs = append(objs, obj)
+	}
+
+	res.PolicyViolations = nil
+	var denied []*apiclient.ManifestPolicyViolation
+	for _, policy := range policies {
+		violations := getManifestPolicyViolations(policy, objs)
+		if policy.Action == v1alpha1.ManifestPolicyActionDeny {
+			denied = append(denied, violations...)
+		} else {
+			res.PolicyViolations = append(res.PolicyViolations, violations...)
+		}
+	}
+	if len(denied) > 0 {
+		messages := make([]string, 0, len(denied))
+		for _, violation := range denied {
+			messages = append(messages, violation.Describe())
+		}
+		return status.Errorf(codes.FailedPrecondition, "manifests violate project policies: %s", strings.Join(messages, "; "))
+	}
+	return nil
+}
+
+// getManifestPolicyViolations returns the violations of the policies of the given ConfigMap by the given manifests
+func getManifestPolicyViolations(policy *apiclient.ManifestPolicy, objs []*unstructured.Unstructured) []*apiclient.ManifestPolicyViolation {
+	var violations []*apiclient.ManifestPolicyViolation
+	names := make([]string, 0, len(policy.Policies))
+	for name := range policy.Policies {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		policyName := policy.Name + "/" + name
+		prg, err := manifestpolicy.Compile(policy.Policies[name])
+		if err != nil {
+			violations = append(violations, &apiclient.ManifestPolicyViolation{Policy: policyName, Message: err.Error()})
+			continue
+		}
+		for _, obj := range objs {
+			messages, err := prg.Validate(obj)
+			if err != nil {
+				messages = []string{err.Error()}
+			}
+			gvk := obj.GroupVersionKind()
+			for _, message := range messages {
+				violations = append(violations, &apiclient.ManifestPolicyViolation{
+					Policy:    policyName,
+					Group:     gvk.Group,
+					Kind:      gvk.Kind,
+					Namespace: obj.GetNamespace(),
+					Name:      obj.GetName(),
+					Message:   message,
+				})
+			}
+		}
+	}
+	return violations
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+const (
+	replicasPolicy = `
+matchResources:
+- apiGroups: [apps]
+  kinds: [Deployment]
+validations:
+- expression: "object.spec.replicas <= 5"
+  message: "replicas must be at most 5"
+`
+	hostNetworkPolicy = `
+validations:
+- expression: "!has(object.spec.hostNetwork) || !object.spec.hostNetwork"
+  message: "host network is not allowed"
+`
+)
+
+func newPolicyManifestResponse() *apiclient.ManifestResponse {
+	return &apiclient.ManifestResponse{Manifests: []string{
+		`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"my-app","namespace":"default"},"spec":{"replicas":10}}`,
+		`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"my-pod","namespace":"default"},"spec":{"hostNetwork":true}}`,
+	}}
+}
+
+func Test_validateManifestPolicies(t *testing.T) {
+	t.Run("Warn", func(t *testing.T) {
+		res := newPolicyManifestResponse()
+		err := validateManifestPolicies(res, []*apiclient.ManifestPolicy{{
+			Name:     "policies",
+			Action:   v1alpha1.ManifestPolicyActionWarn,
+			Policies: map[string]string{"replicas": replicasPolicy, "host-network": hostNetworkPolicy},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, []*apiclient.ManifestPolicyViolation{
+			{Policy: "policies/host-network", Kind: "Pod", Namespace: "default", Name: "my-pod", Message: "host network is not allowed"},
+			{Policy: "policies/replicas", Group: "apps", Kind: "Deployment", Namespace: "default", Name: "my-app", Message: "replicas must be at most 5"},
+		}, res.PolicyViolations)
+	})
+
+	t.Run("Deny", func(t *testing.T) {
+		res := newPolicyManifestResponse()
+		err := validateManifestPolicies(res, []*apiclient.ManifestPolicy{
+			{Name: "warnings", Action: v1alpha1.ManifestPolicyActionWarn, Policies: map[string]string{"host-network": hostNetworkPolicy}},
+			{Name: "restrictions", Action: v1alpha1.ManifestPolicyActionDeny, Policies: map[string]string{"replicas": replicasPolicy}},
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = manifests violate project policies: policy restrictions/replicas: apps/Deployment default/my-app: replicas must be at most 5")
+	})
+
+	t.Run("InvalidPolicy", func(t *testing.T) {
+		res := newPolicyManifestResponse()
+		err := validateManifestPolicies(res, []*apiclient.ManifestPolicy{{
+			Name:     "policies",
+			Action:   v1alpha1.ManifestPolicyActionWarn,
+			Policies: map[string]string{"broken": "validations: [{expression: 'object.'}]"},
+		}})
+		require.NoError(t, err)
+		require.Len(t, res.PolicyViolations, 1)
+		assert.Equal(t, "policies/broken", res.PolicyViolations[0].Policy)
+		assert.Empty(t, res.PolicyViolations[0].Kind)
+	})
+
+	t.Run("Compliant", func(t *testing.T) {
+		res := &apiclient.ManifestResponse{Manifests: []string{`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"my-app"},"spec":{"replicas":1}}`}}
+		err := validateManifestPolicies(res, []*apiclient.ManifestPolicy{
+			{Name: "restrictions", Action: v1alpha1.ManifestPolicyActionDeny, Policies: map[string]string{"replicas": replicasPolicy, "host-network": hostNetworkPolicy}},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.PolicyViolations)
+	})
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/kustomize"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// helmPostRender post-renders the given manifests rendered by Helm for the application at the given path with the
+// post-renderer of its source, and returns the post-rendered manifests along with the commands which were run
+func helmPostRender(ctx context.Context, objs []*unstructured.Unstructured, appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, gitCredsStore git.CredsStore, gitRepoPaths utilio.TempPaths, opt *generateManifestOpt) ([]*unstructured.Unstructured, []string, error) {
+	postRenderer := q.ApplicationSource.Helm.PostRenderer
+	if (postRenderer.Plugin == "") == (postRenderer.KustomizePath == "") {
+		return nil, nil, errors.New("helm post-renderer must set exactly one of plugin or kustomizePath")
+	}
+	manifests, err := marshalManifests(objs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal manifests rendered by helm: %w", err)
+	}
+
+	if postRenderer.Plugin != "" {
+		cleanup, err := writeHelmPostRendererManifests(appPath, manifests)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer cleanup()
+		objs, err := runConfigManagementPluginSidecars(ctx, appPath, repoRoot, postRenderer.Plugin, env, q, q.Repo.GetGitCreds(gitCredsStore), opt.cmpTarDoneCh, opt.cmpTarExcludedGlobs, opt.cmpUseManifestGeneratePaths)
+		if err != nil {
+			return nil, nil, fmt.Errorf("helm post-renderer plugin %s failed: %w", postRenderer.Plugin, err)
+		}
+		return objs, []string{"# post-rendered by config management plugin " + postRenderer.Plugin}, nil
+	}
+
+	overlayRoot, overlayPath, repo, err := resolveHelmPostRendererOverlay(postRenderer.KustomizePath, appPath, repoRoot, env, q, gitRepoPaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup, err := writeHelmPostRendererManifests(overlayPath, manifests)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cleanup()
+	kustomizeBinary, err := settings.GetKustomizeBinaryPath(q.KustomizeOptions, *q.ApplicationSource)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting kustomize binary path: %w", err)
+	}
+	k := kustomize.NewKustomizeApp(overlayRoot, overlayPath, repo.GetGitCreds(gitCredsStore), repo.Repo, kustomizeBinary, repo.Proxy, repo.NoProxy)
+	objs, _, commands, err := k.Build(nil, q.KustomizeOptions, env, &kustomize.BuildOpts{
+		RemoteRepoCreds: kustomizeRemoteRepoCreds(q, gitCredsStore),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("helm post-renderer kustomize overlay %s failed: %w", postRenderer.KustomizePath, err)
+	}
+	return objs, commands, nil
+}
+
+// resolveHelmPostRendererOverlay returns the path of the Kustomize overlay post-rendering the manifests rendered by
+// Helm, along with the root and repository it belongs to. The path is either relative to the application path, or to
+// the root of a source with a ref if it starts with $<ref>/.
+func resolveHelmPostRendererOverlay(kustomizePath string, appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, gitRepoPaths utilio.TempPaths) (string, string, *v1alpha1.Repository, error) {
+	root, repo := repoRoot, q.Repo
+	overlayPath := filepath.Join(appPath, env.Envsubst(kustomizePath))
+	if referencedSource := getReferencedSource(kustomizePath, q.RefSources); referencedSource != nil {
+		root = gitRepoPaths.GetPathIfExists(git.NormalizeGitURL(referencedSource.Repo.Repo))
+		if root == "" {
+			return "", "", nil, fmt.Errorf("failed to find repo %q", referencedSource.Repo.Repo)
+		}
+		repo = &referencedSource.Repo
+		_, refPath, _ := strings.Cut(kustomizePath, "/")
+		overlayPath = filepath.Join(root, env.Envsubst(refPath))
+	}
+	relPath, err := filepath.Rel(root, overlayPath)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to resolve helm post-renderer kustomize overlay %s: %w", kustomizePath, err)
+	}
+	overlayPath, err = apppathutil.Path(root, relPath)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("invalid helm post-renderer kustomize overlay: %w", err)
+	}
+	return root, overlayPath, repo, nil
+}
+
+// writeHelmPostRendererManifests writes the given manifests rendered by Helm to the file the post-renderer reads them
+// from in the given directory, and returns the function removing it once the post-renderer is done. The directory is
+// locked meanwhile, since it may be shared with concurrent manifest generations.
+func writeHelmPostRendererManifests(dir string, manifests []byte) (func(), error) {
+	manifestGenerateLock.Lock(dir)
+	manifestsPath := filepath.Join(dir, v1alpha1.HelmPostRendererManifestsFile)
+	if _, err := os.Lstat(manifestsPath); err == nil {
+		manifestGenerateLock.Unlock(dir)
+		return nil, fmt.Errorf("helm post-renderer directory must not contain a %s file", v1alpha1.HelmPostRendererManifestsFile)
+	}
+	if err := os.WriteFile(manifestsPath, manifests, 0o644); err != nil {
+		manifestGenerateLock.Unlock(dir)
+		return nil, fmt.Errorf("failed to write manifests rendered by helm: %w", err)
+	}
+	return func() {
+		defer manifestGenerateLock.Unlock(dir)
+		_ = os.Remove(manifestsPath)
+	}, nil
+}
+
+// marshalManifests marshals the given objects to a multi-document YAML
+func marshalManifests(objs []*unstructured.Unstructured) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, obj := range objs {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("---\n")
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+func newHelmPostRendererRequest(postRenderer *v1alpha1.HelmPostRenderer) *apiclient.ManifestRequest {
+	return &apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{Repo: "https://github.com/example/charts"},
+		ApplicationSource:  &v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{PostRenderer: postRenderer}},
+		ProjectSourceRepos: []string{"*"},
+	}
+}
+
+func Test_helmPostRender(t *testing.T) {
+	objs := []*unstructured.Unstructured{{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "my-app"},
+		"spec":       map[string]any{"replicas": int64(1)},
+	}}}
+	repoRoot, err := filepath.Abs("./testdata/helm-post-renderer")
+	require.NoError(t, err)
+
+	t.Run("Kustomize", func(t *testing.T) {
+		q := newHelmPostRendererRequest(&v1alpha1.HelmPostRenderer{KustomizePath: "overlay"})
+		postRendered, commands, err := helmPostRender(t.Context(), objs, repoRoot, repoRoot, &v1alpha1.Env{}, q, &git.NoopCredsStore{}, nil, newGenerateManifestOpt())
+		require.NoError(t, err)
+		require.Len(t, postRendered, 1)
+		replicas, _, err := unstructured.NestedInt64(postRendered[0].Object, "spec", "replicas")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), replicas)
+		assert.Equal(t, []string{"kustomize build ./overlay"}, commands)
+		assert.NoFileExists(t, filepath.Join(repoRoot, "overlay", v1alpha1.HelmPostRendererManifestsFile))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		q := newHelmPostRendererRequest(&v1alpha1.HelmPostRenderer{})
+		_, _, err := helmPostRender(t.Context(), objs, repoRoot, repoRoot, &v1alpha1.Env{}, q, &git.NoopCredsStore{}, nil, newGenerateManifestOpt())
+		require.EqualError(t, err, "helm post-renderer must set exactly one of plugin or kustomizePath")
+
+		q = newHelmPostRendererRequest(&v1alpha1.HelmPostRenderer{Plugin: "patcher", KustomizePath: "overlay"})
+		_, _, err = helmPostRender(t.Context(), objs, repoRoot, repoRoot, &v1alpha1.Env{}, q, &git.NoopCredsStore{}, nil, newGenerateManifestOpt())
+		require.EqualError(t, err, "helm post-renderer must set exactly one of plugin or kustomizePath")
+	})
+
+	t.Run("OutOfBounds", func(t *testing.T) {
+		q := newHelmPostRendererRequest(&v1alpha1.HelmPostRenderer{KustomizePath: "../my-chart"})
+		_, _, err := helmPostRender(t.Context(), objs, repoRoot, repoRoot, &v1alpha1.Env{}, q, &git.NoopCredsStore{}, nil, newGenerateManifestOpt())
+		require.ErrorContains(t, err, "invalid helm post-renderer kustomize overlay")
+	})
+}
+
+func Test_resolveHelmPostRendererOverlay(t *testing.T) {
+	repoRoot := t.TempDir()
+	refRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, "overlays", "prod"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(refRoot, "post-renderers", "prod"), 0o755))
+	appPath := filepath.Join(repoRoot, "charts", "my-chart")
+	require.NoError(t, os.MkdirAll(appPath, 0o755))
+
+	refRepo := v1alpha1.Repository{Repo: "https://github.com/example/values"}
+	q := &apiclient.ManifestRequest{
+		Repo:       &v1alpha1.Repository{Repo: "https://github.com/example/charts"},
+		RefSources: map[string]*v1alpha1.RefTarget{"$values": {Repo: refRepo}},
+	}
+	paths := utilio.NewRandomizedTempPaths(t.TempDir())
+	paths.Add(git.NormalizeGitURL(refRepo.Repo), refRoot)
+
+	root, overlayPath, repo, err := resolveHelmPostRendererOverlay("../../overlays/prod", appPath, repoRoot, &v1alpha1.Env{}, q, paths)
+	require.NoError(t, err)
+	assert.Equal(t, repoRoot, root)
+	assert.Equal(t, filepath.Join(repoRoot, "overlays", "prod"), overlayPath)
+	assert.Equal(t, q.Repo, repo)
+
+	root, overlayPath, repo, err = resolveHelmPostRendererOverlay("$values/post-renderers/prod", appPath, repoRoot, &v1alpha1.Env{}, q, paths)
+	require.NoError(t, err)
+	assert.Equal(t, refRoot, root)
+	assert.Equal(t, filepath.Join(refRoot, "post-renderers", "prod"), overlayPath)
+	assert.Equal(t, refRepo.Repo, repo.Repo)
+
+	_, _, _, err = resolveHelmPostRendererOverlay("$values/../../etc", appPath, repoRoot, &v1alpha1.Env{}, q, paths)
+	require.ErrorContains(t, err, "app path outside root")
+
+	_, _, _, err = resolveHelmPostRendererOverlay("overlays/missing", appPath, repoRoot, &v1alpha1.Env{}, q, paths)
+	require.ErrorContains(t, err, "app path does not exist")
+}
+
+func Test_writeHelmPostRendererManifests(t *testing.T) {
+	dir := t.TempDir()
+	cleanup, err := writeHelmPostRendererManifests(dir, []byte("---\nkind: ConfigMap\n"))
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, v1alpha1.HelmPostRendererManifestsFile))
+	cleanup()
+	assert.NoFileExists(t, filepath.Join(dir, v1alpha1.HelmPostRendererManifestsFile))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, v1alpha1.HelmPostRendererManifestsFile), nil, 0o644))
+	_, err = writeHelmPostRendererManifests(dir, []byte("---\nkind: ConfigMap\n"))
+	require.EqualError(t, err, "helm post-renderer directory must not contain a helm-output.yaml file")
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	gojsonnet "github.com/google/go-jsonnet"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	"github.com/argoproj/argo-cd/v3/util/kustomize"
+)
+
+// toolVersions caches the versions of the tools manifests are generated with, keyed by tool and binary
+var toolVersions sync.Map
+
+// getToolVersion returns the version of a tool, which is only looked up once
+func getToolVersion(key string, version func() (string, error)) string {
+	if v, ok := toolVersions.Load(key); ok {
+		return v.(string)
+	}
+	v, err := version()
+	if err != nil {
+		log.Warnf("Failed to get %s version: %v", key, err)
+		v = "unknown"
+	}
+	toolVersions.Store(key, v)
+	return v
+}
+
+// getTools returns the versions of the tools manifests of the given source type are generated with
+func getTools(appSourceType v1alpha1.ApplicationSourceType, q *apiclient.ManifestRequest, kustomizeBinary string) map[string]string {
+	tools := map[string]string{"argocd": common.GetVersion().Version}
+	switch appSourceType {
+	case v1alpha1.ApplicationSourceTypeHelm, v1alpha1.ApplicationSourceTypeHelmfile:
+		tools["helm"] = getToolVersion("helm", helm.Version)
+	case v1alpha1.ApplicationSourceTypeKustomize:
+		tools["kustomize"] = getToolVersion("kustomize:"+kustomizeBinary, func() (string, error) {
+			return kustomize.VersionWithBinaryPath(kustomizeBinary)
+		})
+	case v1alpha1.ApplicationSourceTypePlugin:
+		if q.ApplicationSource.Plugin != nil && q.ApplicationSource.Plugin.Name != "" {
+			tools["plugin"] = q.ApplicationSource.Plugin.Name
+		}
+	case v1alpha1.ApplicationSourceTypeDirectory:
+		tools["jsonnet"] = gojsonnet.Version()
+	}
+	return tools
+}
+
+// newManifestProvenance returns the provenance of the given manifests generated for the given request
+func newManifestProvenance(q *apiclient.ManifestRequest, revision string, appSourceType v1alpha1.ApplicationSourceType, kustomizeBinary string, manifests []string) (*apiclient.ManifestProvenance, error) {
+	parameters, err := json.Marshal(q.ApplicationSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal application source: %w", err)
+	}
+	provenance := &apiclient.ManifestProvenance{
+		RepoURL:     q.ApplicationSource.RepoURL,
+		Revision:    revision,
+		Path:        q.ApplicationSource.Path,
+		Chart:       q.ApplicationSource.Chart,
+		SourceType:  string(appSourceType),
+		Tools:       getTools(appSourceType, q, kustomizeBinary),
+		Parameters:  string(parameters),
+		Namespace:   q.Namespace,
+		KubeVersion: q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion),
+	}
+	provenance.SetManifests(manifests)
+	return provenance, nil
+}
+
+// signProvenance signs the provenance of the given response with the signing key of the repo server, if any. The
+// provenance is signed whenever it is returned rather than when it is generated, so that cached responses are signed
+// with the current key.
+func (s *Service) signProvenance(res *apiclient.ManifestResponse) error {
+	if s.initConstants.ProvenanceSigningKey == nil || res == nil || res.Provenance == nil {
+		return nil
+	}
+	if err := res.Provenance.Sign(s.initConstants.ProvenanceSigningKey); err != nil {
+		return fmt.Errorf("error signing manifest provenance: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+func TestGenerateManifests_Provenance(t *testing.T) {
+	q := apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		ApplicationSource:  &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "concatenated"},
+		Namespace:          "guestbook",
+		KubeVersion:        "v1.30.0",
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+
+	res, err := GenerateManifests(t.Context(), "./testdata/concatenated", "/", "abc123", &q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil)
+	require.NoError(t, err)
+	require.NotNil(t, res.Provenance)
+
+	provenance := res.Provenance
+	assert.Equal(t, "https://github.com/argoproj/argocd-example-apps", provenance.RepoURL)
+	assert.Equal(t, "abc123", provenance.Revision)
+	assert.Equal(t, "concatenated", provenance.Path)
+	assert.Equal(t, string(v1alpha1.ApplicationSourceTypeDirectory), provenance.SourceType)
+	assert.Equal(t, "guestbook", provenance.Namespace)
+	assert.Equal(t, "v1.30.0", provenance.KubeVersion)
+	assert.Equal(t, common.GetVersion().Version, provenance.Tools["argocd"])
+	assert.NotEmpty(t, provenance.Tools["jsonnet"])
+	assert.JSONEq(t, `{"repoURL":"https://github.com/argoproj/argocd-example-apps","path":"concatenated"}`, provenance.Parameters)
+	require.Len(t, provenance.ManifestDigests, len(res.Manifests))
+	for i, manifest := range res.Manifests {
+		assert.Equal(t, apiclient.ManifestDigest(manifest), provenance.ManifestDigests[i])
+	}
+	assert.NotEmpty(t, provenance.ManifestsDigest)
+	assert.Empty(t, provenance.Signature)
+}
+
+func TestSignProvenance(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	newResponse := func() *apiclient.ManifestResponse {
+		provenance := &apiclient.ManifestProvenance{Revision: "abc123"}
+		provenance.SetManifests([]string{`{"kind":"ConfigMap"}`})
+		return &apiclient.ManifestResponse{Manifests: []string{`{"kind":"ConfigMap"}`}, Provenance: provenance}
+	}
+
+	t.Run("NoKey", func(t *testing.T) {
+		service := newService(t, ".")
+		res := newResponse()
+		require.NoError(t, service.signProvenance(res))
+		assert.Empty(t, res.Provenance.Signature)
+	})
+	t.Run("Key", func(t *testing.T) {
+		service := newService(t, ".")
+		service.initConstants.ProvenanceSigningKey = key
+		res := newResponse()
+		require.NoError(t, service.signProvenance(res))
+		assert.NotEmpty(t, res.Provenance.Signature)
+		require.NoError(t, res.Provenance.Verify(key.Public()))
+	})
+	t.Run("NoProvenance", func(t *testing.T) {
+		service := newService(t, ".")
+		service.initConstants.ProvenanceSigningKey = key
+		require.NoError(t, service.signProvenance(&apiclient.ManifestResponse{}))
+	})
+}
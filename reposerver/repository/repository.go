@@ -3,6 +3,7 @@ package repository
 import (
 	"bytes"
 	"context"
+	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -28,11 +30,10 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	gogit "github.com/go-git/go-git/v5"
 	"github.com/golang/protobuf/ptypes/empty"
-	"github.com/google/go-jsonnet"
+	gojsonnet "github.com/google/go-jsonnet"
 	"github.com/google/uuid"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
 	log "github.com/sirupsen/logrus"
-	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -61,6 +62,7 @@ import (
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+	"github.com/argoproj/argo-cd/v3/util/jsonnet"
 	"github.com/argoproj/argo-cd/v3/util/kustomize"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
 	"github.com/argoproj/argo-cd/v3/util/settings"
@@ -80,27 +82,33 @@ var ErrExceededMaxCombinedManifestFileSize = errors.New("exceeded max combined m
 
 // Service implements ManifestService interface
 type Service struct {
-	gitCredsStore             git.CredsStore
-	rootDir                   string
-	gitRepoPaths              utilio.TempPaths
-	chartPaths                utilio.TempPaths
-	ociPaths                  utilio.TempPaths
-	gitRepoInitializer        func(rootPath string) goio.Closer
-	repoLock                  *repositoryLock
-	cache                     *cache.Cache
-	parallelismLimitSemaphore *semaphore.Weighted
-	metricsServer             *metrics.MetricsServer
-	newOCIClient              func(repoURL string, creds oci.Creds, proxy string, noProxy string, mediaTypes []string, opts ...oci.ClientOpts) (oci.Client, error)
-	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error)
-	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client
-	initConstants             RepoServerInitConstants
+	gitCredsStore      git.CredsStore
+	rootDir            string
+	gitRepoPaths       utilio.TempPaths
+	chartPaths         utilio.TempPaths
+	ociPaths           utilio.TempPaths
+	gitRepoInitializer func(rootPath string) goio.Closer
+	repoLock           *repositoryLock
+	sparseCheckouts    *sparseCheckouts
+	jsonnetVendorCache *jsonnetVendorCache
+	cache              *cache.Cache
+	manifestScheduler  *manifestScheduler
+	metricsServer      *metrics.MetricsServer
+	newOCIClient       func(repoURL string, creds oci.Creds, proxy string, noProxy string, mediaTypes []string, opts ...oci.ClientOpts) (oci.Client, error)
+	newGitClient       func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error)
+	newHelmClient      func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client
+	initConstants      RepoServerInitConstants
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
 }
 
 type RepoServerInitConstants struct {
-	OCIMediaTypes                                []string
-	ParallelismLimit                             int64
+	OCIMediaTypes    []string
+	ParallelismLimit int64
+	// SchedulerRepoWeights are the weights of repositories, keyed by URL, when scheduling manifest generation requests
+	SchedulerRepoWeights map[string]float64
+	// SchedulerProjectWeights are the weights of projects when scheduling manifest generation requests
+	SchedulerProjectWeights                      map[string]float64
 	PauseGenerationAfterFailedGenerationAttempts int
 	PauseGenerationOnFailureForMinutes           int
 	PauseGenerationOnFailureForRequests          int
@@ -119,27 +127,27 @@ type RepoServerInitConstants struct {
 	CMPUseManifestGeneratePaths                  bool
 	EnableBuiltinGitConfig                       bool
 	HelmUserAgent                                string
+	// ProvenanceSigningKey signs the provenance of generated manifests, if set
+	ProvenanceSigningKey crypto.Signer
 }
 
 var manifestGenerateLock = sync.NewKeyLock()
 
 // NewService returns a new instance of the Manifest service
 func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initConstants RepoServerInitConstants, gitCredsStore git.CredsStore, rootDir string) *Service {
-	var parallelismLimitSemaphore *semaphore.Weighted
-	if initConstants.ParallelismLimit > 0 {
-		parallelismLimitSemaphore = semaphore.NewWeighted(initConstants.ParallelismLimit)
-	}
 	repoLock := NewRepositoryLock()
 	gitRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	helmRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	ociRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	return &Service{
-		parallelismLimitSemaphore: parallelismLimitSemaphore,
-		repoLock:                  repoLock,
-		cache:                     cache,
-		metricsServer:             metricsServer,
-		newGitClient:              git.NewClientExt,
-		newOCIClient:              oci.NewClient,
+		manifestScheduler:  newManifestScheduler(initConstants.ParallelismLimit, initConstants.SchedulerRepoWeights, initConstants.SchedulerProjectWeights, metricsServer),
+		repoLock:           repoLock,
+		sparseCheckouts:    newSparseCheckouts(),
+		jsonnetVendorCache: newJsonnetVendorCache(),
+		cache:              cache,
+		metricsServer:      metricsServer,
+		newGitClient:       git.NewClientExt,
+		newOCIClient:       oci.NewClient,
 		newHelmClient: func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client {
 			// Add User-Agent option if configured
 			if initConstants.HelmUserAgent != "" {
@@ -177,6 +185,13 @@ func (s *Service) Init() error {
 	}
 
 	for _, file := range dirEntries {
+		if file.IsDir() && file.Name() == jsonnetVendorCacheDirName {
+			// the last use of the cached jsonnet-bundler dependencies is not known anymore, so they are fetched again
+			if err := removeJsonnetVendorCache(filepath.Join(s.rootDir, file.Name())); err != nil {
+				log.Warnf("Failed to remove jsonnet vendor cache: %v", err)
+			}
+			continue
+		}
 		if !file.IsDir() {
 			continue
 		}
@@ -293,10 +308,13 @@ func (s *Service) ListPlugins(_ context.Context, _ *empty.Empty) (*apiclient.Plu
 }
 
 type operationSettings struct {
-	sem             *semaphore.Weighted
+	// schedule waits until the operation is scheduled, if set, and returns the function to call once it is done
+	schedule        func(ctx context.Context) (func(), error)
 	noCache         bool
 	noRevisionCache bool
 	allowConcurrent bool
+	// sparseCheckoutPaths are the paths of the repository to check out, or nil to check out the whole repository
+	sparseCheckoutPaths []string
 }
 
 // operationContext contains request values which are generated by runRepoOperation (on demand) by a call to the
@@ -343,6 +361,8 @@ func (s *Service) runRepoOperation(
 	gitClientOpts := git.WithCache(s.cache, !settings.noRevisionCache && !settings.noCache)
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
 	unresolvedRevision := revision
+	var sparseCheckoutOpts []git.ClientOpts
+	var lockKeySuffix string
 
 	switch {
 	case source.IsOCI():
@@ -350,7 +370,11 @@ func (s *Service) runRepoOperation(
 	case source.IsHelm():
 		helmClient, revision, err = s.newHelmClientResolveRevision(repo, revision, source.Chart, settings.noCache || settings.noRevisionCache)
 	default:
-		gitClient, revision, err = s.newClientResolveRevision(repo, revision, gitClientOpts)
+		sparseCheckoutOpts, lockKeySuffix, err = s.sparseCheckoutOpts(repo, settings.sparseCheckoutPaths)
+		if err != nil {
+			return err
+		}
+		gitClient, revision, err = s.newClientResolveRevision(repo, revision, append(sparseCheckoutOpts, gitClientOpts)...)
 	}
 
 	if err != nil {
@@ -371,12 +395,12 @@ func (s *Service) runRepoOperation(
 	s.metricsServer.IncPendingRepoRequest(repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
-	if settings.sem != nil {
-		err = settings.sem.Acquire(ctx, 1)
+	if settings.schedule != nil {
+		done, err := settings.schedule(ctx)
 		if err != nil {
 			return err
 		}
-		defer settings.sem.Release(1)
+		defer done()
 	}
 
 	if source.IsOCI() {
@@ -454,7 +478,7 @@ func (s *Service) runRepoOperation(
 			return &operationContext{chartPath, ""}, nil
 		})
 	}
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
+	closer, err := s.repoLock.Lock(gitClient.Root(), revision+lockKeySuffix, settings.allowConcurrent, func() (goio.Closer, error) {
 		return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled, repo.Depth)
 	})
 	if err != nil {
@@ -547,13 +571,7 @@ func resolveReferencedSources(hasMultipleSources bool, source *v1alpha1.Applicat
 		return repoRefs, nil
 	}
 
-	refFileParams := make([]string, 0)
-	for _, fileParam := range source.FileParameters {
-		refFileParams = append(refFileParams, fileParam.Path)
-	}
-	refCandidates := append(source.ValueFiles, refFileParams...)
-
-	for _, valueFile := range refCandidates {
+	for _, valueFile := range getHelmRefCandidates(source) {
 		if !strings.HasPrefix(valueFile, "$") {
 			continue
 		}
@@ -640,7 +658,11 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return nil
 	}
 
-	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
+	schedule := func(ctx context.Context) (func(), error) {
+		return s.manifestScheduler.Acquire(ctx, q.Repo.Repo, q.ProjectName, q.Interactive)
+	}
+	// the Helm values are not cached, so the requests including them bypass the cache
+	settings := operationSettings{schedule: schedule, noCache: q.NoCache || q.IncludeHelmValues, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing(), sparseCheckoutPaths: getSparseCheckoutPaths(q.Repo, q.ApplicationSource, q.AnnotationManifestGeneratePaths, q.RefSources)}
 	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature, cacheFn, operation, settings, q.HasMultipleSources, q.RefSources)
 
 	// if the tarDoneCh message is sent it means that the manifest
@@ -655,7 +677,16 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 			return nil, err
 		}
 	}
-	return res, err
+	if err != nil {
+		return res, err
+	}
+	if err := s.signProvenance(res); err != nil {
+		return res, err
+	}
+	if err := validateManifestPolicies(res, q.ManifestPolicies); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_GenerateManifestWithFilesServer) error {
@@ -720,6 +751,12 @@ func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_G
 		}
 	}
 
+	if err := s.signProvenance(res); err != nil {
+		return err
+	}
+	if err := validateManifestPolicies(res, req.ManifestPolicies); err != nil {
+		return err
+	}
 	err = stream.SendAndClose(res)
 	return err
 }
@@ -791,14 +828,8 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 		// check whether they should be replicated in resolveReferencedSources.
 		if q.HasMultipleSources {
 			if q.ApplicationSource.Helm != nil {
-				refFileParams := make([]string, 0)
-				for _, fileParam := range q.ApplicationSource.Helm.FileParameters {
-					refFileParams = append(refFileParams, fileParam.Path)
-				}
-				refCandidates := append(q.ApplicationSource.Helm.ValueFiles, refFileParams...)
-
 				// Checkout every one of the referenced sources to the target revision before generating Manifests
-				for _, valueFile := range refCandidates {
+				for _, valueFile := range getHelmRefCandidates(q.ApplicationSource.Helm) {
 					if !strings.HasPrefix(valueFile, "$") {
 						continue
 					}
@@ -839,9 +870,14 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 							ch.errCh <- fmt.Errorf("cannot reference a different revision of the same repository (%s references %q which resolves to %q while the application references %q which resolves to %q)", refVar, refSourceMapping.TargetRevision, referencedCommitSHA, q.Revision, commitSHA)
 							return
 						}
-						closer, err := s.repoLock.Lock(gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
-							return s.checkoutRevision(gitClient, referencedCommitSHA, s.initConstants.SubmoduleEnabled, q.Repo.Depth)
-						})
+						var closer goio.Closer = utilio.NopCloser
+						// The sparse checkout of the repository of the application, which is already locked at the same
+						// commit, includes the files referenced from it
+						if git.NormalizeGitURL(q.ApplicationSource.RepoURL) != normalizedRepoURL || getSparseCheckoutPaths(q.Repo, q.ApplicationSource, q.AnnotationManifestGeneratePaths, q.RefSources) == nil {
+							closer, err = s.repoLock.Lock(gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
+								return s.checkoutRevision(gitClient, referencedCommitSHA, s.initConstants.SubmoduleEnabled, q.Repo.Depth)
+							})
+						}
 						if err != nil {
 							log.Errorf("failed to acquire lock for referenced source %s", normalizedRepoURL)
 							ch.errCh <- err
@@ -880,7 +916,10 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			}
 		}
 
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths))
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths), WithJsonnetVendor(s.vendorJsonnetDependencies))
+		if sparseCheckoutPaths := getSparseCheckoutPaths(q.Repo, q.ApplicationSource, q.AnnotationManifestGeneratePaths, q.RefSources); err != nil && sparseCheckoutPaths != nil {
+			err = sparseCheckoutError(err, sparseCheckoutPaths)
+		}
 	}
 	refSourceCommitSHAs := make(map[string]string)
 	if len(repoRefs) > 0 {
@@ -943,11 +982,18 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = opContext.verificationResult
+	if manifestGenResult.HelmValues != "" {
+		// the Helm values may contain secrets and are only returned to the requests asking for them, which bypass the
+		// cache, so they are not cached
+		cachedResult := *manifestGenResult
+		cachedResult.HelmValues = ""
+		manifestGenCacheEntry.ManifestResponse = &cachedResult
+	}
 	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, q.InstallationID)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
 	}
-	ch.responseCh <- manifestGenCacheEntry.ManifestResponse
+	ch.responseCh <- manifestGenResult
 }
 
 // getManifestCacheEntry returns false if the 'generate manifests' operation should be run by runRepoOperation, e.g.:
@@ -1046,6 +1092,12 @@ func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoC
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving helm dependency repos: %w", err)
 	}
+	return resolveHelmRepos(dependencies, repositories, helmRepoCreds), nil
+}
+
+// resolveHelmRepos returns the given Helm repositories with the credentials of the matching Argo CD repositories or
+// repository credentials
+func resolveHelmRepos(dependencies []*v1alpha1.Repository, repositories []*v1alpha1.Repository, helmRepoCreds []*v1alpha1.RepoCreds) []helm.HelmRepository {
 	reposByName := make(map[string]*v1alpha1.Repository)
 	reposByURL := make(map[string]*v1alpha1.Repository)
 	for _, repo := range repositories {
@@ -1077,7 +1129,7 @@ func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoC
 				// finally if repo is OCI and no credentials found, use the first OCI credential matching by hostname
 				// see https://github.com/argoproj/argo-cd/issues/14636
 				for _, cred := range repositories {
-					if _, err = url.Parse("oci://" + dep.Repo); err != nil {
+					if _, err := url.Parse("oci://" + dep.Repo); err != nil {
 						continue
 					}
 					// if the repo is OCI, don't match the repository URL exactly, but only as a dependent repository prefix just like in the getRepoCredential function
@@ -1094,7 +1146,7 @@ func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoC
 		}
 		repos = append(repos, helm.HelmRepository{Name: repo.Name, Repo: repo.Repo, Creds: repo.GetHelmCreds(), EnableOci: repo.EnableOCI})
 	}
-	return repos, nil
+	return repos
 }
 
 type dependencies struct {
@@ -1175,6 +1227,26 @@ func isSourcePermitted(url string, repos []string) bool {
 	return p.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: url})
 }
 
+// kustomizeRemoteRepoCreds returns the function checking that the remote Git repositories referenced by a
+// kustomization are permitted by the project of the application, and returning the credentials of the registered
+// repository each of them is fetched with, if any
+func kustomizeRemoteRepoCreds(q *apiclient.ManifestRequest, gitCredsStore git.CredsStore) kustomize.RemoteRepoCreds {
+	return func(repoURL string) (git.Creds, error) {
+		if !isSourcePermitted(repoURL, q.ProjectSourceRepos) {
+			return nil, status.Errorf(codes.PermissionDenied, "kustomize remote base %s is not permitted in project '%s'", repoURL, q.ProjectName)
+		}
+		if q.Repo != nil && git.SameURL(q.Repo.Repo, repoURL) {
+			return q.Repo.GetGitCreds(gitCredsStore), nil
+		}
+		for _, repo := range q.GitRepos {
+			if git.SameURL(repo.Repo, repoURL) {
+				return repo.GetGitCreds(gitCredsStore), nil
+			}
+		}
+		return git.NopCreds{}, nil
+	}
+}
+
 // parseKubeVersion is an helper function to remove the non-semantic information supported by kubernetes
 // that may not be supported in all helm versions: https://github.com/helm/helm/pull/31091
 func parseKubeVersion(version string) (string, error) {
@@ -1189,7 +1261,9 @@ func parseKubeVersion(version string) (string, error) {
 	return kubeVersion.String(), nil
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths) ([]*unstructured.Unstructured, string, error) {
+// helmTemplate templates the Helm chart of the source. It returns the manifests, the command used to template them, and
+// the YAML of the values the chart was templated with.
+func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths) ([]*unstructured.Unstructured, string, string, error) {
 	// We use the app name as Helm's release name property, which must not
 	// contain any underscore characters and must not exceed 53 characters.
 	// We are not interested in the fully qualified application name while
@@ -1198,7 +1272,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 
 	kubeVersion, err := parseKubeVersion(q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion))
 	if err != nil {
-		return nil, "", fmt.Errorf("could not parse kubernetes version %s: %w", q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion), err)
+		return nil, "", "", fmt.Errorf("could not parse kubernetes version %s: %w", q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion), err)
 	}
 
 	templateOpts := &helm.TemplateOpts{
@@ -1227,7 +1301,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 
 		resolvedValueFiles, err := getResolvedValueFiles(appPath, repoRoot, env, q.GetValuesFileSchemes(), appHelm.ValueFiles, q.RefSources, gitRepoPaths, appHelm.IgnoreMissingValueFiles)
 		if err != nil {
-			return nil, "", fmt.Errorf("error resolving helm value files: %w", err)
+			return nil, "", "", fmt.Errorf("error resolving helm value files: %w", err)
 		}
 
 		templateOpts.Values = resolvedValueFiles
@@ -1235,7 +1309,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		if !appHelm.ValuesIsEmpty() {
 			rand, err := uuid.NewRandom()
 			if err != nil {
-				return nil, "", fmt.Errorf("error generating random filename for Helm values file: %w", err)
+				return nil, "", "", fmt.Errorf("error generating random filename for Helm values file: %w", err)
 			}
 			p := path.Join(os.TempDir(), rand.String())
 			defer func() {
@@ -1246,7 +1320,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			}()
 			err = os.WriteFile(p, appHelm.ValuesYAML(), 0o644)
 			if err != nil {
-				return nil, "", fmt.Errorf("error writing helm values file: %w", err)
+				return nil, "", "", fmt.Errorf("error writing helm values file: %w", err)
 			}
 			templateOpts.ExtraValues = pathutil.ResolvedFilePath(p)
 		}
@@ -1265,12 +1339,12 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 				// If the $-prefixed path appears to reference another source, do env substitution _after_ resolving the source
 				resolvedPath, err = getResolvedRefValueFile(p.Path, env, q.GetValuesFileSchemes(), referencedSource.Repo.Repo, gitRepoPaths)
 				if err != nil {
-					return nil, "", fmt.Errorf("error resolving set-file path: %w", err)
+					return nil, "", "", fmt.Errorf("error resolving set-file path: %w", err)
 				}
 			} else {
 				resolvedPath, _, err = pathutil.ResolveValueFilePathOrUrl(appPath, repoRoot, env.Envsubst(p.Path), q.GetValuesFileSchemes())
 				if err != nil {
-					return nil, "", fmt.Errorf("error resolving helm value file path: %w", err)
+					return nil, "", "", fmt.Errorf("error resolving helm value file path: %w", err)
 				}
 			}
 			templateOpts.SetFile[p.Name] = resolvedPath
@@ -1297,12 +1371,12 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 
 	helmRepos, err := getHelmRepos(appPath, q.Repos, q.HelmRepoCreds)
 	if err != nil {
-		return nil, "", fmt.Errorf("error getting helm repos: %w", err)
+		return nil, "", "", fmt.Errorf("error getting helm repos: %w", err)
 	}
 
 	h, err := helm.NewHelmApp(appPath, helmRepos, isLocal, version, proxy, q.Repo.NoProxy, passCredentials)
 	if err != nil {
-		return nil, "", fmt.Errorf("error initializing helm app object: %w", err)
+		return nil, "", "", fmt.Errorf("error initializing helm app object: %w", err)
 	}
 
 	defer h.Dispose()
@@ -1310,7 +1384,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	out, command, err := h.Template(templateOpts)
 	if err != nil {
 		if !helm.IsMissingDependencyErr(err) {
-			return nil, "", err
+			return nil, "", "", err
 		}
 
 		err = runHelmBuild(appPath, h)
@@ -1330,22 +1404,33 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			}
 
 			if len(reposNotPermitted) > 0 {
-				return nil, "", status.Errorf(codes.PermissionDenied, "helm repos %s are not permitted in project '%s'", strings.Join(reposNotPermitted, ", "), q.ProjectName)
+				return nil, "", "", status.Errorf(codes.PermissionDenied, "helm repos %s are not permitted in project '%s'", strings.Join(reposNotPermitted, ", "), q.ProjectName)
 			}
 
-			return nil, "", err
+			return nil, "", "", err
 		}
 
 		out, command, err = h.Template(templateOpts)
 		if err != nil {
-			return nil, "", err
+			return nil, "", "", err
 		}
 	}
 	objs, err := kube.SplitYAML([]byte(out))
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	var values []byte
+	if q.IncludeHelmValues {
+		// the values are only informational, so failing to merge them does not fail the generation of the manifests
+		if values, err = helm.MergedValues(templateOpts); err != nil {
+			log.WithField("application", q.AppName).Warnf("Failed to merge the Helm values, they are not included: %v", err)
+		}
+	}
 
 	redactedCommand := redactPaths(command, gitRepoPaths, templateOpts.ExtraValues)
 
-	return objs, redactedCommand, err
+	return objs, redactedCommand, string(values), nil
 }
 
 // redactPaths removes temp repo paths, since those paths are randomized (and therefore not helpful for the user) and
@@ -1434,6 +1519,19 @@ func getResolvedRefValueFile(
 	return resolvedPath, nil
 }
 
+// getHelmRefCandidates returns the paths of the given Helm options which may reference other sources: the value files,
+// the paths of the file parameters and the path of the Kustomize post-renderer
+func getHelmRefCandidates(source *v1alpha1.ApplicationSourceHelm) []string {
+	refCandidates := slices.Clone(source.ValueFiles)
+	for _, fileParam := range source.FileParameters {
+		refCandidates = append(refCandidates, fileParam.Path)
+	}
+	if source.PostRenderer != nil && source.PostRenderer.KustomizePath != "" {
+		refCandidates = append(refCandidates, source.PostRenderer.KustomizePath)
+	}
+	return refCandidates
+}
+
 func getReferencedSource(rawValueFile string, refSources map[string]*v1alpha1.RefTarget) *v1alpha1.RefTarget {
 	if !strings.HasPrefix(rawValueFile, "$") {
 		return nil
@@ -1463,6 +1561,7 @@ type (
 		cmpTarDoneCh                chan<- bool
 		cmpTarExcludedGlobs         []string
 		cmpUseManifestGeneratePaths bool
+		jsonnetVendor               func(appPath string, repos []*v1alpha1.Repository) (string, error)
 	}
 )
 
@@ -1499,6 +1598,14 @@ func WithCMPUseManifestGeneratePaths(enabled bool) GenerateManifestOpt {
 	}
 }
 
+// WithJsonnetVendor defines the function vendoring the jsonnet-bundler dependencies of directory sources. It returns
+// the vendor directory to add to the jsonnet library paths.
+func WithJsonnetVendor(vendor func(appPath string, repos []*v1alpha1.Repository) (string, error)) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.jsonnetVendor = vendor
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, gitRepoPaths utilio.TempPaths, opts ...GenerateManifestOpt) (*apiclient.ManifestResponse, error) {
 	opt := newGenerateManifestOpt(opts...)
@@ -1518,14 +1625,28 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	}
 
 	var commands []string
+	var kustomizeBinary string
+	var helmValues string
 
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
 		var command string
-		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths)
+		targetObjs, command, helmValues, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths)
+		commands = append(commands, command)
+		if err == nil && q.ApplicationSource.Helm != nil && q.ApplicationSource.Helm.PostRenderer != nil {
+			var postRenderCommands []string
+			targetObjs, postRenderCommands, err = helmPostRender(ctx, targetObjs, appPath, repoRoot, env, q, gitCredsStore, gitRepoPaths, opt)
+			commands = append(commands, postRenderCommands...)
+		}
+	case v1alpha1.ApplicationSourceTypeHelmfile:
+		var command string
+		targetObjs, command, err = helmfileTemplate(appPath, repoRoot, env, q, gitRepoPaths)
+		commands = append(commands, command)
+	case v1alpha1.ApplicationSourceTypeCue:
+		var command string
+		targetObjs, command, err = cueExport(appPath, repoRoot, env, q, gitRepoPaths)
 		commands = append(commands, command)
 	case v1alpha1.ApplicationSourceTypeKustomize:
-		var kustomizeBinary string
 		kustomizeBinary, err = settings.GetKustomizeBinaryPath(q.KustomizeOptions, *q.ApplicationSource)
 		if err != nil {
 			return nil, fmt.Errorf("error getting kustomize binary path: %w", err)
@@ -1537,8 +1658,9 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		}
 		k := kustomize.NewKustomizeApp(repoRoot, appPath, q.Repo.GetGitCreds(gitCredsStore), repoURL, kustomizeBinary, q.Repo.Proxy, q.Repo.NoProxy)
 		targetObjs, _, commands, err = k.Build(q.ApplicationSource.Kustomize, q.KustomizeOptions, env, &kustomize.BuildOpts{
-			KubeVersion: kubeVersion,
-			APIVersions: q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
+			KubeVersion:     kubeVersion,
+			APIVersions:     q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
+			RemoteRepoCreds: kustomizeRemoteRepoCreds(q, gitCredsStore),
 		})
 		if err != nil {
 			return nil, err
@@ -1558,8 +1680,15 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		if directory = q.ApplicationSource.Directory; directory == nil {
 			directory = &v1alpha1.ApplicationSourceDirectory{}
 		}
+		var jsonnetVendorDir string
+		if opt.jsonnetVendor != nil && discovery.IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeDirectory, q.EnabledSourceTypes) {
+			jsonnetVendorDir, err = opt.jsonnetVendor(appPath, q.GitRepos)
+			if err != nil {
+				return nil, fmt.Errorf("failed to vendor jsonnet-bundler dependencies: %w", err)
+			}
+		}
 		logCtx := log.WithField("application", q.AppName)
-		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity)
+		targetObjs, err = findManifests(logCtx, appPath, repoRoot, jsonnetVendorDir, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity)
 	}
 	if err != nil {
 		return nil, err
@@ -1606,10 +1735,17 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		}
 	}
 
+	provenance, err := newManifestProvenance(q, revision, appSourceType, kustomizeBinary, manifests)
+	if err != nil {
+		return nil, err
+	}
+
 	return &apiclient.ManifestResponse{
 		Manifests:  manifests,
 		SourceType: string(appSourceType),
 		Commands:   commands,
+		Provenance: provenance,
+		HelmValues: helmValues,
 	}, nil
 }
 
@@ -1754,7 +1890,7 @@ func isNullList(obj *unstructured.Unstructured) bool {
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
 // findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity) ([]*unstructured.Unstructured, error) {
+func findManifests(logCtx *log.Entry, appPath string, repoRoot string, jsonnetVendorDir string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity) ([]*unstructured.Unstructured, error) {
 	// Validate the directory before loading any manifests to save memory.
 	potentiallyValidManifests, err := getPotentiallyValidManifests(logCtx, appPath, repoRoot, directory.Recurse, directory.Include, directory.Exclude, maxCombinedManifestQuantity)
 	if err != nil {
@@ -1767,11 +1903,16 @@ func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1al
 		manifestPath := potentiallyValidManifest.path
 		manifestFileInfo := potentiallyValidManifest.fileInfo
 
+		// the files of jsonnet-bundler declare the jsonnet dependencies, and are not manifests
+		if manifestFileInfo.Name() == jsonnet.FileName || manifestFileInfo.Name() == jsonnet.LockFileName {
+			continue
+		}
+
 		if strings.HasSuffix(manifestFileInfo.Name(), ".jsonnet") {
 			if !discovery.IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeDirectory, enabledManifestGeneration) {
 				continue
 			}
-			vm, err := makeJsonnetVM(appPath, repoRoot, directory.Jsonnet, env)
+			vm, err := makeJsonnetVM(appPath, repoRoot, jsonnetVendorDir, directory.Jsonnet, env)
 			if err != nil {
 				return nil, err
 			}
@@ -2018,8 +2159,8 @@ func getPotentiallyValidManifests(logCtx *log.Entry, appPath string, repoRoot st
 	return potentiallyValidManifests, nil
 }
 
-func makeJsonnetVM(appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env) (*jsonnet.VM, error) {
-	vm := jsonnet.MakeVM()
+func makeJsonnetVM(appPath string, repoRoot string, jsonnetVendorDir string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env) (*gojsonnet.VM, error) {
+	vm := gojsonnet.MakeVM()
 	for i, j := range sourceJsonnet.TLAs {
 		sourceJsonnet.TLAs[i].Value = env.Envsubst(j.Value)
 	}
@@ -2051,8 +2192,12 @@ func makeJsonnetVM(appPath string, repoRoot string, sourceJsonnet v1alpha1.Appli
 		}
 		jpaths = append(jpaths, string(jpath))
 	}
+	// the jsonnet-bundler dependencies vendored by the repo-server
+	if jsonnetVendorDir != "" {
+		jpaths = append(jpaths, jsonnetVendorDir)
+	}
 
-	vm.Importer(&jsonnet.FileImporter{
+	vm.Importer(&gojsonnet.FileImporter{
 		JPaths: jpaths,
 	})
 
@@ -2208,16 +2353,28 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 			if err := populateKustomizeAppDetails(res, q, repoRoot, opContext.appPath, commitSHA, s.gitCredsStore); err != nil {
 				return err
 			}
+		case v1alpha1.ApplicationSourceTypeCue:
+			if err := populateCueAppDetails(res, opContext.appPath, q); err != nil {
+				return err
+			}
 		case v1alpha1.ApplicationSourceTypePlugin:
 			if err := populatePluginAppDetails(ctx, res, opContext.appPath, repoRoot, q, s.initConstants.CMPTarExcludedGlobs); err != nil {
 				return fmt.Errorf("failed to populate plugin app details: %w", err)
 			}
+		case v1alpha1.ApplicationSourceTypeDirectory:
+			jsonnetDependencies, err := getJsonnetDependencies(opContext.appPath)
+			if err != nil {
+				return fmt.Errorf("failed to get jsonnet-bundler dependencies: %w", err)
+			}
+			if len(jsonnetDependencies) > 0 {
+				res.Directory = &apiclient.DirectoryAppSpec{JsonnetDependencies: jsonnetDependencies}
+			}
 		}
 		_ = s.cache.SetAppDetails(revision, q.Source, q.RefSources, res, v1alpha1.TrackingMethod(q.TrackingMethod), nil)
 		return nil
 	}
 
-	settings := operationSettings{allowConcurrent: q.Source.AllowsConcurrentProcessing(), noCache: q.NoCache, noRevisionCache: q.NoCache || q.NoRevisionCache}
+	settings := operationSettings{allowConcurrent: q.Source.AllowsConcurrentProcessing(), noCache: q.NoCache, noRevisionCache: q.NoCache || q.NoRevisionCache, sparseCheckoutPaths: getSparseCheckoutPaths(q.Repo, q.Source, "", q.RefSources)}
 	err := s.runRepoOperation(ctx, q.Source.TargetRevision, q.Repo, q.Source, false, cacheFn, operation, settings, len(q.RefSources) > 0, q.RefSources)
 
 	return res, err
@@ -2580,7 +2737,8 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 	}
 	opts = append(opts,
 		git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)),
-		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig))
+		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig),
+		git.WithPartialClone(repo.PartialClone))
 	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
 }
 
@@ -3174,11 +3332,7 @@ func (s *Service) UpdateRevisionForPaths(_ context.Context, request *apiclient.U
 
 	refCandidates := make([]string, 0)
 	if request.HasMultipleSources && request.ApplicationSource.Helm != nil {
-		refFileParams := make([]string, 0)
-		for _, fileParam := range request.ApplicationSource.Helm.FileParameters {
-			refFileParams = append(refFileParams, fileParam.Path)
-		}
-		refCandidates = append(request.ApplicationSource.Helm.ValueFiles, refFileParams...)
+		refCandidates = getHelmRefCandidates(request.ApplicationSource.Helm)
 	}
 
 	for _, valueFile := range refCandidates {
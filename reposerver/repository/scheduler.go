@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// manifestPriority is the priority of a manifest generation request
+type manifestPriority string
+
+const (
+	// manifestPriorityInteractive is the priority of the requests of users, e.g. from the UI or the CLI
+	manifestPriorityInteractive manifestPriority = "interactive"
+	// manifestPriorityBackground is the priority of the requests of the reconciliation of applications
+	manifestPriorityBackground manifestPriority = "background"
+)
+
+// manifestFlow identifies the requests which share the capacity of the scheduler fairly with other flows
+type manifestFlow struct {
+	repo    string
+	project string
+}
+
+// manifestScheduler limits the number of concurrent manifest generations. Once the limit is reached, requests are
+// queued and scheduled with start-time fair queueing: each flow, i.e. the requests for the same repository and
+// project, gets a share of the capacity proportional to its weight, so that a repository with many applications cannot
+// starve the others. Interactive requests are always scheduled before background requests.
+type manifestScheduler struct {
+	lock           sync.Mutex
+	limit          int64
+	running        int64
+	repoWeights    map[string]float64
+	projectWeights map[string]float64
+	// virtualTime is the start tag of the last scheduled request
+	virtualTime float64
+	// lastFinish is the finish tag of the last request of each flow, for the flows whose finish tag is after the
+	// virtual time. The other flows are idle, and their next request starts at the virtual time.
+	lastFinish map[manifestFlow]float64
+	queues     map[manifestPriority]*manifestQueue
+	seq        uint64
+	metrics    *metrics.MetricsServer
+}
+
+// newManifestScheduler returns a scheduler running at most the given number of concurrent requests, or any number of
+// requests if the limit is not positive. The weights of the repositories, keyed by URL, and of the projects default to 1.
+func newManifestScheduler(limit int64, repoWeights, projectWeights map[string]float64, metricsServer *metrics.MetricsServer) *manifestScheduler {
+	normalizedRepoWeights := make(map[string]float64, len(repoWeights))
+	for repoURL, weight := range repoWeights {
+		normalizedRepoWeights[git.NormalizeGitURL(repoURL)] = weight
+	}
+	return &manifestScheduler{
+		limit:          limit,
+		repoWeights:    normalizedRepoWeights,
+		projectWeights: projectWeights,
+		lastFinish:     map[manifestFlow]float64{},
+		queues: map[manifestPriority]*manifestQueue{
+			manifestPriorityInteractive: {},
+			manifestPriorityBackground:  {},
+		},
+		metrics: metricsServer,
+	}
+}
+
+// ParseSchedulerWeights parses the weights of repositories or projects when scheduling manifest generation requests,
+// which must be positive numbers
+func ParseSchedulerWeights(weights map[string]string) (map[string]float64, error) {
+	parsed := make(map[string]float64, len(weights))
+	for key, value := range weights {
+		weight, err := strconv.ParseFloat(value, 64)
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("invalid scheduler weight %q of %s: must be a positive number", value, key)
+		}
+		parsed[key] = weight
+	}
+	return parsed, nil
+}
+
+type manifestSchedulerRequest struct {
+	flow     manifestFlow
+	priority manifestPriority
+	start    float64
+	finish   float64
+	seq      uint64
+	// scheduled is closed once the request is scheduled
+	scheduled chan struct{}
+	// index is the index of the request in its queue, or -1 once it is removed from it
+	index int
+}
+
+// manifestQueue is a priority queue of requests ordered by start tag, then by arrival
+type manifestQueue []*manifestSchedulerRequest
+
+func (q manifestQueue) Len() int { return len(q) }
+
+func (q manifestQueue) Less(i, j int) bool {
+	if q[i].start != q[j].start {
+		return q[i].start < q[j].start
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q manifestQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *manifestQueue) Push(x any) {
+	req := x.(*manifestSchedulerRequest)
+	req.index = len(*q)
+	*q = append(*q, req)
+}
+
+func (q *manifestQueue) Pop() any {
+	old := *q
+	n := len(old)
+	req := old[n-1]
+	old[n-1] = nil
+	req.index = -1
+	*q = old[:n-1]
+	return req
+}
+
+func (s *manifestScheduler) weight(flow manifestFlow) float64 {
+	weight := 1.0
+	if w, ok := s.repoWeights[flow.repo]; ok {
+		weight *= w
+	}
+	if w, ok := s.projectWeights[flow.project]; ok {
+		weight *= w
+	}
+	return weight
+}
+
+// Acquire waits until a request for the given repository and project is scheduled, and returns the function to call
+// once it is done
+func (s *manifestScheduler) Acquire(ctx context.Context, repoURL string, project string, interactive bool) (func(), error) {
+	if s.limit <= 0 {
+		return func() {}, nil
+	}
+	priority := manifestPriorityBackground
+	if interactive {
+		priority = manifestPriorityInteractive
+	}
+	flow := manifestFlow{repo: git.NormalizeGitURL(repoURL), project: project}
+
+	s.lock.Lock()
+	start := max(s.virtualTime, s.lastFinish[flow])
+	finish := start + 1/s.weight(flow)
+	s.lastFinish[flow] = finish
+	if s.running < s.limit && s.queues[manifestPriorityInteractive].Len() == 0 && s.queues[manifestPriorityBackground].Len() == 0 {
+		s.running++
+		s.advanceVirtualTime(start)
+		s.lock.Unlock()
+		return s.release, nil
+	}
+	s.seq++
+	req := &manifestSchedulerRequest{flow: flow, priority: priority, start: start, finish: finish, seq: s.seq, scheduled: make(chan struct{})}
+	heap.Push(s.queues[priority], req)
+	s.lock.Unlock()
+
+	s.metrics.IncManifestQueueDepth(repoURL, project, string(priority))
+	defer s.metrics.DecManifestQueueDepth(repoURL, project, string(priority))
+	queuedAt := time.Now()
+	select {
+	case <-req.scheduled:
+		s.metrics.ObserveManifestQueueDuration(string(priority), time.Since(queuedAt))
+		return s.release, nil
+	case <-ctx.Done():
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		if req.index >= 0 {
+			heap.Remove(s.queues[priority], req.index)
+			s.unqueue(req)
+		} else {
+			// the request was scheduled concurrently
+			s.running--
+			s.scheduleNext()
+		}
+		s.forgetIdleFlows()
+		return nil, ctx.Err()
+	}
+}
+
+func (s *manifestScheduler) release() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.running--
+	s.scheduleNext()
+	s.forgetIdleFlows()
+}
+
+// forgetIdleFlows forgets the finish tags of all the flows once no request is running or queued, since all the flows
+// are idle
+func (s *manifestScheduler) forgetIdleFlows() {
+	if s.running == 0 && s.queues[manifestPriorityInteractive].Len() == 0 && s.queues[manifestPriorityBackground].Len() == 0 {
+		clear(s.lastFinish)
+	}
+}
+
+// scheduleNext schedules the queued requests with the lowest start tags, interactive requests first, while the limit
+// is not reached
+func (s *manifestScheduler) scheduleNext() {
+	for _, priority := range []manifestPriority{manifestPriorityInteractive, manifestPriorityBackground} {
+		queue := s.queues[priority]
+		for s.running < s.limit && queue.Len() > 0 {
+			req := heap.Pop(queue).(*manifestSchedulerRequest)
+			s.running++
+			s.advanceVirtualTime(req.start)
+			close(req.scheduled)
+		}
+	}
+}
+
+// advanceVirtualTime sets the virtual time to the start tag of a scheduled request if it is later, and forgets the
+// flows which became idle
+func (s *manifestScheduler) advanceVirtualTime(start float64) {
+	if start <= s.virtualTime {
+		return
+	}
+	s.virtualTime = start
+	for flow, finish := range s.lastFinish {
+		if finish <= s.virtualTime {
+			delete(s.lastFinish, flow)
+		}
+	}
+}
+
+// unqueue rolls back the tags of the flow of a request removed from its queue before being scheduled, as if it had
+// never been queued: the later requests of the flow start earlier by the cost of the request
+func (s *manifestScheduler) unqueue(req *manifestSchedulerRequest) {
+	cost := req.finish - req.start
+	for _, queue := range s.queues {
+		for _, other := range *queue {
+			if other.flow == req.flow && other.start > req.start {
+				other.start -= cost
+				other.finish -= cost
+			}
+		}
+		heap.Init(queue)
+	}
+	s.lastFinish[req.flow] -= cost
+	if s.lastFinish[req.flow] <= s.virtualTime {
+		delete(s.lastFinish, req.flow)
+	}
+}
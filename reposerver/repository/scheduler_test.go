@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
+)
+
+type scheduledRequest struct {
+	name        string
+	repoURL     string
+	project     string
+	interactive bool
+}
+
+// runScheduled queues the given requests, in order, while the only slot of the scheduler is taken, then releases the
+// slot and returns the order in which the requests were scheduled
+func runScheduled(t *testing.T, scheduler *manifestScheduler, requests []scheduledRequest) []string {
+	t.Helper()
+	done, err := scheduler.Acquire(t.Context(), "https://github.com/example/holder.git", "default", false)
+	require.NoError(t, err)
+
+	order := make(chan string, len(requests))
+	for i, req := range requests {
+		go func() {
+			done, err := scheduler.Acquire(t.Context(), req.repoURL, req.project, req.interactive)
+			if !assert.NoError(t, err) {
+				return
+			}
+			order <- req.name
+			done()
+		}()
+		waitQueued(t, scheduler, i+1)
+	}
+	done()
+
+	var names []string
+	for range requests {
+		select {
+		case name := <-order:
+			names = append(names, name)
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "timed out waiting for requests to be scheduled", "scheduled: %v", names)
+		}
+	}
+	return names
+}
+
+func waitQueued(t *testing.T, scheduler *manifestScheduler, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		scheduler.lock.Lock()
+		defer scheduler.lock.Unlock()
+		return scheduler.queues[manifestPriorityInteractive].Len()+scheduler.queues[manifestPriorityBackground].Len() == n
+	}, 10*time.Second, time.Millisecond)
+}
+
+const (
+	monorepoURL = "https://github.com/example/monorepo.git"
+	otherURL    = "https://github.com/example/other.git"
+)
+
+func TestManifestScheduler_Unlimited(t *testing.T) {
+	scheduler := newManifestScheduler(0, nil, nil, metrics.NewMetricsServer())
+	for range 10 {
+		_, err := scheduler.Acquire(t.Context(), monorepoURL, "default", false)
+		require.NoError(t, err)
+	}
+}
+
+func TestManifestScheduler_FairQueueing(t *testing.T) {
+	requests := []scheduledRequest{
+		{name: "monorepo-1", repoURL: monorepoURL, project: "default"},
+		{name: "monorepo-2", repoURL: monorepoURL, project: "default"},
+		{name: "monorepo-3", repoURL: monorepoURL, project: "default"},
+		{name: "monorepo-4", repoURL: monorepoURL, project: "default"},
+		{name: "other-1", repoURL: otherURL, project: "default"},
+		{name: "other-2", repoURL: otherURL, project: "default"},
+	}
+
+	t.Run("EqualWeights", func(t *testing.T) {
+		scheduler := newManifestScheduler(1, nil, nil, metrics.NewMetricsServer())
+		order := runScheduled(t, scheduler, requests)
+		assert.Equal(t, []string{"monorepo-1", "other-1", "monorepo-2", "other-2", "monorepo-3", "monorepo-4"}, order)
+	})
+
+	t.Run("RepoWeights", func(t *testing.T) {
+		scheduler := newManifestScheduler(1, map[string]float64{"https://github.com/example/other": 2}, nil, metrics.NewMetricsServer())
+		order := runScheduled(t, scheduler, requests)
+		assert.Equal(t, []string{"monorepo-1", "other-1", "other-2", "monorepo-2", "monorepo-3", "monorepo-4"}, order)
+	})
+
+	t.Run("ProjectWeights", func(t *testing.T) {
+		scheduler := newManifestScheduler(1, nil, map[string]float64{"default": 0.5}, metrics.NewMetricsServer())
+		order := runScheduled(t, scheduler, []scheduledRequest{
+			{name: "default-1", repoURL: monorepoURL, project: "default"},
+			{name: "default-2", repoURL: monorepoURL, project: "default"},
+			{name: "production-1", repoURL: monorepoURL, project: "production"},
+			{name: "production-2", repoURL: monorepoURL, project: "production"},
+			{name: "production-3", repoURL: monorepoURL, project: "production"},
+		})
+		assert.Equal(t, []string{"default-1", "production-1", "production-2", "default-2", "production-3"}, order)
+	})
+}
+
+func TestManifestScheduler_InteractivePriority(t *testing.T) {
+	scheduler := newManifestScheduler(1, nil, nil, metrics.NewMetricsServer())
+	order := runScheduled(t, scheduler, []scheduledRequest{
+		{name: "background-1", repoURL: otherURL, project: "default"},
+		{name: "background-2", repoURL: monorepoURL, project: "default"},
+		{name: "interactive", repoURL: monorepoURL, project: "default", interactive: true},
+	})
+	assert.Equal(t, []string{"interactive", "background-1", "background-2"}, order)
+}
+
+func TestManifestScheduler_Cancel(t *testing.T) {
+	scheduler := newManifestScheduler(1, nil, nil, metrics.NewMetricsServer())
+	done, err := scheduler.Acquire(t.Context(), monorepoURL, "default", false)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := scheduler.Acquire(ctx, monorepoURL, "default", false)
+		errCh <- err
+	}()
+	waitQueued(t, scheduler, 1)
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+	waitQueued(t, scheduler, 0)
+
+	done()
+	done, err = scheduler.Acquire(t.Context(), otherURL, "default", false)
+	require.NoError(t, err)
+	done()
+	assert.Equal(t, int64(0), scheduler.running)
+}
+
+func TestManifestScheduler_CancelRollsBackFlow(t *testing.T) {
+	scheduler := newManifestScheduler(1, nil, nil, metrics.NewMetricsServer())
+	done, err := scheduler.Acquire(t.Context(), "https://github.com/example/holder.git", "default", false)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := scheduler.Acquire(ctx, monorepoURL, "default", false)
+		errCh <- err
+	}()
+	waitQueued(t, scheduler, 1)
+
+	order := make(chan string, 2)
+	for i, req := range []scheduledRequest{{name: "monorepo", repoURL: monorepoURL}, {name: "other", repoURL: otherURL}} {
+		go func() {
+			done, err := scheduler.Acquire(t.Context(), req.repoURL, "default", false)
+			if !assert.NoError(t, err) {
+				return
+			}
+			order <- req.name
+			done()
+		}()
+		waitQueued(t, scheduler, i+2)
+	}
+
+	// the cancelled request no longer delays the next request of its flow
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+	waitQueued(t, scheduler, 2)
+	done()
+	assert.Equal(t, "monorepo", <-order)
+	assert.Equal(t, "other", <-order)
+}
+
+func TestManifestScheduler_ForgetsIdleFlows(t *testing.T) {
+	scheduler := newManifestScheduler(1, nil, nil, metrics.NewMetricsServer())
+	for _, repoURL := range []string{monorepoURL, otherURL, monorepoURL} {
+		done, err := scheduler.Acquire(t.Context(), repoURL, "default", false)
+		require.NoError(t, err)
+		done()
+	}
+	assert.Empty(t, scheduler.lastFinish)
+
+	runScheduled(t, scheduler, []scheduledRequest{
+		{name: "monorepo-1", repoURL: monorepoURL, project: "default"},
+		{name: "monorepo-2", repoURL: monorepoURL, project: "default"},
+		{name: "other-1", repoURL: otherURL, project: "default"},
+	})
+	require.Eventually(t, func() bool {
+		scheduler.lock.Lock()
+		defer scheduler.lock.Unlock()
+		return scheduler.running == 0 && len(scheduler.lastFinish) == 0
+	}, 10*time.Second, time.Millisecond)
+}
+
+func TestParseSchedulerWeights(t *testing.T) {
+	weights, err := ParseSchedulerWeights(map[string]string{"default": "2", "https://github.com/example/monorepo.git": "0.25"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"default": 2, "https://github.com/example/monorepo.git": 0.25}, weights)
+
+	_, err = ParseSchedulerWeights(map[string]string{"default": "0"})
+	require.EqualError(t, err, `invalid scheduler weight "0" of default: must be a positive number`)
+
+	_, err = ParseSchedulerWeights(map[string]string{"default": "high"})
+	require.Error(t, err)
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// sparseCheckouts tracks the paths checked out in the working tree of each repository configured for sparse checkout.
+// The paths only ever grow, so that a working tree checked out for an application also includes the paths of the
+// applications which were previously checked out, and can be shared by concurrent operations on the same revision.
+type sparseCheckouts struct {
+	lock   sync.Mutex
+	byRoot map[string]*sparseCheckout
+}
+
+type sparseCheckout struct {
+	paths map[string]bool
+	// generation is incremented whenever paths are added
+	generation int
+}
+
+func newSparseCheckouts() *sparseCheckouts {
+	return &sparseCheckouts{byRoot: map[string]*sparseCheckout{}}
+}
+
+// add adds the given paths to the paths of the working tree with the given root, and returns all of its paths along
+// with their generation
+func (c *sparseCheckouts) add(root string, paths []string) ([]string, int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	checkout, ok := c.byRoot[root]
+	if !ok {
+		checkout = &sparseCheckout{paths: map[string]bool{}}
+		c.byRoot[root] = checkout
+	}
+	for _, p := range paths {
+		if !checkout.paths[p] {
+			checkout.paths[p] = true
+			checkout.generation++
+		}
+	}
+	all := make([]string, 0, len(checkout.paths))
+	for p := range checkout.paths {
+		all = append(all, p)
+	}
+	slices.Sort(all)
+	return all, checkout.generation
+}
+
+// sparseCheckoutOpts returns the git client options checking out the given paths of the given repository, along with
+// the suffix of the revision its working tree is locked with, which only allows concurrent operations on the same
+// revision to share the working tree if it includes their paths. The whole repository is checked out if no path is
+// given.
+func (s *Service) sparseCheckoutOpts(repo *v1alpha1.Repository, paths []string) ([]git.ClientOpts, string, error) {
+	if len(paths) == 0 {
+		return nil, "", nil
+	}
+	root, err := s.gitRepoPaths.GetPath(git.NormalizeGitURL(repo.Repo))
+	if err != nil {
+		return nil, "", err
+	}
+	paths, generation := s.sparseCheckouts.add(root, paths)
+	return []git.ClientOpts{git.WithSparseCheckout(paths)}, "|sparse:" + strconv.Itoa(generation), nil
+}
+
+// getSparseCheckoutPaths returns the paths of the repository, relative to its root, which need to be checked out to
+// generate the manifests of the given source if its repository is configured for sparse checkout: the path of the
+// source, its local Helm value files and post-renderer overlay, the files it references from sources with a ref to the
+// same repository, its Jsonnet libraries, and the paths of the manifest-generate-paths annotation. It returns nil,
+// i.e. the whole repository, otherwise.
+func getSparseCheckoutPaths(repo *v1alpha1.Repository, source *v1alpha1.ApplicationSource, annotationManifestGeneratePaths string, refSources map[string]*v1alpha1.RefTarget) []string {
+	if repo == nil || !repo.SparseCheckout || source == nil || source.IsHelm() || source.IsOCI() {
+		return nil
+	}
+	appPath := path.Join("/", source.Path)
+	paths := []string{appPath}
+	if source.Helm != nil {
+		for _, valueFile := range getHelmRefCandidates(source.Helm) {
+			if strings.HasPrefix(valueFile, "$") {
+				refVar, refPath, _ := strings.Cut(valueFile, "/")
+				if refSource, ok := refSources[refVar]; ok && git.SameURL(refSource.Repo.Repo, source.RepoURL) {
+					paths = append(paths, path.Join("/", refPath))
+				}
+			} else if !strings.Contains(valueFile, "://") {
+				paths = append(paths, resolveSparseCheckoutPath(appPath, valueFile))
+			}
+		}
+	}
+	if source.Directory != nil {
+		for _, lib := range source.Directory.Jsonnet.Libs {
+			// the jsonnet library path is relative to the repository root, not application path
+			paths = append(paths, path.Join("/", lib))
+		}
+	}
+	for annotationPath := range strings.SplitSeq(annotationManifestGeneratePaths, ";") {
+		if annotationPath != "" {
+			paths = append(paths, resolveSparseCheckoutPath(appPath, annotationPath))
+		}
+	}
+	result := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if p == "/" {
+			return nil
+		}
+		result = append(result, strings.TrimPrefix(p, "/"))
+	}
+	slices.Sort(result)
+	return slices.Compact(result)
+}
+
+// resolveSparseCheckoutPath resolves the given path, which is either relative to the application path or absolute
+// from the root of the repository, to an absolute path from the root of the repository
+func resolveSparseCheckoutPath(appPath, p string) string {
+	if path.IsAbs(p) {
+		return path.Clean(p)
+	}
+	return path.Join(appPath, p)
+}
+
+// sparseCheckoutError adds to an error generating manifests from a sparse checkout of the given paths that files
+// outside of these paths are missing, since they are the most likely cause of the error
+func sparseCheckoutError(err error, paths []string) error {
+	return fmt.Errorf("%w (the repository is configured for sparse checkout, which only checks out %s: any other file the manifests are generated from, e.g. a Kustomize base in a parent directory, must be listed in the argocd.argoproj.io/manifest-generate-paths annotation)", err, strings.Join(paths, ", "))
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_getSparseCheckoutPaths(t *testing.T) {
+	sparseRepo := &v1alpha1.Repository{Repo: "https://github.com/example/monorepo.git", SparseCheckout: true}
+
+	t.Run("NotSparse", func(t *testing.T) {
+		source := &v1alpha1.ApplicationSource{RepoURL: sparseRepo.Repo, Path: "apps/guestbook"}
+		assert.Nil(t, getSparseCheckoutPaths(&v1alpha1.Repository{Repo: sparseRepo.Repo}, source, "", nil))
+	})
+
+	t.Run("HelmChart", func(t *testing.T) {
+		source := &v1alpha1.ApplicationSource{RepoURL: sparseRepo.Repo, Chart: "guestbook"}
+		assert.Nil(t, getSparseCheckoutPaths(sparseRepo, source, "", nil))
+	})
+
+	t.Run("AppPath", func(t *testing.T) {
+		source := &v1alpha1.ApplicationSource{RepoURL: sparseRepo.Repo, Path: "./apps/guestbook/"}
+		assert.Equal(t, []string{"apps/guestbook"}, getSparseCheckoutPaths(sparseRepo, source, "", nil))
+	})
+
+	t.Run("ManifestGeneratePaths", func(t *testing.T) {
+		source := &v1alpha1.ApplicationSource{RepoURL: sparseRepo.Repo, Path: "apps/guestbook"}
+		paths := getSparseCheckoutPaths(sparseRepo, source, ".;../../base;/shared/config.yaml", nil)
+		assert.Equal(t, []string{"apps/guestbook", "base", "shared/config.yaml"}, paths)
+	})
+
+	t.Run("JsonnetLibs", func(t *testing.T) {
+		source := &v1alpha1.ApplicationSource{
+			RepoURL:   sparseRepo.Repo,
+			Path:      "apps/guestbook",
+			Directory: &v1alpha1.ApplicationSourceDirectory{Jsonnet: v1alpha1.ApplicationSourceJsonnet{Libs: []string{"vendor", "/lib/"}}},
+		}
+		assert.Equal(t, []string{"apps/guestbook", "lib", "vendor"}, getSparseCheckoutPaths(sparseRepo, source, "", nil))
+	})
+
+	t.Run("RepositoryRoot", func(t *testing.T) {
+		source := &v1alpha1.ApplicationSource{RepoURL: sparseRepo.Repo, Path: "apps/guestbook"}
+		assert.Nil(t, getSparseCheckoutPaths(sparseRepo, source, "/", nil))
+		assert.Nil(t, getSparseCheckoutPaths(sparseRepo, &v1alpha1.ApplicationSource{RepoURL: sparseRepo.Repo, Path: "."}, "", nil))
+	})
+
+	t.Run("HelmValueFiles", func(t *testing.T) {
+		source := &v1alpha1.ApplicationSource{
+			RepoURL: sparseRepo.Repo,
+			Path:    "charts/guestbook",
+			Helm: &v1alpha1.ApplicationSourceHelm{
+				ValueFiles:     []string{"values.yaml", "../../envs/prod/values.yaml", "https://example.com/values.yaml", "$values/envs/common.yaml", "$other/values.yaml"},
+				FileParameters: []v1alpha1.HelmFileParameter{{Name: "config", Path: "$values/config/app.json"}},
+			},
+		}
+		refSources := map[string]*v1alpha1.RefTarget{
+			"$values": {Repo: v1alpha1.Repository{Repo: "https://github.com/example/monorepo"}},
+			"$other":  {Repo: v1alpha1.Repository{Repo: "https://github.com/example/other.git"}},
+		}
+		paths := getSparseCheckoutPaths(sparseRepo, source, "", refSources)
+		assert.Equal(t, []string{"charts/guestbook", "charts/guestbook/values.yaml", "config/app.json", "envs/common.yaml", "envs/prod/values.yaml"}, paths)
+	})
+}
+
+func Test_sparseCheckouts_add(t *testing.T) {
+	checkouts := newSparseCheckouts()
+
+	paths, generation := checkouts.add("/tmp/repo", []string{"apps/b", "apps/a"})
+	assert.Equal(t, []string{"apps/a", "apps/b"}, paths)
+
+	// known paths share the working tree of the same generation
+	paths, sameGeneration := checkouts.add("/tmp/repo", []string{"apps/a"})
+	assert.Equal(t, []string{"apps/a", "apps/b"}, paths)
+	assert.Equal(t, generation, sameGeneration)
+
+	paths, nextGeneration := checkouts.add("/tmp/repo", []string{"apps/c"})
+	assert.Equal(t, []string{"apps/a", "apps/b", "apps/c"}, paths)
+	assert.NotEqual(t, generation, nextGeneration)
+
+	paths, _ = checkouts.add("/tmp/other", []string{"apps/d"})
+	assert.Equal(t, []string{"apps/d"}, paths)
+}
+
+func Test_sparseCheckoutError(t *testing.T) {
+	cause := errors.New("accumulating resources: '../base' does not exist")
+	err := sparseCheckoutError(cause, []string{"apps/guestbook", "shared"})
+	require.ErrorIs(t, err, cause)
+	assert.ErrorContains(t, err, "only checks out apps/guestbook, shared")
+	assert.ErrorContains(t, err, "argocd.argoproj.io/manifest-generate-paths")
+}
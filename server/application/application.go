@@ -352,6 +352,9 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, a.RBACName(s.ns)); err != nil {
 		return nil, err
 	}
+	if err := s.enforcePromotionApproval(ctx, a, nil, a.Annotations); err != nil {
+		return nil, err
+	}
 
 	s.projectLock.RLock(a.Spec.GetProject())
 	defer s.projectLock.RUnlock(a.Spec.GetProject())
@@ -430,6 +433,7 @@ func (s *Server) queryRepoServer(ctx context.Context, proj *v1alpha1.AppProject,
 	helmCreds []*v1alpha1.RepoCreds,
 	ociRepos []*v1alpha1.Repository,
 	ociCreds []*v1alpha1.RepoCreds,
+	gitRepos []*v1alpha1.Repository,
 	helmOptions *v1alpha1.HelmOptions,
 	enabledSourceTypes map[string]bool,
 ) error,
@@ -481,8 +485,16 @@ func (s *Server) queryRepoServer(ctx context.Context, proj *v1alpha1.AppProject,
 	if err != nil {
 		return fmt.Errorf("failed to get permitted OCI credentials for project %q: %w", proj.Name, err)
 	}
+	gitRepos, err := s.db.ListGitRepositories(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list Git repositories: %w", err)
+	}
+	permittedGitRepos, err := argo.GetPermittedRepos(proj, gitRepos)
+	if err != nil {
+		return fmt.Errorf("failed to get permitted Git repositories for project %q: %w", proj.Name, err)
+	}
 
-	return action(client, permittedHelmRepos, permittedHelmCredentials, permittedOCIRepos, permittedOCICredentials, helmOptions, enabledSourceTypes)
+	return action(client, permittedHelmRepos, permittedHelmCredentials, permittedOCIRepos, permittedOCICredentials, permittedGitRepos, helmOptions, enabledSourceTypes)
 }
 
 // GetManifests returns application manifests
@@ -501,7 +513,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 
 	manifestInfos := make([]*apiclient.ManifestResponse, 0)
 	err = s.queryRepoServer(ctx, proj, func(
-		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, ociRepos []*v1alpha1.Repository, ociCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
+		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, ociRepos []*v1alpha1.Repository, ociCreds []*v1alpha1.RepoCreds, gitRepos []*v1alpha1.Repository, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
 	) error {
 		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
 		if err != nil {
@@ -548,6 +560,11 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("failed to get ref sources: %w", err)
 		}
 
+		manifestPolicies, err := argo.GetManifestPolicies(proj, s.settingsMgr)
+		if err != nil {
+			return fmt.Errorf("error getting manifest policies: %w", err)
+		}
+
 		for _, source := range sources {
 			repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
 			if err != nil {
@@ -602,6 +619,9 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 				InstallationID:                  installationID,
 				NoCache:                         q.NoCache != nil && *q.NoCache,
+				GitRepos:                        gitRepos,
+				Interactive:                     true,
+				ManifestPolicies:                manifestPolicies,
 			})
 			if err != nil {
 				return fmt.Errorf("error generating manifests: %w", err)
@@ -635,6 +655,12 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			}
 		}
 		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+		// the provenance of each source is kept so that the combined manifests can be verified per source
+		provenance := manifestInfo.Provenance
+		if provenance == nil {
+			provenance = &apiclient.ManifestProvenance{}
+		}
+		manifests.SourceProvenances = append(manifests.SourceProvenances, provenance)
 	}
 
 	return manifests, nil
@@ -658,7 +684,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 
 	var manifestInfo *apiclient.ManifestResponse
 	err = s.queryRepoServer(ctx, proj, func(
-		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, _ []*v1alpha1.Repository, _ []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
+		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, _ []*v1alpha1.Repository, _ []*v1alpha1.RepoCreds, gitRepos []*v1alpha1.Repository, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
 	) error {
 		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
 		if err != nil {
@@ -720,6 +746,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
 			AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
+			GitRepos:                        gitRepos,
 		}
 
 		repoStreamClient, err := client.GenerateManifestWithFiles(stream.Context())
@@ -819,6 +846,7 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 			_ []*v1alpha1.RepoCreds,
 			_ []*v1alpha1.Repository,
 			_ []*v1alpha1.RepoCreds,
+			_ []*v1alpha1.Repository,
 			helmOptions *v1alpha1.HelmOptions,
 			enabledSourceTypes map[string]bool,
 		) error {
@@ -999,6 +1027,7 @@ func (s *Server) waitSync(app *v1alpha1.Application) {
 
 func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newApp *v1alpha1.Application, merge bool) (*v1alpha1.Application, error) {
 	for range 10 {
+		oldAnnotations := app.Annotations
 		app.Spec = newApp.Spec
 		if merge {
 			app.Labels = collections.Merge(app.Labels, newApp.Labels)
@@ -1010,6 +1039,10 @@ func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newAp
 
 		app.Finalizers = newApp.Finalizers
 
+		if err := s.enforcePromotionApproval(ctx, app, oldAnnotations, app.Annotations); err != nil {
+			return nil, err
+		}
+
 		res, err := s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Update(ctx, app, metav1.UpdateOptions{})
 		if err == nil {
 			s.logAppEvent(ctx, app, argo.EventReasonResourceUpdated, "updated application spec")
@@ -1029,6 +1062,15 @@ func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newAp
 	return nil, status.Errorf(codes.Internal, "Failed to update application. Too many conflicts")
 }
 
+// enforcePromotionApproval checks that the user is permitted to approve the operations of the application if the
+// approve-promotion annotation is changed, since it approves the promotion of hydrated manifests to gated stages
+func (s *Server) enforcePromotionApproval(ctx context.Context, app *v1alpha1.Application, oldAnnotations, newAnnotations map[string]string) error {
+	if oldAnnotations[v1alpha1.AnnotationKeyApprovePromotion] == newAnnotations[v1alpha1.AnnotationKeyApprovePromotion] {
+		return nil
+	}
+	return s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionApprove, app.RBACName(s.ns))
+}
+
 // Update updates an application
 func (s *Server) Update(ctx context.Context, q *application.ApplicationUpdateRequest) (*v1alpha1.Application, error) {
 	if q.GetApplication() == nil {
@@ -2470,6 +2512,86 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
 }
 
+// ApproveOperation approves the sync operation of an application that is waiting for approvals
+func (s *Server) ApproveOperation(ctx context.Context, q *application.OperationApprovalRequest) (*application.ApplicationResponse, error) {
+	return s.reviewOperation(ctx, q, false)
+}
+
+// RejectOperation rejects the sync operation of an application that is waiting for approvals
+func (s *Server) RejectOperation(ctx context.Context, q *application.OperationApprovalRequest) (*application.ApplicationResponse, error) {
+	return s.reviewOperation(ctx, q, true)
+}
+
+func (s *Server) reviewOperation(ctx context.Context, q *application.OperationApprovalRequest, reject bool) (*application.ApplicationResponse, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionApprove, q.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+	username := session.Username(ctx)
+	if username == "" {
+		return nil, status.Errorf(codes.PermissionDenied, "Unable to review operation. User is unknown")
+	}
+
+	for range 10 {
+		if err := validateOperationReview(a, proj, username, reject); err != nil {
+			return nil, err
+		}
+		review := v1alpha1.OperationApproval{Username: username, Time: metav1.Now(), Message: q.GetMessage()}
+		action := "approved operation"
+		if reject {
+			a.Operation.Rejection = &review
+			action = "rejected operation"
+		} else {
+			a.Operation.Approvals = append(a.Operation.Approvals, review)
+		}
+		_, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, action)
+			return &application.ApplicationResponse{}, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to review operation for app %q due to update conflict. retrying again...", appName)
+		time.Sleep(100 * time.Millisecond)
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to review operation. Too many conflicts")
+}
+
+// validateOperationReview returns an error if the given user cannot approve or reject the operation of the application
+func validateOperationReview(a *v1alpha1.Application, proj *v1alpha1.AppProject, username string, reject bool) error {
+	op := a.Operation
+	if op == nil || op.Sync == nil {
+		return status.Errorf(codes.InvalidArgument, "Unable to review operation. No sync operation is in progress")
+	}
+	required := proj.RequiredApprovals(a)
+	if required == 0 || op.Sync.DryRun {
+		return status.Errorf(codes.FailedPrecondition, "Unable to review operation. The operation does not require approval")
+	}
+	if op.Rejection != nil {
+		return status.Errorf(codes.FailedPrecondition, "Unable to review operation. The operation was rejected by %s", op.Rejection.Username)
+	}
+	if int64(len(op.Approvals)) >= required {
+		return status.Errorf(codes.FailedPrecondition, "Unable to review operation. The operation was already approved")
+	}
+	if reject {
+		return nil
+	}
+	if op.InitiatedBy.Username == username {
+		return status.Errorf(codes.PermissionDenied, "Unable to approve operation. Users cannot approve operations they initiated")
+	}
+	if op.IsApprovedBy(username) {
+		return status.Errorf(codes.FailedPrecondition, "Unable to approve operation. The operation was already approved by %s", username)
+	}
+	return nil
+}
+
 func (s *Server) logAppEvent(ctx context.Context, a *v1alpha1.Application, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
 	user := session.Username(ctx)
@@ -2829,6 +2951,18 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 	return res, nil
 }
 
+// GetDryRunReport returns the report of the last dry-run sync operation of the application
+func (s *Server) GetDryRunReport(ctx context.Context, q *application.ApplicationDryRunReportQuery) (*v1alpha1.DryRunReport, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if a.Status.OperationState == nil || a.Status.OperationState.DryRunReport == nil {
+		return nil, status.Errorf(codes.NotFound, "application %s has no dry-run report", a.QualifiedName())
+	}
+	return a.Status.OperationState.DryRunReport, nil
+}
+
 func (s *Server) inferResourcesStatusHealth(app *v1alpha1.Application) {
 	if app.Status.ResourceHealthSource == v1alpha1.ResourceHealthLocationAppTree {
 		tree := &v1alpha1.ApplicationTree{}
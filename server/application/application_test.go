@@ -1041,6 +1041,17 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("GetDryRunReport", func(t *testing.T) {
+		_, err := appServer.GetDryRunReport(adminCtx, &application.ApplicationDryRunReportQuery{Name: new("test")})
+		require.EqualError(t, err, "rpc error: code = NotFound desc = application default/test has no dry-run report")
+		_, err = appServer.GetDryRunReport(noRoleCtx, &application.ApplicationDryRunReportQuery{Name: new("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.GetDryRunReport(adminCtx, &application.ApplicationDryRunReportQuery{Name: new("doest-not-exist")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.GetDryRunReport(adminCtx, &application.ApplicationDryRunReportQuery{Name: new("doest-not-exist"), Project: new("test")})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("GetManifestsWithFiles", func(t *testing.T) {
 		err := appServer.GetManifestsWithFiles(&TestServerStream{ctx: adminCtx, appName: "test"})
 		require.NoError(t, err)
@@ -2904,6 +2915,22 @@ func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 	})
 }
 
+func TestServer_GetDryRunReport(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Status.OperationState = &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{DryRun: true}},
+		Phase:     synccommon.OperationSucceeded,
+		DryRunReport: &v1alpha1.DryRunReport{
+			Resources: []v1alpha1.DryRunResourceResult{{Kind: "Deployment", Name: "guestbook", Action: v1alpha1.DryRunActionUpdate}},
+		},
+	}
+	appServer := newTestAppServer(t, testApp)
+
+	report, err := appServer.GetDryRunReport(t.Context(), &application.ApplicationDryRunReportQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, testApp.Status.OperationState.DryRunReport, report)
+}
+
 func TestGetCachedAppState(t *testing.T) {
 	testApp := newTestApp()
 	testApp.ResourceVersion = "1"
@@ -4573,6 +4600,141 @@ func TestServerSideDiff(t *testing.T) {
 	})
 }
 
+func TestReviewOperation(t *testing.T) {
+	approvalProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "approval-proj", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:   []string{"*"},
+			Destinations:  []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			ApprovalGates: []v1alpha1.ApprovalGate{{RequiredApprovals: 2}},
+		},
+	}
+	newApp := func() *v1alpha1.Application {
+		testApp := newTestApp()
+		testApp.Spec.Project = approvalProj.Name
+		testApp.Operation = &v1alpha1.Operation{
+			Sync:        &v1alpha1.SyncOperation{},
+			InitiatedBy: v1alpha1.OperationInitiator{Username: "alice"},
+		}
+		return testApp
+	}
+	userCtx := func(username string) context.Context {
+		return context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": username})
+	}
+
+	t.Run("Approve", func(t *testing.T) {
+		appServer := newTestAppServer(t, newApp(), approvalProj)
+
+		_, err := appServer.ApproveOperation(userCtx("alice"), &application.OperationApprovalRequest{Name: new("test-app")})
+		require.EqualError(t, err, "rpc error: code = PermissionDenied desc = Unable to approve operation. Users cannot approve operations they initiated")
+
+		_, err = appServer.ApproveOperation(userCtx("bob"), &application.OperationApprovalRequest{Name: new("test-app"), Message: new("CHG-1234")})
+		require.NoError(t, err)
+		_, err = appServer.ApproveOperation(userCtx("bob"), &application.OperationApprovalRequest{Name: new("test-app")})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = Unable to approve operation. The operation was already approved by bob")
+		_, err = appServer.ApproveOperation(userCtx("carol"), &application.OperationApprovalRequest{Name: new("test-app")})
+		require.NoError(t, err)
+		_, err = appServer.ApproveOperation(userCtx("dave"), &application.OperationApprovalRequest{Name: new("test-app")})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = Unable to review operation. The operation was already approved")
+
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications("default").Get(t.Context(), "test-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Len(t, app.Operation.Approvals, 2)
+		assert.Equal(t, "bob", app.Operation.Approvals[0].Username)
+		assert.Equal(t, "CHG-1234", app.Operation.Approvals[0].Message)
+		assert.Equal(t, "carol", app.Operation.Approvals[1].Username)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		appServer := newTestAppServer(t, newApp(), approvalProj)
+
+		_, err := appServer.RejectOperation(userCtx("alice"), &application.OperationApprovalRequest{Name: new("test-app"), Message: new("not now")})
+		require.NoError(t, err)
+		_, err = appServer.ApproveOperation(userCtx("bob"), &application.OperationApprovalRequest{Name: new("test-app")})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = Unable to review operation. The operation was rejected by alice")
+
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications("default").Get(t.Context(), "test-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation.Rejection)
+		assert.Equal(t, "not now", app.Operation.Rejection.Message)
+	})
+
+	t.Run("NotRequired", func(t *testing.T) {
+		testApp := newApp()
+		testApp.Spec.Project = "default"
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.ApproveOperation(userCtx("bob"), &application.OperationApprovalRequest{Name: new("test-app")})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = Unable to review operation. The operation does not require approval")
+	})
+
+	t.Run("NoOperation", func(t *testing.T) {
+		testApp := newApp()
+		testApp.Operation = nil
+		appServer := newTestAppServer(t, testApp, approvalProj)
+
+		_, err := appServer.RejectOperation(userCtx("bob"), &application.OperationApprovalRequest{Name: new("test-app")})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = Unable to review operation. No sync operation is in progress")
+	})
+
+	t.Run("RequiresApprovePermission", func(t *testing.T) {
+		appServer := newTestAppServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}, map[string]string{}, newApp(), approvalProj)
+
+		_, err := appServer.ApproveOperation(userCtx("bob"), &application.OperationApprovalRequest{Name: new("test-app")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error())
+	})
+}
+
+func TestPromotionApproval(t *testing.T) {
+	newAppServer := func(t *testing.T) *Server {
+		t.Helper()
+		return newTestAppServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(`p, role:updater, applications, get, */*, allow
+p, role:updater, applications, create, */*, allow
+p, role:updater, applications, update, */*, allow
+p, role:approver, applications, approve, */*, allow
+g, bob, role:approver`)
+			enf.SetDefaultRole("role:updater")
+		}, map[string]string{}, newTestApp())
+	}
+	userCtx := func(username string) context.Context {
+		return context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": username})
+	}
+	approvePatch := `{"metadata":{"annotations":{"argocd.argoproj.io/approve-promotion":"env/prod=abc123"}}}`
+
+	t.Run("Patch", func(t *testing.T) {
+		appServer := newAppServer(t)
+
+		_, err := appServer.Patch(userCtx("alice"), &application.ApplicationPatchRequest{Name: new("test-app"), Patch: new(approvePatch), PatchType: new("merge")})
+		require.ErrorContains(t, err, "permission denied: applications, approve, default/test-app, sub: alice")
+		_, err = appServer.Patch(userCtx("alice"), &application.ApplicationPatchRequest{Name: new("test-app"), Patch: new(`{"metadata":{"annotations":{"foo":"bar"}}}`), PatchType: new("merge")})
+		require.NoError(t, err)
+
+		app, err := appServer.Patch(userCtx("bob"), &application.ApplicationPatchRequest{Name: new("test-app"), Patch: new(approvePatch), PatchType: new("merge")})
+		require.NoError(t, err)
+		assert.True(t, app.IsPromotionApproved("env/prod", "abc123"))
+
+		// the approval is kept by updates which do not change it
+		_, err = appServer.Patch(userCtx("alice"), &application.ApplicationPatchRequest{Name: new("test-app"), Patch: new(`{"metadata":{"annotations":{"foo":"baz"}}}`), PatchType: new("merge")})
+		require.NoError(t, err)
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		appServer := newAppServer(t)
+		app := newTestApp()
+		app.Name = "other-app"
+		app.Annotations = map[string]string{v1alpha1.AnnotationKeyApprovePromotion: "env/prod=abc123"}
+
+		_, err := appServer.Create(userCtx("alice"), &application.ApplicationCreateRequest{Application: app})
+		require.ErrorContains(t, err, "permission denied: applications, approve, default/other-app, sub: alice")
+		_, err = appServer.Create(userCtx("bob"), &application.ApplicationCreateRequest{Application: app})
+		require.NoError(t, err)
+	})
+}
+
 // TestTerminateOperationWithConflicts tests that TerminateOperation properly handles
 // concurrent update conflicts by retrying with the fresh application object.
 //
@@ -95,6 +95,11 @@ func (s *Server) Get(ctx context.Context, _ *settingspkg.SettingsQuery) (*settin
 		return nil, err
 	}
 
+	manifestProvenancePublicKey, err := s.mgr.GetManifestProvenancePublicKey()
+	if err != nil {
+		return nil, err
+	}
+
 	set := settingspkg.Settings{
 		URL:                argoCDSettings.URL,
 		AdditionalURLs:     argoCDSettings.AdditionalURLs,
@@ -113,16 +118,17 @@ func (s *Server) Get(ctx context.Context, _ *settingspkg.SettingsQuery) (*settin
 			ChatText:   help.ChatText,
 			BinaryUrls: help.BinaryURLs,
 		},
-		UserLoginsDisabled:        userLoginsDisabled,
-		KustomizeVersions:         kustomizeVersions,
-		UiCssURL:                  argoCDSettings.UiCssURL,
-		TrackingMethod:            trackingMethod,
-		InstallationID:            installationID,
-		ExecEnabled:               argoCDSettings.ExecEnabled,
-		AppsInAnyNamespaceEnabled: s.appsInAnyNamespaceEnabled,
-		ImpersonationEnabled:      argoCDSettings.ImpersonationEnabled,
-		HydratorEnabled:           s.hydratorEnabled,
-		SyncWithReplaceAllowed:    s.syncWithReplaceAllowed,
+		UserLoginsDisabled:          userLoginsDisabled,
+		KustomizeVersions:           kustomizeVersions,
+		UiCssURL:                    argoCDSettings.UiCssURL,
+		TrackingMethod:              trackingMethod,
+		InstallationID:              installationID,
+		ExecEnabled:                 argoCDSettings.ExecEnabled,
+		AppsInAnyNamespaceEnabled:   s.appsInAnyNamespaceEnabled,
+		ImpersonationEnabled:        argoCDSettings.ImpersonationEnabled,
+		HydratorEnabled:             s.hydratorEnabled,
+		SyncWithReplaceAllowed:      s.syncWithReplaceAllowed,
+		ManifestProvenancePublicKey: manifestProvenancePublicKey,
 	}
 
 	if sessionmgr.LoggedIn(ctx) || s.disableAuth {
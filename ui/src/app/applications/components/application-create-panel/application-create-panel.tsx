@@ -22,6 +22,7 @@ const jsonMergePatch = require('json-merge-patch');
 const appTypes = new Array<{field: string; type: models.AppSourceType}>(
     {type: 'Helm', field: 'helm'},
     {type: 'Kustomize', field: 'kustomize'},
+    {type: 'CUE', field: 'cue'},
     {type: 'Directory', field: 'directory'},
     {type: 'Plugin', field: 'plugin'}
 );
@@ -476,6 +477,9 @@ export const ApplicationCreatePanel = (props: {
                                                         case 'Plugin':
                                                             details = {type, path: details.path, plugin: {name: '', env: []}};
                                                             break;
+                                                        case 'CUE':
+                                                            details = {type, path: details.path, cue: {tags: []}};
+                                                            break;
                                                         // Directory
                                                         default:
                                                             details = {type, path: details.path, directory: {}};
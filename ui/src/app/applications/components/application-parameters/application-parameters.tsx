@@ -356,6 +356,9 @@ export const ApplicationParameters = (props: {
                             if (updatedSrc && updatedSrc.helm?.parameters) {
                                 updatedSrc.helm.parameters = updatedSrc.helm.parameters.filter(isDefined);
                             }
+                            if (updatedSrc && updatedSrc.cue?.tags) {
+                                updatedSrc.cue.tags = updatedSrc.cue.tags.filter(isDefined);
+                            }
                             if (updatedSrc && updatedSrc.kustomize?.images) {
                                 updatedSrc.kustomize.images = updatedSrc.kustomize.images.filter(isDefinedWithVersion);
                             }
@@ -457,7 +460,7 @@ export const ApplicationParameters = (props: {
                 (src.chart ? ', CHART=' + src.chart + ':' + src.targetRevision : '') +
                 (src.path ? ', PATH=' + src.path : '') +
                 (src.targetRevision ? ', REVISION=' + src.targetRevision : '');
-        } else if (repoAppDetails.type === 'Kustomize') {
+        } else if (repoAppDetails.type === 'Kustomize' || repoAppDetails.type === 'CUE') {
             floatingTitle =
                 'Source ' +
                 (ind + 1) +
@@ -497,6 +500,9 @@ export const ApplicationParameters = (props: {
                         if (appSrc.helm && appSrc.helm.parameters) {
                             appSrc.helm.parameters = appSrc.helm.parameters.filter(isDefined);
                         }
+                        if (appSrc.cue && appSrc.cue.tags) {
+                            appSrc.cue.tags = appSrc.cue.tags.filter(isDefined);
+                        }
                         if (appSrc.kustomize && appSrc.kustomize.images) {
                             appSrc.kustomize.images = appSrc.kustomize.images.filter(isDefinedWithVersion);
                         }
@@ -847,6 +853,69 @@ function gatherDetails(
                 })
             )
         );
+    } else if (repoDetails.type === 'CUE') {
+        attributes.push({
+            title: 'PACKAGE',
+            view: source.cue && source.cue.package,
+            edit: (formApi: FormApi) => <FormField formApi={formApi} field={isMultiSource ? 'spec.sources[' + ind + '].cue.package' : 'spec.source.cue.package'} component={Text} />
+        });
+        attributes.push({
+            title: 'EXPRESSION',
+            view: source.cue && source.cue.expression,
+            edit: (formApi: FormApi) => <FormField formApi={formApi} field={isMultiSource ? 'spec.sources[' + ind + '].cue.expression' : 'spec.source.cue.expression'} component={Text} />
+        });
+        attributes.push({
+            title: 'VALUES FILES',
+            view: (source.cue && (source.cue.valuesFiles || []).join(', ')) || 'No values files selected',
+            edit: (formApi: FormApi) => (
+                <FormField
+                    formApi={formApi}
+                    field={isMultiSource ? 'spec.sources[' + ind + '].cue.valuesFiles' : 'spec.source.cue.valuesFiles'}
+                    component={TagsInputField}
+                    componentProps={{
+                        noTagsLabel: 'No values files selected'
+                    }}
+                />
+            )
+        });
+        attributes.push({
+            title: 'VALUES',
+            view: source.cue && (
+                <Expandable>
+                    <pre>{source.cue.values}</pre>
+                </Expandable>
+            ),
+            edit: (formApi: FormApi) => (
+                <div>
+                    <pre>
+                        <FormField formApi={formApi} field={isMultiSource ? 'spec.sources[' + ind + '].cue.values' : 'spec.source.cue.values'} component={TextArea} />
+                    </pre>
+                </div>
+            )
+        });
+        const tagsByName = new Map<string, models.CueTagAnnouncement>();
+        ((repoDetails.cue && repoDetails.cue.tags) || []).forEach(tag => tagsByName.set(tag.name, tag));
+        const overridesByName = new Map<string, number>();
+        ((source.cue && source.cue.tags) || []).forEach((override, i) => overridesByName.set(override.name, i));
+        attributes = attributes.concat(
+            getParamsEditableItems(
+                app,
+                'TAGS',
+                isMultiSource ? 'spec.sources[' + ind + '].cue.tags' : 'spec.source.cue.tags',
+                removedOverrides,
+                setRemovedOverrides,
+                distinct(tagsByName.keys(), overridesByName.keys()).map(name => {
+                    const tag = tagsByName.get(name);
+                    const original = (tag && tag.default) || '';
+                    let overrideIndex = overridesByName.get(name);
+                    if (overrideIndex === undefined) {
+                        overrideIndex = -1;
+                    }
+                    const value = (overrideIndex > -1 && source.cue.tags[overrideIndex].value) || original;
+                    return {overrideIndex, original, metadata: {name, value}};
+                })
+            )
+        );
     } else if (repoDetails.type === 'Plugin') {
         attributes.push({
             title: 'NAME',
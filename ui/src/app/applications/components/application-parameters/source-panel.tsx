@@ -14,6 +14,7 @@ import './source-panel.scss';
 const appTypes = new Array<{field: string; type: models.AppSourceType}>(
     {type: 'Helm', field: 'helm'},
     {type: 'Kustomize', field: 'kustomize'},
+    {type: 'CUE', field: 'cue'},
     {type: 'Directory', field: 'directory'},
     {type: 'Plugin', field: 'plugin'}
 );
@@ -370,6 +371,9 @@ export const SourcePanel = (props: {
                                                     case 'Plugin':
                                                         details = {type, path: details.path, plugin: {name: '', env: []}};
                                                         break;
+                                                    case 'CUE':
+                                                        details = {type, path: details.path, cue: {tags: []}};
+                                                        break;
                                                     // Directory
                                                     default:
                                                         details = {type, path: details.path, directory: {}};
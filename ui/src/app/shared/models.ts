@@ -28,6 +28,18 @@ export interface SyncOperationResource {
 export interface SyncStrategy {
     apply?: {force?: boolean};
     hook?: {force?: boolean};
+    batch?: {force?: boolean; batches: SyncBatch[]};
+}
+
+export interface LabelSelector {
+    matchLabels?: {[name: string]: string};
+    matchExpressions?: {key: string; operator: string; values?: string[]}[];
+}
+
+export interface SyncBatch {
+    name: string;
+    selector: LabelSelector;
+    analysisHook?: string;
 }
 
 export interface SyncOperation {
@@ -47,6 +59,12 @@ export interface RetryStrategy {
     limit: number;
     backoff: RetryBackoff;
     refresh: boolean;
+    resources?: ResourceRetryStrategy;
+}
+
+export interface ResourceRetryStrategy {
+    limit: number;
+    backoff?: RetryBackoff;
 }
 
 export interface RollbackOperation {
@@ -63,6 +81,14 @@ export interface OperationInitiator {
 export interface Operation {
     sync: SyncOperation;
     initiatedBy: OperationInitiator;
+    approvals?: OperationApproval[];
+    rejection?: OperationApproval;
+}
+
+export interface OperationApproval {
+    username: string;
+    time: models.Time;
+    message?: string;
 }
 
 export type OperationPhase = 'Running' | 'Error' | 'Failed' | 'Succeeded' | 'Terminating' | 'Progressing' | 'Pending' | 'Waiting';
@@ -88,6 +114,39 @@ export interface OperationState {
     syncResult: SyncOperationResult;
     startedAt: models.Time;
     finishedAt: models.Time;
+    syncBatches?: SyncBatchStatus[];
+    dryRunReport?: DryRunReport;
+}
+
+export type DryRunAction = 'Create' | 'Update' | 'Prune' | 'None';
+
+export interface DryRunReport {
+    resources?: DryRunResourceResult[];
+    hooks?: DryRunResourceResult[];
+    generatedAt: models.Time;
+}
+
+export interface DryRunResourceResult {
+    group: string;
+    version: string;
+    kind: string;
+    namespace: string;
+    name: string;
+    action: DryRunAction;
+    hookType?: HookType;
+    syncPhase?: string;
+    syncWave?: number;
+    message?: string;
+    validationError?: string;
+}
+
+export interface SyncBatchStatus {
+    name: string;
+    phase?: OperationPhase;
+    message?: string;
+    startedAt?: models.Time;
+    syncedAt?: models.Time;
+    finishedAt?: models.Time;
 }
 
 export type OperationStateTitle = 'Deleting' | 'Syncing' | 'Sync error' | 'Sync failed' | 'Sync OK' | 'Terminated' | 'Unknown';
@@ -153,6 +212,12 @@ export interface ResourceResult {
     hookType: HookType;
     hookPhase: OperationPhase;
     images?: string[];
+    syncWave?: number;
+    waveStartedAt?: models.Time;
+    waveDuration?: string;
+    syncBatch?: string;
+    retryCount?: number;
+    nextRetryAt?: models.Time;
 }
 
 export type SyncResourceResult = ResourceResult & {
@@ -244,6 +309,10 @@ export interface ApplicationSource {
 
     kustomize?: ApplicationSourceKustomize;
 
+    helmfile?: ApplicationSourceHelmfile;
+
+    cue?: ApplicationSourceCue;
+
     plugin?: ApplicationSourcePlugin;
 
     directory?: ApplicationSourceDirectory;
@@ -257,6 +326,9 @@ export interface SourceHydrator {
     drySource: DrySource;
     syncSource: SyncSource;
     hydrateTo?: HydrateTo;
+    pullRequest?: HydratorPullRequest;
+    promotionStages?: PromotionStage[];
+    layout?: HydratedLayout;
 }
 
 export interface DrySource {
@@ -270,6 +342,7 @@ export interface DrySource {
 }
 
 export interface SyncSource {
+    repoURL?: string;
     targetBranch: string;
     path: string;
 }
@@ -278,12 +351,41 @@ export interface HydrateTo {
     targetBranch: string;
 }
 
+export interface HydratorPullRequest {
+    provider: string;
+    api?: string;
+    labels?: string[];
+}
+
+export interface HydratedLayout {
+    splitManifests?: boolean;
+    kustomization?: boolean;
+    helmValues?: boolean;
+}
+
+export interface PromotionStage {
+    targetBranch: string;
+    gate?: PromotionGate;
+}
+
+export interface PromotionGate {
+    healthy?: boolean;
+    soakDuration?: string;
+    manualApproval?: boolean;
+}
+
 export interface ApplicationSourceHelm {
     valueFiles: string[];
     values?: string;
     valuesObject?: any;
     parameters: HelmParameter[];
     fileParameters: HelmFileParameter[];
+    postRenderer?: HelmPostRenderer;
+}
+
+export interface HelmPostRenderer {
+    plugin?: string;
+    kustomizePath?: string;
 }
 
 export interface ApplicationSourceKustomize {
@@ -293,6 +395,31 @@ export interface ApplicationSourceKustomize {
     version: string;
     namespace: string;
 }
+
+export interface ApplicationSourceHelmfile {
+    environment?: string;
+    selectors?: string[];
+    stateValuesFiles?: string[];
+    stateValues?: {[name: string]: string};
+    namespace?: string;
+    kubeVersion?: string;
+    apiVersions?: string[];
+    skipTests?: boolean;
+}
+
+export interface CueTag {
+    name: string;
+    value: string;
+}
+
+export interface ApplicationSourceCue {
+    package?: string;
+    expression?: string;
+    tags?: CueTag[];
+    valuesFiles?: string[];
+    values?: string;
+}
+
 export interface EnvEntry {
     name: string;
     value: string;
@@ -333,12 +460,33 @@ export interface Automated {
     prune: boolean;
     selfHeal: boolean;
     enabled: boolean;
+    selfHealPolicy?: SelfHealPolicy;
+}
+
+export interface SelfHealPolicy {
+    include?: SelfHealResourceSelector[];
+    exclude?: SelfHealResourceSelector[];
+}
+
+export interface SelfHealResourceSelector {
+    group?: string;
+    kind?: string;
+    name?: string;
+    labelSelector?: LabelSelector;
 }
 
 export interface SyncPolicy {
     automated?: Automated;
     syncOptions?: string[];
     retry?: RetryStrategy;
+    syncSchedule?: SyncSchedule;
+}
+
+export interface SyncSchedule {
+    schedule: string;
+    timeZone?: string;
+    prune?: boolean;
+    hardRefresh?: boolean;
 }
 
 export interface Info {
@@ -356,6 +504,12 @@ export interface ApplicationSpec {
     ignoreDifferences?: ResourceIgnoreDifferences[];
     info?: Info[];
     revisionHistoryLimit?: number;
+    dependsOn?: ApplicationDependency[];
+}
+
+export interface ApplicationDependency {
+    name: string;
+    namespace?: string;
 }
 
 export interface ResourceIgnoreDifferences {
@@ -485,6 +639,13 @@ export interface AbstractApplicationTree {
 export interface ApplicationTree extends AbstractApplicationTree {
     orphanedNodes: ResourceNode[];
     hosts: Node[];
+    dependencies?: ApplicationDependencyNode[];
+}
+
+export interface ApplicationDependencyNode extends ApplicationDependency {
+    syncStatus?: SyncStatusCode;
+    healthStatus?: HealthStatusCode;
+    dependsOn?: ApplicationDependency[];
 }
 
 export interface ApplicationSetTree extends AbstractApplicationTree {
@@ -535,13 +696,26 @@ export interface ApplicationStatus {
     operationState?: OperationState;
     summary?: ApplicationSummary;
     sourceHydrator?: SourceHydratorStatus;
+    lastScheduledSyncAt?: models.Time;
 }
 
 export interface SourceHydratorStatus {
     lastSuccessfulOperation?: SuccessfulHydrateOperation;
     currentOperation?: HydrateOperation;
+    promotionStages?: PromotionStageStatus[];
 }
 
+export interface PromotionStageStatus {
+    targetBranch: string;
+    phase?: PromotionStagePhase;
+    message?: string;
+    drySHA?: string;
+    hydratedSHA?: string;
+    promotedAt?: models.Time;
+}
+
+export type PromotionStagePhase = 'Waiting' | 'Failed' | 'Promoted';
+
 export interface HydrateOperation {
     startedAt: models.Time;
     finishedAt?: models.Time;
@@ -552,12 +726,14 @@ export interface HydrateOperation {
     // hydratedSHA is the sha of the hydrated commit. This will be empty if the operation is not successful.
     hydratedSHA: string;
     sourceHydrator: SourceHydrator;
+    pullRequestURL?: string;
 }
 
 export interface SuccessfulHydrateOperation {
     drySHA: string;
     hydratedSHA: string;
     sourceHydrator: SourceHydrator;
+    pullRequestURL?: string;
 }
 
 export type HydrateOperationPhase = 'Hydrating' | 'Failed' | 'Hydrated';
@@ -724,7 +900,7 @@ export interface HelmChart {
     versions: string[];
 }
 
-export type AppSourceType = 'Helm' | 'Kustomize' | 'Directory' | 'Plugin';
+export type AppSourceType = 'Helm' | 'Kustomize' | 'Helmfile' | 'CUE' | 'Directory' | 'Plugin';
 
 export interface RepoAppDetails {
     type: AppSourceType;
@@ -732,6 +908,7 @@ export interface RepoAppDetails {
     helm?: HelmAppSpec;
     kustomize?: KustomizeAppSpec;
     plugin?: PluginAppSpec;
+    cue?: CueAppSpec;
     directory?: {};
 }
 
@@ -770,6 +947,17 @@ export interface KustomizeAppSpec {
     namespace?: string;
 }
 
+export interface CueTagAnnouncement {
+    name: string;
+    type?: string;
+    options?: string[];
+    default?: string;
+}
+
+export interface CueAppSpec {
+    tags?: CueTagAnnouncement[];
+}
+
 export interface PluginAppSpec {
     name: string;
     env: EnvEntry[];
@@ -873,6 +1061,21 @@ export interface ProjectSpec {
     signatureKeys: ProjectSignatureKey[];
     orphanedResources?: {warn?: boolean; ignore: OrphanedResource[]};
     syncWindows?: SyncWindows;
+    approvalGates?: ApprovalGate[];
+    manifestPolicies?: ManifestPolicy[];
+}
+
+export interface ManifestPolicy {
+    configMap: string;
+    action?: 'warn' | 'deny';
+}
+
+export interface ApprovalGate {
+    applications?: string[];
+    namespaces?: string[];
+    clusters?: string[];
+    requiredApprovals: number;
+    description?: string;
 }
 
 export type SyncWindows = SyncWindow[];
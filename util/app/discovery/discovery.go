@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -19,6 +20,8 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
+	"github.com/argoproj/argo-cd/v3/util/cue"
+	"github.com/argoproj/argo-cd/v3/util/helmfile"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/kustomize"
 )
@@ -65,11 +68,30 @@ func Discover(ctx context.Context, appPath, repoPath string, enableGenerateManif
 		if kustomize.IsKustomization(base) && IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeKustomize, enableGenerateManifests) {
 			apps[dir] = string(v1alpha1.ApplicationSourceTypeKustomize)
 		}
+		if helmfileDir, ok := helmfile.StateDir(path); ok && IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeHelmfile, enableGenerateManifests) {
+			helmfileDir, err = filepath.Rel(appPath, helmfileDir)
+			if err != nil {
+				return err
+			}
+			apps[helmfileDir] = string(v1alpha1.ApplicationSourceTypeHelmfile)
+		}
+		if filepath.Ext(base) == ".cue" && IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeCue, enableGenerateManifests) && isCuePackageDir(filepath.Dir(path), repoPath) {
+			apps[dir] = string(v1alpha1.ApplicationSourceTypeCue)
+		}
 		return nil
 	})
 	return apps, err
 }
 
+// isCuePackageDir returns whether the given directory holds a package of a CUE module within the repository
+func isCuePackageDir(dir string, repoPath string) bool {
+	if slices.Contains(strings.Split(filepath.ToSlash(dir), "/"), cue.ModuleDirName) {
+		return false
+	}
+	_, ok := cue.FindModuleRoot(dir, repoPath)
+	return ok
+}
+
 func AppType(ctx context.Context, appPath, repoPath string, enableGenerateManifests map[string]bool, tarExcludedGlobs []string, env []string) (string, error) {
 	apps, err := Discover(ctx, appPath, repoPath, enableGenerateManifests, tarExcludedGlobs, env)
 	if err != nil {
@@ -14,8 +14,12 @@ func TestDiscover(t *testing.T) {
 	apps, err := Discover(t.Context(), "./testdata", "./testdata", map[string]bool{}, []string{}, []string{})
 	require.NoError(t, err)
 	assert.Equal(t, map[string]string{
-		"foo": "Kustomize",
-		"baz": "Helm",
+		"foo":  "Kustomize",
+		"baz":  "Helm",
+		"qux":  "Helmfile",
+		"quux": "Helmfile",
+		// the root of the CUE module holds no package
+		"corge/guestbook": "CUE",
 	}, apps)
 }
 
@@ -28,6 +32,18 @@ func TestAppType(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Helm", appType)
 
+	appType, err = AppType(t.Context(), "./testdata/qux", "./testdata", map[string]bool{}, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "Helmfile", appType)
+
+	appType, err = AppType(t.Context(), "./testdata/quux", "./testdata", map[string]bool{}, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "Helmfile", appType)
+
+	appType, err = AppType(t.Context(), "./testdata/corge/guestbook", "./testdata", map[string]bool{}, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "CUE", appType)
+
 	appType, err = AppType(t.Context(), "./testdata", "./testdata", map[string]bool{}, []string{}, []string{})
 	require.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
@@ -37,6 +53,8 @@ func TestAppType_Disabled(t *testing.T) {
 	enableManifestGeneration := map[string]bool{
 		string(v1alpha1.ApplicationSourceTypeKustomize): false,
 		string(v1alpha1.ApplicationSourceTypeHelm):      false,
+		string(v1alpha1.ApplicationSourceTypeHelmfile):  false,
+		string(v1alpha1.ApplicationSourceTypeCue):       false,
 	}
 	appType, err := AppType(t.Context(), "./testdata/foo", "./testdata", enableManifestGeneration, []string{}, []string{})
 	require.NoError(t, err)
@@ -46,6 +64,14 @@ func TestAppType_Disabled(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
 
+	appType, err = AppType(t.Context(), "./testdata/qux", "./testdata", enableManifestGeneration, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "Directory", appType)
+
+	appType, err = AppType(t.Context(), "./testdata/corge/guestbook", "./testdata", enableManifestGeneration, []string{}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "Directory", appType)
+
 	appType, err = AppType(t.Context(), "./testdata", "./testdata", enableManifestGeneration, []string{}, []string{})
 	require.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
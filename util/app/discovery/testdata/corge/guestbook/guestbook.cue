@@ -0,0 +1,9 @@
+package guestbook
+
+env: *"dev" | string @tag(env)
+
+configMap: {
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: name: "guestbook-\(env)"
+}
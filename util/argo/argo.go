@@ -355,6 +355,14 @@ func ValidateRepo(
 	if err != nil {
 		return nil, fmt.Errorf("failed to get permitted OCI credentials for project %q: %w", proj.Name, err)
 	}
+	gitRepos, err := db.ListGitRepositories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list git repositories: %w", err)
+	}
+	permittedGitRepos, err := GetPermittedRepos(proj, gitRepos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permitted git repositories for project %q: %w", proj.Name, err)
+	}
 
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
 	if err != nil {
@@ -389,6 +397,7 @@ func ValidateRepo(
 		repoClient,
 		permittedHelmRepos,
 		permittedOCIRepos,
+		permittedGitRepos,
 		helmOptions,
 		destCluster,
 		apiGroups,
@@ -435,6 +444,7 @@ func validateRepo(ctx context.Context,
 	repoClient apiclient.RepoServerServiceClient,
 	permittedHelmRepos []*argoappv1.Repository,
 	permittedOCIRepos []*argoappv1.Repository,
+	permittedGitRepos []*argoappv1.Repository,
 	helmOptions *argoappv1.HelmOptions,
 	cluster *argoappv1.Cluster,
 	apiGroups []kube.APIResourceInfo,
@@ -493,6 +503,7 @@ func validateRepo(ctx context.Context,
 		db,
 		permittedHelmRepos,
 		permittedOCIRepos,
+		permittedGitRepos,
 		helmOptions,
 		app,
 		proj,
@@ -630,6 +641,102 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 			Message: "when spec.sourceHydrator.hydrateTo is set, spec.sourceHydrator.hydrateTo.path is required",
 		})
 	}
+	if hydrator.PullRequest != nil {
+		if hydrator.PullRequest.Provider == "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "when spec.sourceHydrator.pullRequest is set, spec.sourceHydrator.pullRequest.provider is required",
+			})
+		}
+		if hydrator.HydrateTo != nil && hydrator.HydrateTo.TargetBranch == hydrator.SyncSource.TargetBranch {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "when spec.sourceHydrator.pullRequest is set, spec.sourceHydrator.hydrateTo.targetBranch must differ from spec.sourceHydrator.syncSource.targetBranch",
+			})
+		}
+	}
+	if hydrator.SyncSource.RepoURL != "" {
+		conditions = append(conditions, validateOCISyncSource(hydrator)...)
+	}
+	if len(hydrator.PromotionStages) > 0 {
+		conditions = append(conditions, validatePromotionStages(hydrator)...)
+	}
+	if hydrator.Layout != nil && hydrator.Layout.HelmValues && !hydrator.Layout.Kustomization {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "when spec.sourceHydrator.layout.helmValues is set, spec.sourceHydrator.layout.kustomization is required, since values.yaml would otherwise be synced as a manifest",
+		})
+	}
+	return conditions
+}
+
+// validatePromotionStages validates the promotion stages of a source hydrator, which promote the hydrated manifests
+// committed to the SyncSource target branch through other branches of the same repository.
+func validatePromotionStages(hydrator *argoappv1.SourceHydrator) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	if hydrator.HydrateTo != nil || hydrator.PullRequest != nil || hydrator.SyncSource.RepoURL != "" {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "spec.sourceHydrator.promotionStages cannot be combined with spec.sourceHydrator.hydrateTo, spec.sourceHydrator.pullRequest or spec.sourceHydrator.syncSource.repoURL",
+		})
+	}
+	branches := map[string]bool{hydrator.SyncSource.TargetBranch: true}
+	for i, stage := range hydrator.PromotionStages {
+		if stage.TargetBranch == "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.promotionStages[%d].targetBranch is required", i),
+			})
+		} else if branches[stage.TargetBranch] {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.promotionStages[%d].targetBranch %q is already used by the source hydrator", i, stage.TargetBranch),
+			})
+		}
+		branches[stage.TargetBranch] = true
+		if stage.Gate.SoakDuration != "" {
+			if _, err := time.ParseDuration(stage.Gate.SoakDuration); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("spec.sourceHydrator.promotionStages[%d].gate.soakDuration is invalid: %v", i, err),
+				})
+			}
+		}
+	}
+	return conditions
+}
+
+// isValidOCITag matches the tags allowed by the OCI distribution spec.
+var isValidOCITag = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}$`).MatchString
+
+// validateOCISyncSource validates a source hydrator pushing the hydrated manifests to an OCI repository, where the
+// branches are the tags of the artifacts.
+func validateOCISyncSource(hydrator *argoappv1.SourceHydrator) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	if !hydrator.SyncSource.IsOCI() {
+		return append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "spec.sourceHydrator.syncSource.repoURL must be an OCI repository with the oci:// scheme",
+		})
+	}
+	if hydrator.SyncSource.TargetBranch != "" && !isValidOCITag(hydrator.SyncSource.TargetBranch) {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("spec.sourceHydrator.syncSource.targetBranch %q is not a valid OCI tag", hydrator.SyncSource.TargetBranch),
+		})
+	}
+	if hydrator.HydrateTo != nil && hydrator.HydrateTo.TargetBranch != "" && !isValidOCITag(hydrator.HydrateTo.TargetBranch) {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("spec.sourceHydrator.hydrateTo.targetBranch %q is not a valid OCI tag", hydrator.HydrateTo.TargetBranch),
+		})
+	}
+	if hydrator.PullRequest != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "spec.sourceHydrator.pullRequest is not supported when spec.sourceHydrator.syncSource.repoURL is an OCI repository",
+		})
+	}
 	return conditions
 }
 
@@ -650,6 +757,12 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.SourceHydrator.GetDrySource().RepoURL, proj.Name),
 			})
 		}
+		if spec.SourceHydrator.SyncSource.RepoURL != "" && !proj.IsSourcePermitted(spec.SourceHydrator.GetSyncSource()) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.SourceHydrator.SyncSource.RepoURL, proj.Name),
+			})
+		}
 	case spec.HasMultipleSources():
 		for _, source := range spec.Sources {
 			condition := validateSourcePermissions(source, spec.HasMultipleSources())
@@ -679,6 +792,24 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		}
 	}
 
+	for i, dependency := range spec.DependsOn {
+		if dependency.Name == "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application dependency %d must specify a name", i),
+			})
+		}
+	}
+
+	if spec.SyncPolicy != nil && spec.SyncPolicy.SyncSchedule != nil {
+		if err := spec.SyncPolicy.SyncSchedule.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid sync schedule: %v", err),
+			})
+		}
+	}
+
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -796,6 +927,7 @@ func verifyGenerateManifests(
 	db db.ArgoDB,
 	helmRepos argoappv1.Repositories,
 	ociRepos argoappv1.Repositories,
+	gitRepos argoappv1.Repositories,
 	helmOptions *argoappv1.HelmOptions,
 	app *argoappv1.Application,
 	proj *argoappv1.AppProject,
@@ -902,6 +1034,9 @@ func verifyGenerateManifests(
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
 			AnnotationManifestGeneratePaths: app.GetAnnotation(argoappv1.AnnotationKeyManifestGeneratePaths),
 			InstallationID:                  installationID,
+			GitRepos:                        gitRepos,
+			// applications are validated when users create or update them
+			Interactive: true,
 		}
 		req.Repo.CopyCredentialsFromRepo(repoRes)
 		req.Repo.CopySettingsFrom(repoRes)
@@ -1043,6 +1178,23 @@ func NormalizeSource(source *argoappv1.ApplicationSource) *argoappv1.Application
 	return source
 }
 
+// GetManifestPolicies returns the manifest policies of the given project, read from the ConfigMaps they reference
+func GetManifestPolicies(proj *argoappv1.AppProject, settingsMgr *settings.SettingsManager) ([]*apiclient.ManifestPolicy, error) {
+	policies := make([]*apiclient.ManifestPolicy, 0, len(proj.Spec.ManifestPolicies))
+	for _, policy := range proj.Spec.ManifestPolicies {
+		cm, err := settingsMgr.GetConfigMapByName(policy.ConfigMap)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get manifest policies ConfigMap %s: %w", policy.ConfigMap, err)
+		}
+		policies = append(policies, &apiclient.ManifestPolicy{
+			Name:     policy.ConfigMap,
+			Action:   policy.GetAction(),
+			Policies: cm.Data,
+		})
+	}
+	return policies, nil
+}
+
 func GetPermittedReposCredentials(proj *argoappv1.AppProject, repoCreds []*argoappv1.RepoCreds) ([]*argoappv1.RepoCreds, error) {
 	var permittedRepoCreds []*argoappv1.RepoCreds
 	for _, v := range repoCreds {
@@ -304,6 +304,39 @@ func TestValidatePermissionsEmptyDestination(t *testing.T) {
 	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"}})
 }
 
+func TestValidatePermissionsDependencyWithoutName(t *testing.T) {
+	conditions, err := ValidatePermissions(t.Context(), &argoappv1.ApplicationSpec{
+		Source:    &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		DependsOn: []argoappv1.ApplicationDependency{{Name: "database"}, {Namespace: "infra"}},
+	}, &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "application dependency 1 must specify a name"},
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"},
+	})
+}
+
+func TestValidatePermissionsInvalidSyncSchedule(t *testing.T) {
+	conditions, err := ValidatePermissions(t.Context(), &argoappv1.ApplicationSpec{
+		Source:     &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		SyncPolicy: &argoappv1.SyncPolicy{SyncSchedule: &argoappv1.SyncSchedule{Schedule: "0 2 * * *", TimeZone: "Mars/Olympus"}},
+	}, &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, conditions, 2)
+	assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+	assert.Contains(t, conditions[0].Message, "invalid sync schedule: cannot load time zone 'Mars/Olympus'")
+}
+
 func TestValidateChartWithoutRevision(t *testing.T) {
 	appSpec := &argoappv1.ApplicationSpec{
 		Source: &argoappv1.ApplicationSource{RepoURL: "https://charts.helm.sh/incubator/", Chart: "myChart", TargetRevision: ""},
@@ -407,6 +440,7 @@ func TestValidateRepo(t *testing.T) {
 	db.EXPECT().GetRepository(mock.Anything, app.Spec.Source.RepoURL, "").Return(repo, nil).Maybe()
 	db.EXPECT().ListHelmRepositories(mock.Anything).Return(helmRepos, nil).Maybe()
 	db.EXPECT().ListOCIRepositories(mock.Anything).Return([]*argoappv1.Repository{}, nil).Maybe()
+	db.EXPECT().ListGitRepositories(mock.Anything).Return([]*argoappv1.Repository{}, nil).Maybe()
 	db.EXPECT().GetCluster(mock.Anything, app.Spec.Destination.Server).Return(cluster, nil).Maybe()
 	db.EXPECT().GetAllHelmRepositoryCredentials(mock.Anything).Return(nil, nil).Maybe()
 	db.EXPECT().GetAllOCIRepositoryCredentials(mock.Anything).Return([]*argoappv1.RepoCreds{}, nil).Maybe()
@@ -2113,3 +2147,110 @@ func Test_GetSyncedRefSources(t *testing.T) {
 		})
 	}
 }
+
+func TestGetManifestPolicies(t *testing.T) {
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-policies",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"host-network": "validations: [{expression: 'true'}]"},
+	}
+	settingsMgr := settings.NewSettingsManager(t.Context(), fake.NewClientset(&cm), test.FakeArgoCDNamespace)
+
+	proj := &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{ManifestPolicies: []argoappv1.ManifestPolicy{
+		{ConfigMap: "pod-policies"},
+		{ConfigMap: "pod-policies", Action: argoappv1.ManifestPolicyActionDeny},
+	}}}
+	policies, err := GetManifestPolicies(proj, settingsMgr)
+	require.NoError(t, err)
+	assert.Equal(t, []*apiclient.ManifestPolicy{
+		{Name: "pod-policies", Action: argoappv1.ManifestPolicyActionWarn, Policies: cm.Data},
+		{Name: "pod-policies", Action: argoappv1.ManifestPolicyActionDeny, Policies: cm.Data},
+	}, policies)
+
+	proj.Spec.ManifestPolicies = []argoappv1.ManifestPolicy{{ConfigMap: "missing"}}
+	_, err = GetManifestPolicies(proj, settingsMgr)
+	require.ErrorContains(t, err, "failed to get manifest policies ConfigMap missing")
+}
+
+func TestValidateSourceHydratorPullRequest(t *testing.T) {
+	hydrator := &argoappv1.SourceHydrator{
+		DrySource:   argoappv1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps"},
+		SyncSource:  argoappv1.SyncSource{TargetBranch: "env/prod", Path: "guestbook"},
+		PullRequest: &argoappv1.HydratorPullRequest{Provider: argoappv1.HydratorPullRequestProviderGitHub},
+	}
+	assert.Empty(t, validateSourceHydrator(hydrator))
+
+	hydrator.PullRequest.Provider = ""
+	hydrator.HydrateTo = &argoappv1.HydrateTo{TargetBranch: "env/prod"}
+	conditions := validateSourceHydrator(hydrator)
+	require.Len(t, conditions, 2)
+	assert.Equal(t, "when spec.sourceHydrator.pullRequest is set, spec.sourceHydrator.pullRequest.provider is required", conditions[0].Message)
+	assert.Equal(t, "when spec.sourceHydrator.pullRequest is set, spec.sourceHydrator.hydrateTo.targetBranch must differ from spec.sourceHydrator.syncSource.targetBranch", conditions[1].Message)
+}
+
+func TestValidateSourceHydratorOCISyncSource(t *testing.T) {
+	hydrator := &argoappv1.SourceHydrator{
+		DrySource:  argoappv1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps"},
+		SyncSource: argoappv1.SyncSource{RepoURL: "oci://registry.example.com/hydrated", TargetBranch: "env-prod", Path: "guestbook"},
+	}
+	assert.Empty(t, validateSourceHydrator(hydrator))
+
+	hydrator.SyncSource.TargetBranch = "env/prod"
+	hydrator.HydrateTo = &argoappv1.HydrateTo{TargetBranch: "-next"}
+	hydrator.PullRequest = &argoappv1.HydratorPullRequest{Provider: argoappv1.HydratorPullRequestProviderGitHub}
+	conditions := validateSourceHydrator(hydrator)
+	require.Len(t, conditions, 3)
+	assert.Equal(t, `spec.sourceHydrator.syncSource.targetBranch "env/prod" is not a valid OCI tag`, conditions[0].Message)
+	assert.Equal(t, `spec.sourceHydrator.hydrateTo.targetBranch "-next" is not a valid OCI tag`, conditions[1].Message)
+	assert.Equal(t, "spec.sourceHydrator.pullRequest is not supported when spec.sourceHydrator.syncSource.repoURL is an OCI repository", conditions[2].Message)
+
+	hydrator = &argoappv1.SourceHydrator{
+		DrySource:  argoappv1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps"},
+		SyncSource: argoappv1.SyncSource{RepoURL: "https://github.com/argoproj/hydrated", TargetBranch: "env/prod", Path: "guestbook"},
+	}
+	conditions = validateSourceHydrator(hydrator)
+	require.Len(t, conditions, 1)
+	assert.Equal(t, "spec.sourceHydrator.syncSource.repoURL must be an OCI repository with the oci:// scheme", conditions[0].Message)
+}
+
+func TestValidateSourceHydratorPromotionStages(t *testing.T) {
+	hydrator := &argoappv1.SourceHydrator{
+		DrySource:  argoappv1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps"},
+		SyncSource: argoappv1.SyncSource{TargetBranch: "env/dev", Path: "guestbook"},
+		PromotionStages: []argoappv1.PromotionStage{
+			{TargetBranch: "env/staging", Gate: argoappv1.PromotionGate{Healthy: true, SoakDuration: "1h"}},
+			{TargetBranch: "env/prod", Gate: argoappv1.PromotionGate{ManualApproval: true}},
+		},
+	}
+	assert.Empty(t, validateSourceHydrator(hydrator))
+
+	hydrator.HydrateTo = &argoappv1.HydrateTo{TargetBranch: "env/dev-next"}
+	hydrator.PromotionStages = []argoappv1.PromotionStage{
+		{TargetBranch: ""},
+		{TargetBranch: "env/dev"},
+		{TargetBranch: "env/prod", Gate: argoappv1.PromotionGate{SoakDuration: "1 day"}},
+	}
+	conditions := validateSourceHydrator(hydrator)
+	require.Len(t, conditions, 4)
+	assert.Equal(t, "spec.sourceHydrator.promotionStages cannot be combined with spec.sourceHydrator.hydrateTo, spec.sourceHydrator.pullRequest or spec.sourceHydrator.syncSource.repoURL", conditions[0].Message)
+	assert.Equal(t, "spec.sourceHydrator.promotionStages[0].targetBranch is required", conditions[1].Message)
+	assert.Equal(t, `spec.sourceHydrator.promotionStages[1].targetBranch "env/dev" is already used by the source hydrator`, conditions[2].Message)
+	assert.Contains(t, conditions[3].Message, "spec.sourceHydrator.promotionStages[2].gate.soakDuration is invalid")
+}
+
+func TestValidateSourceHydratorLayout(t *testing.T) {
+	hydrator := &argoappv1.SourceHydrator{
+		DrySource:  argoappv1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps"},
+		SyncSource: argoappv1.SyncSource{TargetBranch: "env/dev", Path: "guestbook"},
+		Layout:     &argoappv1.HydratedLayout{SplitManifests: true, Kustomization: true, HelmValues: true},
+	}
+	assert.Empty(t, validateSourceHydrator(hydrator))
+
+	hydrator.Layout.Kustomization = false
+	conditions := validateSourceHydrator(hydrator)
+	require.Len(t, conditions, 1)
+	assert.Equal(t, "when spec.sourceHydrator.layout.helmValues is set, spec.sourceHydrator.layout.kustomization is required, since values.yaml would otherwise be synced as a manifest", conditions[0].Message)
+}
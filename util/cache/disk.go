@@ -0,0 +1,299 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	bolterrors "go.etcd.io/bbolt/errors"
+)
+
+var (
+	diskCacheEntriesBucket = []byte("entries")
+	diskCacheOrderBucket   = []byte("order")
+)
+
+// diskCacheHeaderSize is the size of the header of an entry, which holds its expiration and its sequence number
+const diskCacheHeaderSize = 16
+
+// diskCacheOpenTimeout is how long to wait for the lock of a database held by another process. It is short, since
+// the lock is held for the whole lifetime of the process which opened the database, e.g. another repo server replica.
+const diskCacheOpenTimeout = time.Second
+
+// NewDiskCache opens or creates a persistent cache in the bbolt database at the given path. Entries expire after the
+// given expiration, unless set with another expiration, and the oldest entries are evicted once the cached data exceeds
+// the given maximum size. The database can only be opened by a single process at a time, so it fails if the database
+// is already opened by another process, e.g. if the path is on a volume shared by several replicas.
+func NewDiskCache(path string, expiration time.Duration, maxSize int64) (*DiskCache, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: diskCacheOpenTimeout})
+	if errors.Is(err, bolterrors.ErrTimeout) {
+		return nil, fmt.Errorf("failed to open disk cache %s: it is already opened by another process, the disk cache cannot be shared between processes", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open disk cache %s: %w", path, err)
+	}
+	c := &DiskCache{db: db, expiration: expiration, maxSize: maxSize}
+	if err := c.init(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize disk cache %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// compile-time validation of adherence of the CacheClient contract
+var _ CacheClient = &DiskCache{}
+
+// DiskCache is a cache client persisting entries in a local bbolt database, which survives restarts. Unlike Redis, it
+// is not shared, so updates cannot be subscribed to.
+type DiskCache struct {
+	db         *bolt.DB
+	expiration time.Duration
+	maxSize    int64
+	// size is the total size of the keys and values of the entries
+	size atomic.Int64
+}
+
+// init creates the buckets of the database, deletes the expired entries and computes the size of the others
+func (c *DiskCache) init() error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		entries, err := tx.CreateBucketIfNotExists(diskCacheEntriesBucket)
+		if err != nil {
+			return err
+		}
+		order, err := tx.CreateBucketIfNotExists(diskCacheOrderBucket)
+		if err != nil {
+			return err
+		}
+		var size int64
+		var expired [][]byte
+		now := time.Now()
+		err = entries.ForEach(func(k, v []byte) error {
+			if isDiskCacheEntryExpired(v, now) {
+				expired = append(expired, bytes.Clone(k))
+			} else {
+				size += int64(len(k) + len(v))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range expired {
+			if _, err := deleteDiskCacheEntry(entries, order, k); err != nil {
+				return err
+			}
+		}
+		c.size.Store(size)
+		return nil
+	})
+}
+
+// Close closes the database
+func (c *DiskCache) Close() error {
+	return c.db.Close()
+}
+
+// Size returns the total size of the keys and values of the entries
+func (c *DiskCache) Size() int64 {
+	return c.size.Load()
+}
+
+func isDiskCacheEntryExpired(v []byte, now time.Time) bool {
+	if len(v) < diskCacheHeaderSize {
+		return true
+	}
+	expiresAt := int64(binary.BigEndian.Uint64(v[:8]))
+	return expiresAt != 0 && now.UnixNano() >= expiresAt
+}
+
+// deleteDiskCacheEntry deletes the entry with the given key, and returns the size it occupied
+func deleteDiskCacheEntry(entries, order *bolt.Bucket, k []byte) (int64, error) {
+	v := entries.Get(k)
+	if v == nil {
+		return 0, nil
+	}
+	size := int64(len(k) + len(v))
+	if len(v) >= diskCacheHeaderSize {
+		if err := order.Delete(v[8:16]); err != nil {
+			return 0, err
+		}
+	}
+	return size, entries.Delete(k)
+}
+
+func (c *DiskCache) marshal(obj any) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, diskCacheHeaderSize))
+	w := gzip.NewWriter(buf)
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *DiskCache) unmarshal(v []byte, obj any) error {
+	r, err := gzip.NewReader(bytes.NewReader(v[diskCacheHeaderSize:]))
+	if err != nil {
+		return fmt.Errorf("failed to decode cached data: %w", err)
+	}
+	if err := json.NewDecoder(r).Decode(obj); err != nil {
+		return fmt.Errorf("failed to decode cached data: %w", err)
+	}
+	return nil
+}
+
+// put stores the given value, which starts with a header, and evicts the oldest entries if the cache is full
+func (c *DiskCache) put(tx *bolt.Tx, key []byte, v []byte, expiration time.Duration) (int64, error) {
+	entries := tx.Bucket(diskCacheEntriesBucket)
+	order := tx.Bucket(diskCacheOrderBucket)
+	delta, err := deleteDiskCacheEntry(entries, order, key)
+	if err != nil {
+		return 0, err
+	}
+	delta = -delta
+	seq, err := order.NextSequence()
+	if err != nil {
+		return 0, err
+	}
+	var expiresAt int64
+	if expiration > 0 {
+		expiresAt = time.Now().Add(expiration).UnixNano()
+	}
+	binary.BigEndian.PutUint64(v[:8], uint64(expiresAt))
+	binary.BigEndian.PutUint64(v[8:16], seq)
+	if err := entries.Put(key, v); err != nil {
+		return 0, err
+	}
+	if err := order.Put(v[8:16], key); err != nil {
+		return 0, err
+	}
+	delta += int64(len(key) + len(v))
+
+	// evict the oldest entries, which are first in the order bucket, until the entries fit in the maximum size
+	cursor := order.Cursor()
+	for seqKey, k := cursor.First(); seqKey != nil && c.maxSize > 0 && c.size.Load()+delta > c.maxSize; seqKey, k = cursor.First() {
+		seqKey, k = bytes.Clone(seqKey), bytes.Clone(k)
+		if err := order.Delete(seqKey); err != nil {
+			return 0, err
+		}
+		if v := entries.Get(k); v != nil && len(v) >= diskCacheHeaderSize && bytes.Equal(v[8:16], seqKey) {
+			delta -= int64(len(k) + len(v))
+			if err := entries.Delete(k); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return delta, nil
+}
+
+func (c *DiskCache) update(fn func(tx *bolt.Tx) (int64, error)) error {
+	var delta int64
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		var err error
+		delta, err = fn(tx)
+		return err
+	})
+	if err == nil {
+		c.size.Add(delta)
+	}
+	return err
+}
+
+func (c *DiskCache) Set(item *Item) error {
+	expiration := item.CacheActionOpts.Expiration
+	if expiration == 0 {
+		expiration = c.expiration
+	}
+	v, err := c.marshal(item.Object)
+	if err != nil {
+		return err
+	}
+	key := []byte(item.Key)
+	if c.maxSize > 0 && int64(len(key)+len(v)) > c.maxSize {
+		log.Warnf("Not caching key '%s' on disk, since its size exceeds the maximum size of the disk cache", item.Key)
+		return nil
+	}
+	return c.update(func(tx *bolt.Tx) (int64, error) {
+		if item.CacheActionOpts.DisableOverwrite {
+			if existing := tx.Bucket(diskCacheEntriesBucket).Get(key); existing != nil && !isDiskCacheEntryExpired(existing, time.Now()) {
+				return 0, nil
+			}
+		}
+		return c.put(tx, key, v, expiration)
+	})
+}
+
+func (c *DiskCache) Rename(oldKey string, newKey string, expiration time.Duration) error {
+	if expiration == 0 {
+		expiration = c.expiration
+	}
+	return c.update(func(tx *bolt.Tx) (int64, error) {
+		entries := tx.Bucket(diskCacheEntriesBucket)
+		existing := entries.Get([]byte(oldKey))
+		if existing == nil || isDiskCacheEntryExpired(existing, time.Now()) {
+			return 0, ErrCacheMiss
+		}
+		v := bytes.Clone(existing)
+		deleted, err := deleteDiskCacheEntry(entries, tx.Bucket(diskCacheOrderBucket), []byte(oldKey))
+		if err != nil {
+			return 0, err
+		}
+		delta, err := c.put(tx, []byte(newKey), v, expiration)
+		return delta - deleted, err
+	})
+}
+
+func (c *DiskCache) Get(key string, obj any) error {
+	_, err := c.get(key, obj)
+	return err
+}
+
+// get loads the entry with the given key into the given object, and returns the duration until it expires, which is
+// zero if it does not expire
+func (c *DiskCache) get(key string, obj any) (time.Duration, error) {
+	var v []byte
+	err := c.db.View(func(tx *bolt.Tx) error {
+		if existing := tx.Bucket(diskCacheEntriesBucket).Get([]byte(key)); existing != nil {
+			// values are only valid during the transaction
+			v = bytes.Clone(existing)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	if v == nil || isDiskCacheEntryExpired(v, now) {
+		return 0, ErrCacheMiss
+	}
+	var ttl time.Duration
+	if expiresAt := int64(binary.BigEndian.Uint64(v[:8])); expiresAt != 0 {
+		ttl = time.Unix(0, expiresAt).Sub(now)
+	}
+	return ttl, c.unmarshal(v, obj)
+}
+
+func (c *DiskCache) Delete(key string) error {
+	return c.update(func(tx *bolt.Tx) (int64, error) {
+		deleted, err := deleteDiskCacheEntry(tx.Bucket(diskCacheEntriesBucket), tx.Bucket(diskCacheOrderBucket), []byte(key))
+		return -deleted, err
+	})
+}
+
+func (c *DiskCache) OnUpdated(_ context.Context, _ string, _ func() error) error {
+	return nil
+}
+
+func (c *DiskCache) NotifyUpdated(_ string) error {
+	return nil
+}
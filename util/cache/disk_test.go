@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDiskCache(t *testing.T, path string, expiration time.Duration, maxSize int64) *DiskCache {
+	t.Helper()
+	cache, err := NewDiskCache(path, expiration, maxSize)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cache.Close() })
+	return cache
+}
+
+func TestDiskCache(t *testing.T) {
+	cache := newDiskCache(t, filepath.Join(t.TempDir(), "cache.db"), time.Hour, 0)
+	obj := &foo{}
+	// cache miss
+	err := cache.Get("my-key", obj)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	err = cache.Set(&Item{Key: "my-key", Object: &foo{Bar: "bar"}})
+	require.NoError(t, err)
+	err = cache.Get("my-key", obj)
+	require.NoError(t, err)
+	assert.Equal(t, &foo{Bar: "bar"}, obj)
+	// no overwrite
+	err = cache.Set(&Item{Key: "my-key", Object: &foo{Bar: "baz"}, CacheActionOpts: CacheActionOpts{DisableOverwrite: true}})
+	require.NoError(t, err)
+	err = cache.Get("my-key", obj)
+	require.NoError(t, err)
+	assert.Equal(t, &foo{Bar: "bar"}, obj)
+	// rename
+	err = cache.Rename("my-key", "other-key", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, ErrCacheMiss, cache.Get("my-key", obj))
+	err = cache.Get("other-key", obj)
+	require.NoError(t, err)
+	assert.Equal(t, &foo{Bar: "bar"}, obj)
+	assert.Equal(t, ErrCacheMiss, cache.Rename("my-key", "other-key", time.Hour))
+	// delete
+	err = cache.Delete("other-key")
+	require.NoError(t, err)
+	assert.Equal(t, ErrCacheMiss, cache.Get("other-key", obj))
+	assert.Zero(t, cache.Size())
+}
+
+func TestDiskCache_Expiration(t *testing.T) {
+	cache := newDiskCache(t, filepath.Join(t.TempDir(), "cache.db"), time.Hour, 0)
+	err := cache.Set(&Item{Key: "my-key", Object: &foo{Bar: "bar"}, CacheActionOpts: CacheActionOpts{Expiration: time.Millisecond}})
+	require.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, ErrCacheMiss, cache.Get("my-key", &foo{}))
+
+	err = cache.Set(&Item{Key: "my-key", Object: &foo{Bar: "bar"}})
+	require.NoError(t, err)
+	ttl, err := cache.get("my-key", &foo{})
+	require.NoError(t, err)
+	assert.Greater(t, ttl, 59*time.Minute)
+}
+
+func TestDiskCache_Persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.db")
+	cache, err := NewDiskCache(path, time.Hour, 0)
+	require.NoError(t, err)
+	require.NoError(t, cache.Set(&Item{Key: "my-key", Object: &foo{Bar: "bar"}}))
+	require.NoError(t, cache.Set(&Item{Key: "expiring-key", Object: &foo{Bar: "bar"}, CacheActionOpts: CacheActionOpts{Expiration: time.Millisecond}}))
+	size := cache.Size()
+	require.NoError(t, cache.Close())
+	time.Sleep(10 * time.Millisecond)
+
+	cache = newDiskCache(t, path, time.Hour, 0)
+	obj := &foo{}
+	require.NoError(t, cache.Get("my-key", obj))
+	assert.Equal(t, &foo{Bar: "bar"}, obj)
+	// expired entries are deleted when the cache is opened
+	assert.Less(t, cache.Size(), size)
+	assert.Equal(t, ErrCacheMiss, cache.Get("expiring-key", obj))
+}
+
+func TestDiskCache_AlreadyOpened(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.db")
+	newDiskCache(t, path, time.Hour, 0)
+	start := time.Now()
+	_, err := NewDiskCache(path, time.Hour, 0)
+	require.ErrorContains(t, err, "already opened by another process")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestDiskCache_Eviction(t *testing.T) {
+	value := &foo{Bar: strings.Repeat("a", 100)}
+	cache := newDiskCache(t, filepath.Join(t.TempDir(), "cache.db"), time.Hour, 0)
+	require.NoError(t, cache.Set(&Item{Key: "key-1", Object: value}))
+	entrySize := cache.Size()
+
+	// room for two entries
+	cache = newDiskCache(t, filepath.Join(t.TempDir(), "cache.db"), time.Hour, 2*entrySize+1)
+	require.NoError(t, cache.Set(&Item{Key: "key-1", Object: value}))
+	require.NoError(t, cache.Set(&Item{Key: "key-2", Object: value}))
+	// overwriting an entry makes it the newest
+	require.NoError(t, cache.Set(&Item{Key: "key-1", Object: value}))
+	require.NoError(t, cache.Set(&Item{Key: "key-3", Object: value}))
+
+	assert.Equal(t, ErrCacheMiss, cache.Get("key-2", &foo{}))
+	require.NoError(t, cache.Get("key-1", &foo{}))
+	require.NoError(t, cache.Get("key-3", &foo{}))
+	assert.Equal(t, 2*entrySize, cache.Size())
+
+	// entries larger than the cache are not stored
+	cache = newDiskCache(t, filepath.Join(t.TempDir(), "cache.db"), time.Hour, entrySize-1)
+	require.NoError(t, cache.Set(&Item{Key: "key-1", Object: value}))
+	assert.Equal(t, ErrCacheMiss, cache.Get("key-1", &foo{}))
+	assert.Zero(t, cache.Size())
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// CacheTier is the tier of a multi-tier cache which served a request
+type CacheTier string
+
+const (
+	// CacheTierExternal is the external cache, e.g. Redis
+	CacheTierExternal CacheTier = "redis"
+	// CacheTierDisk is the persistent disk cache
+	CacheTierDisk CacheTier = "disk"
+	// CacheTierMiss means no tier had the requested entry
+	CacheTierMiss CacheTier = "miss"
+)
+
+// TierMetricsRegistry records which tier of a multi-tier cache served each request
+type TierMetricsRegistry interface {
+	IncCacheTierRequest(tier CacheTier)
+}
+
+// NewPersistentClient creates cache client that proxies requests to given external cache, and additionally persists
+// the entries whose keys start with one of the given prefixes in the given disk cache. Entries are read from the
+// external cache first, and only read from the disk cache when they are missing from the external cache, e.g. after it
+// has been flushed, or when it is unavailable. Entries read from the disk cache are restored in the external cache.
+func NewPersistentClient(client CacheClient, diskCache *DiskCache, keyPrefixes []string, registry TierMetricsRegistry) *persistentClient {
+	return &persistentClient{externalCache: client, diskCache: diskCache, keyPrefixes: keyPrefixes, registry: registry}
+}
+
+type persistentClient struct {
+	externalCache CacheClient
+	diskCache     *DiskCache
+	keyPrefixes   []string
+	registry      TierMetricsRegistry
+}
+
+func (c *persistentClient) isPersisted(key string) bool {
+	for _, prefix := range c.keyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *persistentClient) observe(tier CacheTier) {
+	if c.registry != nil {
+		c.registry.IncCacheTierRequest(tier)
+	}
+}
+
+func (c *persistentClient) Rename(oldKey string, newKey string, expiration time.Duration) error {
+	if c.isPersisted(oldKey) || c.isPersisted(newKey) {
+		err := c.diskCache.Rename(oldKey, newKey, expiration)
+		if err != nil && !errors.Is(err, ErrCacheMiss) {
+			log.Warnf("Failed to move key '%s' in disk cache: %v", oldKey, err)
+		}
+	}
+	return c.externalCache.Rename(oldKey, newKey, expiration)
+}
+
+// Set stores the given value in the external cache, and in the disk cache if its key is persisted.
+func (c *persistentClient) Set(item *Item) error {
+	if c.isPersisted(item.Key) {
+		if err := c.diskCache.Set(item); err != nil {
+			log.Warnf("Failed to save key '%s' in disk cache: %v", item.Key, err)
+		}
+	}
+	return c.externalCache.Set(item)
+}
+
+// Get returns cache value from the external cache if it is present. Otherwise loads persisted keys from the disk
+// cache, and restores them in the external cache.
+func (c *persistentClient) Get(key string, obj any) error {
+	err := c.externalCache.Get(key, obj)
+	if !c.isPersisted(key) {
+		return err
+	}
+	if err == nil {
+		c.observe(CacheTierExternal)
+		return nil
+	}
+
+	ttl, diskErr := c.diskCache.get(key, obj)
+	if diskErr != nil {
+		if !errors.Is(diskErr, ErrCacheMiss) {
+			log.Warnf("Failed to load key '%s' from disk cache: %v", key, diskErr)
+		}
+		c.observe(CacheTierMiss)
+		return err
+	}
+	c.observe(CacheTierDisk)
+	if errors.Is(err, ErrCacheMiss) {
+		if err := c.externalCache.Set(&Item{Key: key, Object: obj, CacheActionOpts: CacheActionOpts{Expiration: ttl}}); err != nil {
+			log.Warnf("Failed to restore key '%s' from disk cache: %v", key, err)
+		}
+	} else {
+		log.Warnf("Failed to load key '%s' from external cache, loaded it from disk cache: %v", key, err)
+	}
+	return nil
+}
+
+// Delete deletes cache for given key in both disk and external cache.
+func (c *persistentClient) Delete(key string) error {
+	if c.isPersisted(key) {
+		if err := c.diskCache.Delete(key); err != nil {
+			return err
+		}
+	}
+	return c.externalCache.Delete(key)
+}
+
+func (c *persistentClient) OnUpdated(ctx context.Context, key string, callback func() error) error {
+	return c.externalCache.OnUpdated(ctx, key, callback)
+}
+
+func (c *persistentClient) NotifyUpdated(key string) error {
+	return c.externalCache.NotifyUpdated(key)
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tierMetricsRegistry struct {
+	requests map[CacheTier]int
+}
+
+func (r *tierMetricsRegistry) IncCacheTierRequest(tier CacheTier) {
+	r.requests[tier]++
+}
+
+func TestPersistentClient(t *testing.T) {
+	externalCache := NewInMemoryCache(time.Hour)
+	diskCache := newDiskCache(t, filepath.Join(t.TempDir(), "cache.db"), time.Hour, 0)
+	registry := &tierMetricsRegistry{requests: map[CacheTier]int{}}
+	client := NewPersistentClient(externalCache, diskCache, []string{"mfst|"}, registry)
+
+	require.NoError(t, client.Set(&Item{Key: "mfst|app", Object: &foo{Bar: "bar"}}))
+	require.NoError(t, client.Set(&Item{Key: "appdetails|app", Object: &foo{Bar: "bar"}}))
+	// only keys with the prefixes are persisted
+	require.NoError(t, diskCache.Get("mfst|app", &foo{}))
+	assert.Equal(t, ErrCacheMiss, diskCache.Get("appdetails|app", &foo{}))
+
+	obj := &foo{}
+	require.NoError(t, client.Get("mfst|app", obj))
+	assert.Equal(t, &foo{Bar: "bar"}, obj)
+	assert.Equal(t, map[CacheTier]int{CacheTierExternal: 1}, registry.requests)
+
+	// entries missing from the external cache are loaded from disk, and restored in the external cache
+	require.NoError(t, externalCache.Delete("mfst|app"))
+	require.NoError(t, externalCache.Delete("appdetails|app"))
+	obj = &foo{}
+	require.NoError(t, client.Get("mfst|app", obj))
+	assert.Equal(t, &foo{Bar: "bar"}, obj)
+	assert.Equal(t, map[CacheTier]int{CacheTierExternal: 1, CacheTierDisk: 1}, registry.requests)
+	require.NoError(t, externalCache.Get("mfst|app", &foo{}))
+	assert.Equal(t, ErrCacheMiss, client.Get("appdetails|app", &foo{}))
+
+	require.NoError(t, client.Rename("mfst|app", "mfst|other-app", time.Hour))
+	require.NoError(t, diskCache.Get("mfst|other-app", &foo{}))
+
+	require.NoError(t, client.Delete("mfst|other-app"))
+	assert.Equal(t, ErrCacheMiss, client.Get("mfst|other-app", &foo{}))
+	assert.Equal(t, map[CacheTier]int{CacheTierExternal: 1, CacheTierDisk: 1, CacheTierMiss: 1}, registry.requests)
+}
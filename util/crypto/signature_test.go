@@ -0,0 +1,77 @@
+package crypto
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeKeys(t *testing.T, key crypto.Signer) ([]byte, []byte) {
+	t.Helper()
+	privateDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+}
+
+func TestSignVerify(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	for name, key := range map[string]crypto.Signer{"ed25519": ed25519Key, "ecdsa": ecdsaKey, "rsa": rsaKey} {
+		t.Run(name, func(t *testing.T) {
+			privatePEM, publicPEM := encodeKeys(t, key)
+			signer, err := ParsePrivateKey(privatePEM)
+			require.NoError(t, err)
+			publicKey, err := ParsePublicKey(publicPEM)
+			require.NoError(t, err)
+
+			signerID, err := KeyID(signer.Public())
+			require.NoError(t, err)
+			publicID, err := KeyID(publicKey)
+			require.NoError(t, err)
+			assert.Equal(t, signerID, publicID)
+			assert.Regexp(t, "^sha256:[0-9a-f]{64}$", publicID)
+
+			signature, err := Sign(signer, []byte("manifests"))
+			require.NoError(t, err)
+			require.NoError(t, Verify(publicKey, []byte("manifests"), signature))
+			require.EqualError(t, Verify(publicKey, []byte("tampered manifests"), signature), "invalid signature")
+		})
+	}
+}
+
+func TestParsePrivateKey_PKCS1(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}))
+	require.NoError(t, err)
+	assert.Equal(t, rsaKey.Public(), signer.Public())
+}
+
+func TestParsePrivateKey_Invalid(t *testing.T) {
+	_, err := ParsePrivateKey([]byte("not a key"))
+	require.EqualError(t, err, "no PEM-encoded private key found")
+
+	_, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("garbage")}))
+	require.ErrorContains(t, err, "failed to parse private key")
+}
+
+func TestParsePublicKey_Invalid(t *testing.T) {
+	_, err := ParsePublicKey([]byte("not a key"))
+	require.EqualError(t, err, "no PEM-encoded public key found")
+}
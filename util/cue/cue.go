@@ -0,0 +1,259 @@
+package cue
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+)
+
+// ModuleDirName is the name of the directory marking the root of a CUE module
+const ModuleDirName = "cue.mod"
+
+// ExportOpts are the options of a `cue export` command
+type ExportOpts struct {
+	// Package is the package to export, relative to the working directory
+	Package string
+	// Expression selects the value to export
+	Expression string
+	// Tags are injected into the fields with a matching @tag attribute
+	Tags map[string]string
+	// Files are YAML or JSON files unified with the package
+	Files []pathutil.ResolvedFilePath
+}
+
+// Tag is a field of a CUE package with a @tag attribute, whose value can be injected
+type Tag struct {
+	// Name is the name of the tag
+	Name string
+	// Type is the type of the tag, e.g. string or int
+	Type string
+	// Options are the values allowed by the short form of the tag
+	Options []string
+	// Default is the default value of the field, if any
+	Default string
+}
+
+// Cue provides wrapper functionality around the `cue` command.
+type Cue interface {
+	// Export returns the Kubernetes objects exported from the package, along with the command
+	Export(opts *ExportOpts) ([]*unstructured.Unstructured, string, error)
+}
+
+// NewCueApp create a new wrapper to run commands on the `cue` command-line tool.
+func NewCueApp(workDir string, env []string) Cue {
+	return newCueApp(workDir, env, executil.Run)
+}
+
+func newCueApp(workDir string, env []string, run func(cmd *exec.Cmd) (string, error)) *cue {
+	return &cue{workDir: workDir, env: env, run: run}
+}
+
+type cue struct {
+	workDir string
+	env     []string
+	run     func(cmd *exec.Cmd) (string, error)
+}
+
+var _ Cue = &cue{}
+
+func (c *cue) Export(opts *ExportOpts) ([]*unstructured.Unstructured, string, error) {
+	pkg := opts.Package
+	if pkg == "" {
+		pkg = "."
+	}
+	if _, err := PackageDir(c.workDir, pkg); err != nil {
+		return nil, "", err
+	}
+	args := []string{"export", pkg}
+	for _, file := range opts.Files {
+		args = append(args, string(file))
+	}
+	args = append(args, "--out", "json")
+	if opts.Expression != "" {
+		args = append(args, "--expression", opts.Expression)
+	}
+	names := make([]string, 0, len(opts.Tags))
+	for name := range opts.Tags {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		args = append(args, "--inject", name+"="+opts.Tags[name])
+	}
+
+	cmd := exec.CommandContext(context.Background(), "cue", args...)
+	cmd.Dir = c.workDir
+	cmd.Env = append(os.Environ(), c.env...)
+	command := executil.GetCommandArgsToLog(cmd)
+
+	out, err := c.run(cmd)
+	if err != nil {
+		return nil, command, fmt.Errorf("failed to execute cue export command: %w", err)
+	}
+	var value any
+	if err := json.Unmarshal([]byte(out), &value); err != nil {
+		return nil, command, fmt.Errorf("failed to parse cue export output: %w", err)
+	}
+	objs, err := toObjects(value, "")
+	if err != nil {
+		return nil, command, err
+	}
+	return objs, command, nil
+}
+
+// PackageDir returns the directory of the given package, which must be within the working directory
+func PackageDir(workDir string, pkg string) (string, error) {
+	dir, _, _ := strings.Cut(pkg, ":")
+	if dir == "" {
+		return workDir, nil
+	}
+	if !filepath.IsLocal(dir) {
+		return "", fmt.Errorf("cue package %s must be a relative path within the application path", pkg)
+	}
+	return filepath.Join(workDir, dir), nil
+}
+
+// toObjects returns the Kubernetes objects of an exported value, which is either a Kubernetes object, or a list or a
+// struct of values which are themselves Kubernetes objects, lists or structs
+func toObjects(value any, path string) ([]*unstructured.Unstructured, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		if isObject(v) {
+			return []*unstructured.Unstructured{{Object: v}}, nil
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		var objs []*unstructured.Unstructured
+		for _, key := range keys {
+			keyObjs, err := toObjects(v[key], path+"."+key)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, keyObjs...)
+		}
+		return objs, nil
+	case []any:
+		var objs []*unstructured.Unstructured
+		for i, item := range v {
+			itemObjs, err := toObjects(item, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, itemObjs...)
+		}
+		return objs, nil
+	default:
+		if path == "" {
+			return nil, errors.New("cue export value is not a Kubernetes object, or a list or a struct of Kubernetes objects")
+		}
+		return nil, fmt.Errorf("cue export value %s is not a Kubernetes object, or a list or a struct of Kubernetes objects", strings.TrimPrefix(path, "."))
+	}
+}
+
+func isObject(v map[string]any) bool {
+	apiVersion, _ := v["apiVersion"].(string)
+	kind, _ := v["kind"].(string)
+	return apiVersion != "" && kind != ""
+}
+
+// FindModuleRoot returns the root directory of the CUE module containing the given directory, without looking further
+// up than the given root directory
+func FindModuleRoot(dir string, root string) (string, bool) {
+	dir = filepath.Clean(dir)
+	root = filepath.Clean(root)
+	for {
+		if info, err := os.Stat(filepath.Join(dir, ModuleDirName)); err == nil && info.IsDir() {
+			return dir, true
+		}
+		if dir == root {
+			return "", false
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir || !strings.HasPrefix(parent, root) {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+var (
+	tagAttribute = regexp.MustCompile(`@tag\(\s*([^,)\s]+)\s*((?:,[^)]*)?)\)`)
+	defaultValue = regexp.MustCompile(`\*\s*("(?:[^"\\]|\\.)*"|[^\s|,}]+)`)
+)
+
+// GetTags returns the fields with a @tag attribute of the .cue files in the given directory, sorted by name
+func GetTags(dir string) ([]Tag, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+	tags := map[string]Tag{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".cue" || strings.HasSuffix(entry.Name(), "_tool.cue") {
+			continue
+		}
+		if err := parseTags(filepath.Join(dir, entry.Name()), tags); err != nil {
+			return nil, err
+		}
+	}
+	result := make([]Tag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, tag)
+	}
+	slices.SortFunc(result, func(a, b Tag) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result, nil
+}
+
+func parseTags(path string, tags map[string]Tag) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		match := tagAttribute.FindStringSubmatchIndex(line)
+		if match == nil {
+			continue
+		}
+		tag := Tag{Name: line[match[2]:match[3]], Type: "string"}
+		for arg := range strings.SplitSeq(line[match[4]:match[5]], ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(arg), "=")
+			switch key {
+			case "type":
+				tag.Type = value
+			case "short":
+				tag.Options = strings.Split(value, "|")
+			}
+		}
+		if def := defaultValue.FindStringSubmatch(line[:match[0]]); def != nil {
+			tag.Default = strings.Trim(def[1], `"`)
+		}
+		if _, ok := tags[tag.Name]; !ok {
+			tags[tag.Name] = tag
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return nil
+}
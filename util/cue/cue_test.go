@@ -0,0 +1,120 @@
+package cue
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+)
+
+func newTestCueApp(out string, cmds *[]*exec.Cmd) *cue {
+	return newCueApp(".", []string{"ARGOCD_APP_NAME=my-app"}, func(cmd *exec.Cmd) (string, error) {
+		*cmds = append(*cmds, cmd)
+		return out, nil
+	})
+}
+
+func TestCue_Export(t *testing.T) {
+	var cmds []*exec.Cmd
+	c := newTestCueApp(`{
+  "frontend": {
+    "deployment": {"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "frontend"}},
+    "service": {"apiVersion": "v1", "kind": "Service", "metadata": {"name": "frontend"}}
+  },
+  "configMaps": [{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "config"}}]
+}`, &cmds)
+
+	objs, command, err := c.Export(&ExportOpts{
+		Package:    ".:prod",
+		Expression: "objects",
+		Tags:       map[string]string{"replicas": "3", "env": "prod"},
+		Files:      []pathutil.ResolvedFilePath{"/tmp/values.yaml"},
+	})
+	require.NoError(t, err)
+	require.Len(t, objs, 3)
+	assert.Equal(t, "ConfigMap", objs[0].GetKind())
+	assert.Equal(t, "Deployment", objs[1].GetKind())
+	assert.Equal(t, "Service", objs[2].GetKind())
+	require.Len(t, cmds, 1)
+	assert.Equal(t, []string{
+		"cue", "export", ".:prod", "/tmp/values.yaml",
+		"--out", "json",
+		"--expression", "objects",
+		"--inject", "env=prod",
+		"--inject", "replicas=3",
+	}, cmds[0].Args)
+	assert.Contains(t, command, "cue export")
+	assert.Contains(t, cmds[0].Env, "ARGOCD_APP_NAME=my-app")
+}
+
+func TestCue_Export_Object(t *testing.T) {
+	var cmds []*exec.Cmd
+	c := newTestCueApp(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "config"}}`, &cmds)
+
+	objs, _, err := c.Export(&ExportOpts{})
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	assert.Equal(t, "config", objs[0].GetName())
+	assert.Equal(t, []string{"cue", "export", ".", "--out", "json"}, cmds[0].Args)
+}
+
+func TestCue_Export_NotObjects(t *testing.T) {
+	var cmds []*exec.Cmd
+	c := newTestCueApp(`{"config": {"apiVersion": "v1", "kind": "ConfigMap"}, "replicas": 3}`, &cmds)
+
+	_, _, err := c.Export(&ExportOpts{})
+	require.ErrorContains(t, err, "cue export value replicas is not a Kubernetes object")
+}
+
+func TestCue_Export_PackageOutsideAppPath(t *testing.T) {
+	var cmds []*exec.Cmd
+	c := newTestCueApp("{}", &cmds)
+
+	_, _, err := c.Export(&ExportOpts{Package: "../other:prod"})
+	require.ErrorContains(t, err, "must be a relative path within the application path")
+	assert.Empty(t, cmds)
+}
+
+func TestFindModuleRoot(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "module", ModuleDirName), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "module", "apps", "guestbook"), 0o755))
+
+	moduleRoot, ok := FindModuleRoot(filepath.Join(root, "module", "apps", "guestbook"), root)
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(root, "module"), moduleRoot)
+
+	_, ok = FindModuleRoot(root, root)
+	assert.False(t, ok)
+
+	// the module root must be within the given root
+	_, ok = FindModuleRoot(filepath.Join(root, "module", "apps", "guestbook"), filepath.Join(root, "module", "apps"))
+	assert.False(t, ok)
+}
+
+func TestGetTags(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "guestbook.cue"), []byte(`package guestbook
+
+env:      *"dev" | string @tag(env,short=dev|staging|prod)
+replicas: *1 | int        @tag(replicas,type=int)
+image:    string          @tag(image)
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "deploy_tool.cue"), []byte(`package guestbook
+
+cluster: string @tag(cluster)
+`), 0o644))
+
+	tags, err := GetTags(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []Tag{
+		{Name: "env", Type: "string", Options: []string{"dev", "staging", "prod"}, Default: "dev"},
+		{Name: "image", Type: "string"},
+		{Name: "replicas", Type: "int", Default: "1"},
+	}, tags)
+}
@@ -113,6 +113,9 @@ type ArgoDB interface {
 	// ListOCIRepositories lists repositories
 	ListOCIRepositories(ctx context.Context) ([]*appv1.Repository, error)
 
+	// ListGitRepositories lists the Git repositories
+	ListGitRepositories(ctx context.Context) ([]*appv1.Repository, error)
+
 	// ListConfiguredGPGPublicKeys returns all GPG public key IDs that are configured
 	ListConfiguredGPGPublicKeys(ctx context.Context) (map[string]*appv1.GnuPGPublicKey, error)
 	// AddGPGPublicKey adds one or more GPG public keys to the configuration
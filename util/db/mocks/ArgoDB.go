@@ -1787,6 +1787,68 @@ func (_c *ArgoDB_ListConfiguredGPGPublicKeys_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// ListGitRepositories provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) ListGitRepositories(ctx context.Context) ([]*v1alpha1.Repository, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGitRepositories")
+	}
+
+	var r0 []*v1alpha1.Repository
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*v1alpha1.Repository, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*v1alpha1.Repository); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v1alpha1.Repository)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ArgoDB_ListGitRepositories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGitRepositories'
+type ArgoDB_ListGitRepositories_Call struct {
+	*mock.Call
+}
+
+// ListGitRepositories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ArgoDB_Expecter) ListGitRepositories(ctx interface{}) *ArgoDB_ListGitRepositories_Call {
+	return &ArgoDB_ListGitRepositories_Call{Call: _e.mock.On("ListGitRepositories", ctx)}
+}
+
+func (_c *ArgoDB_ListGitRepositories_Call) Run(run func(ctx context.Context)) *ArgoDB_ListGitRepositories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_ListGitRepositories_Call) Return(repositorys []*v1alpha1.Repository, err error) *ArgoDB_ListGitRepositories_Call {
+	_c.Call.Return(repositorys, err)
+	return _c
+}
+
+func (_c *ArgoDB_ListGitRepositories_Call) RunAndReturn(run func(ctx context.Context) ([]*v1alpha1.Repository, error)) *ArgoDB_ListGitRepositories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListHelmRepositories provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) ListHelmRepositories(ctx context.Context) ([]*v1alpha1.Repository, error) {
 	ret := _mock.Called(ctx)
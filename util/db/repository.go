@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
@@ -190,6 +191,16 @@ func (db *db) listRepositories(ctx context.Context, repoType *string, writeCreds
 	return repositories, nil
 }
 
+func (db *db) ListGitRepositories(ctx context.Context) ([]*v1alpha1.Repository, error) {
+	repos, err := db.listRepositories(ctx, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Git repositories: %w", err)
+	}
+	return v1alpha1.Repositories(repos).Filter(func(r *v1alpha1.Repository) bool {
+		return r.Type == "" || r.Type == common.DefaultRepoType
+	}), nil
+}
+
 func (db *db) ListOCIRepositories(ctx context.Context) ([]*v1alpha1.Repository, error) {
 	var result []*v1alpha1.Repository
 	repos, err := db.listRepositories(ctx, new("oci"), false)
@@ -407,6 +407,18 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 	}
 	repository.Depth = depth
 
+	partialClone, err := boolOrFalse(secret, "partialClone")
+	if err != nil {
+		return repository, err
+	}
+	repository.PartialClone = partialClone
+
+	sparseCheckout, err := boolOrFalse(secret, "sparseCheckout")
+	if err != nil {
+		return repository, err
+	}
+	repository.SparseCheckout = sparseCheckout
+
 	return repository, nil
 }
 
@@ -444,6 +456,8 @@ func (s *secretsRepositoryBackend) repositoryToSecret(repository *appsv1.Reposit
 	updateSecretBool(secretCopy, "forceHttpBasicAuth", repository.ForceHttpBasicAuth)
 	updateSecretBool(secretCopy, "useAzureWorkloadIdentity", repository.UseAzureWorkloadIdentity)
 	updateSecretInt(secretCopy, "depth", repository.Depth)
+	updateSecretBool(secretCopy, "partialClone", repository.PartialClone)
+	updateSecretBool(secretCopy, "sparseCheckout", repository.SparseCheckout)
 	addSecretMetadata(secretCopy, s.getSecretType())
 
 	return secretCopy
@@ -13,6 +13,7 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -147,6 +148,8 @@ type Client interface {
 	CheckoutOrNew(branch, base string, submoduleEnabled bool) (string, error)
 	// RemoveContents removes all files from the given paths in the git repository.
 	RemoveContents(paths []string) (string, error)
+	// CheckoutPaths replaces the given paths of the working tree and of the index with their content at the revision.
+	CheckoutPaths(revision string, paths []string) (string, error)
 	// CommitAndPush commits and pushes changes to the target branch.
 	CommitAndPush(branch, message string) (string, error)
 	// GetCommitNote gets the note associated with the DRY sha stored in the specific namespace
@@ -187,6 +190,32 @@ type nativeGitClient struct {
 	noProxy string
 	// git configuration environment variables
 	gitConfigEnv []string
+	// whether to fetch the repository as a partial clone, without the contents of files
+	partialClone bool
+	// paths of the repository to check out, or all paths if empty
+	sparseCheckoutPaths []string
+	// signing options of the commits made by the client, or nil to not sign commits
+	commitSigning *CommitSigningOpts
+}
+
+// CommitSigningFormat is the format of the signatures of commits, matching the gpg.format git configuration
+type CommitSigningFormat string
+
+const (
+	// CommitSigningFormatOpenPGP signs commits with a GnuPG key
+	CommitSigningFormatOpenPGP CommitSigningFormat = "openpgp"
+	// CommitSigningFormatSSH signs commits with an SSH key
+	CommitSigningFormatSSH CommitSigningFormat = "ssh"
+)
+
+// CommitSigningOpts configures the signing of commits made by the client
+type CommitSigningOpts struct {
+	// Format is the format of the signatures
+	Format CommitSigningFormat
+	// Key is the ID of the GnuPG key for the openpgp format, or the path to the private key for the ssh format
+	Key string
+	// GnuPGHome is the GnuPG home directory holding the secret key for the openpgp format
+	GnuPGHome string
 }
 
 type runOpts struct {
@@ -250,6 +279,29 @@ func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	}
 }
 
+// WithPartialClone sets whether the repository is fetched as a partial clone, which only downloads the contents of
+// files when they are checked out
+func WithPartialClone(enable bool) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.partialClone = enable
+	}
+}
+
+// WithSparseCheckout limits checkouts to the given paths, relative to the root of the repository. All paths are checked
+// out if none is given.
+func WithSparseCheckout(paths []string) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.sparseCheckoutPaths = paths
+	}
+}
+
+// WithCommitSigning signs the commits made by the client with the given key. Commits are not signed if opts is nil.
+func WithCommitSigning(opts *CommitSigningOpts) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.commitSigning = opts
+	}
+}
+
 func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...ClientOpts) (Client, error) {
 	r := regexp.MustCompile(`([/:])`)
 	normalizedGitURL := NormalizeGitURL(rawRepoURL)
@@ -469,6 +521,9 @@ func (m *nativeGitClient) fetch(ctx context.Context, revision string, depth int6
 	} else {
 		args = append(args, "--tags")
 	}
+	if m.partialClone {
+		args = append(args, "--filter=blob:none")
+	}
 	args = append(args, "--force", "--prune")
 	return m.runCredentialedCmd(ctx, args...)
 }
@@ -586,7 +641,15 @@ func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) (stri
 		revision = "origin/HEAD"
 	}
 	ctx := context.Background()
-	if out, err := m.runCmd(ctx, "checkout", "--force", revision); err != nil {
+	if err := m.configureSparseCheckout(ctx); err != nil {
+		return "", fmt.Errorf("failed to configure sparse checkout: %w", err)
+	}
+	if m.partialClone {
+		// The contents of the checked out files are fetched from the remote on demand
+		if err := m.runCredentialedCmd(ctx, "checkout", "--force", revision); err != nil {
+			return "", fmt.Errorf("failed to checkout %s: %w", revision, err)
+		}
+	} else if out, err := m.runCmd(ctx, "checkout", "--force", revision); err != nil {
 		return out, fmt.Errorf("failed to checkout %s: %w", revision, err)
 	}
 	// We must populate LFS content by using lfs checkout, if we have at least
@@ -620,6 +683,50 @@ func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) (stri
 	return "", nil
 }
 
+// configureSparseCheckout writes the sparse checkout patterns matching the paths to check out, which are applied by the
+// next checkout. Once a working tree has been sparsely checked out, a pattern matching all paths is written to restore
+// it when all paths are checked out again.
+func (m *nativeGitClient) configureSparseCheckout(ctx context.Context) error {
+	patternsPath := filepath.Join(m.root, ".git", "info", "sparse-checkout")
+	_, err := os.Stat(patternsPath)
+	sparse := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if !sparse && len(m.sparseCheckoutPaths) == 0 {
+		return nil
+	}
+	if !sparse {
+		if _, err := m.config(ctx, "core.sparseCheckout", "true"); err != nil {
+			return err
+		}
+		if _, err := m.config(ctx, "core.sparseCheckoutCone", "false"); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(patternsPath), 0o755); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(patternsPath, []byte(strings.Join(sparseCheckoutPatterns(m.sparseCheckoutPaths), "\n")+"\n"), 0o644)
+}
+
+// sparseCheckoutPatterns returns the non-cone sparse checkout patterns matching the given paths, relative to the root
+// of the repository, and everything below them
+func sparseCheckoutPatterns(paths []string) []string {
+	if len(paths) == 0 {
+		return []string{"/*"}
+	}
+	patterns := make([]string, 0, len(paths))
+	for _, p := range paths {
+		p = strings.Trim(path.Clean("/"+filepath.ToSlash(p)), "/")
+		if p == "" {
+			return []string{"/*"}
+		}
+		patterns = append(patterns, "/"+p)
+	}
+	return patterns
+}
+
 func (m *nativeGitClient) getRefs() ([]*plumbing.Reference, error) {
 	myLockUUID, err := uuid.NewRandom()
 	myLockId := ""
@@ -1044,7 +1151,7 @@ func (m *nativeGitClient) CheckoutOrOrphan(branch string, submoduleEnabled bool)
 		}
 
 		// Make an empty initial commit.
-		out, err = m.runCmd(ctx, "commit", "--allow-empty", "-m", "Initial commit")
+		out, err = m.runCommitCmd(ctx, "--allow-empty", "-m", "Initial commit")
 		if err != nil {
 			return out, fmt.Errorf("failed to commit initial commit: %w", err)
 		}
@@ -1094,6 +1201,19 @@ func (m *nativeGitClient) RemoveContents(paths []string) (string, error) {
 	return "", nil
 }
 
+// CheckoutPaths replaces the given paths of the working tree and of the index with their content at the revision.
+func (m *nativeGitClient) CheckoutPaths(revision string, paths []string) (string, error) {
+	if len(paths) == 0 {
+		return "", nil
+	}
+	args := append([]string{"checkout", revision, "--"}, paths...)
+	out, err := m.runCmd(context.Background(), args...)
+	if err != nil {
+		return out, fmt.Errorf("failed to checkout paths %v from %s: %w", paths, revision, err)
+	}
+	return "", nil
+}
+
 // CommitAndPush commits and pushes changes to the target branch.
 func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error) {
 	ctx := context.Background()
@@ -1102,7 +1222,7 @@ func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error)
 		return out, fmt.Errorf("failed to add files: %w", err)
 	}
 
-	out, err = m.runCmd(ctx, "commit", "-m", message)
+	out, err = m.runCommitCmd(ctx, "-m", message)
 	if err != nil {
 		if strings.Contains(out, "nothing to commit, working tree clean") {
 			return out, nil
@@ -1252,6 +1372,19 @@ func (m *nativeGitClient) runCmd(ctx context.Context, args ...string) (string, e
 	return m.runCmdOutput(cmd, runOpts{})
 }
 
+// runCommitCmd runs git commit with the given arguments, signing the commit if the client is configured to
+func (m *nativeGitClient) runCommitCmd(ctx context.Context, args ...string) (string, error) {
+	if m.commitSigning == nil {
+		return m.runCmd(ctx, append([]string{"commit"}, args...)...)
+	}
+	signArgs := []string{"-c", "gpg.format=" + string(m.commitSigning.Format), "commit", "--gpg-sign=" + m.commitSigning.Key}
+	cmd := exec.CommandContext(ctx, "git", append(signArgs, args...)...)
+	if m.commitSigning.GnuPGHome != "" {
+		cmd.Env = append(cmd.Env, "GNUPGHOME="+m.commitSigning.GnuPGHome)
+	}
+	return m.runCmdOutput(cmd, runOpts{})
+}
+
 // runCredentialedCmd is a convenience function to run a git command with username/password credentials
 func (m *nativeGitClient) runCredentialedCmd(ctx context.Context, args ...string) error {
 	closer, environ, err := m.creds.Environ()
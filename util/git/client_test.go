@@ -861,6 +861,59 @@ func Test_nativeGitClient_RemoveContents_SpecificPath(t *testing.T) {
 	require.Equal(t, "README.md", strings.TrimSpace(string(ls)))
 }
 
+func Test_nativeGitClient_CheckoutPaths(t *testing.T) {
+	// given
+	ctx := t.Context()
+	tempDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	_, err = client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err)
+
+	err = runCmd(ctx, client.Root(), "mkdir", "app", "other")
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(client.Root(), "app", "manifest.yaml"), []byte("v1"), 0o644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(client.Root(), "other", "manifest.yaml"), []byte("v1"), 0o644)
+	require.NoError(t, err)
+	err = runCmd(ctx, client.Root(), "git", "add", "--all")
+	require.NoError(t, err)
+	err = runCmd(ctx, client.Root(), "git", "commit", "-m", "v1")
+	require.NoError(t, err)
+	revision, err := client.CommitSHA()
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(client.Root(), "app", "manifest.yaml"), []byte("v2"), 0o644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(client.Root(), "other", "manifest.yaml"), []byte("v2"), 0o644)
+	require.NoError(t, err)
+	err = runCmd(ctx, client.Root(), "git", "commit", "-a", "-m", "v2")
+	require.NoError(t, err)
+
+	// when: checkout only the "app" directory of the first commit
+	_, err = client.CheckoutPaths(revision, []string{"app"})
+	require.NoError(t, err)
+
+	// then: "app" has the content of the first commit, "other" is unchanged
+	content, err := os.ReadFile(filepath.Join(client.Root(), "app", "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(content))
+	content, err = os.ReadFile(filepath.Join(client.Root(), "other", "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(content))
+
+	// and: the change is staged
+	status, err := outputCmd(ctx, client.Root(), "git", "status", "--porcelain")
+	require.NoError(t, err)
+	assert.Equal(t, "M  app/manifest.yaml", strings.TrimSpace(string(status)))
+}
+
 func Test_nativeGitClient_CommitAndPush(t *testing.T) {
 	ctx := t.Context()
 	tempDir, err := _createEmptyGitRepo(ctx)
@@ -909,6 +962,49 @@ func Test_nativeGitClient_CommitAndPush(t *testing.T) {
 	require.Equal(t, expectedCommitHash, actualCommitHash)
 }
 
+func Test_nativeGitClient_CommitAndPush_Signed(t *testing.T) {
+	ctx := t.Context()
+	tempDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+
+	err = runCmd(ctx, tempDir, "git", "config", "--local", "receive.denyCurrentBranch", "updateInstead")
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(t.TempDir(), "id_ed25519")
+	err = runCmd(ctx, tempDir, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath)
+	require.NoError(t, err)
+
+	client, err := NewClientExt("file://"+tempDir, t.TempDir(), NopCreds{}, true, false, "", "", WithCommitSigning(&CommitSigningOpts{
+		Format: CommitSigningFormatSSH,
+		Key:    keyPath,
+	}))
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	out, err := client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err, "error output: ", out)
+
+	// the initial commit of the orphan branch is signed
+	out, err = client.CheckoutOrOrphan("hydrated", false)
+	require.NoError(t, err, "error output: ", out)
+	commit, err := outputCmd(ctx, client.Root(), "git", "cat-file", "commit", "HEAD")
+	require.NoError(t, err)
+	assert.Contains(t, string(commit), "-----BEGIN SSH SIGNATURE-----")
+
+	err = runCmd(ctx, client.Root(), "touch", "README.md")
+	require.NoError(t, err)
+
+	out, err = client.CommitAndPush("hydrated", "docs: README")
+	require.NoError(t, err, "error output: %s", out)
+
+	commit, err = outputCmd(ctx, tempDir, "git", "cat-file", "commit", "hydrated")
+	require.NoError(t, err)
+	assert.Contains(t, string(commit), "docs: README")
+	assert.Contains(t, string(commit), "-----BEGIN SSH SIGNATURE-----")
+}
+
 func Test_newAuth_AzureWorkloadIdentity(t *testing.T) {
 	tokenprovider := new(mocks.TokenProvider)
 	tokenprovider.EXPECT().GetToken(azureDevopsEntraResourceId).Return(&workloadidentity.Token{AccessToken: "accessToken"}, nil).Maybe()
@@ -1458,3 +1554,51 @@ func Test_nativeGitClient_HasFileChanged(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, changed, "expected modified file to be reported as changed")
 }
+
+func Test_nativeGitClient_PartialCloneSparseCheckout(t *testing.T) {
+	ctx := t.Context()
+	tempDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+	err = runCmd(ctx, tempDir, "git", "config", "--local", "uploadpack.allowFilter", "true")
+	require.NoError(t, err)
+	for _, file := range []string{"app/manifest.yaml", "base/manifest.yaml", "other/manifest.yaml", "README.md"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, filepath.Dir(file)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, file), []byte(file), 0o644))
+	}
+	err = runCmd(ctx, tempDir, "git", "add", ".")
+	require.NoError(t, err)
+	err = runCmd(ctx, tempDir, "git", "commit", "-m", "Add manifests")
+	require.NoError(t, err)
+
+	root := t.TempDir()
+	client, err := NewClientExt("file://"+tempDir, root, NopCreds{}, true, false, "", "", WithPartialClone(true), WithSparseCheckout([]string{"app", "base/"}))
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+	require.NoError(t, client.Fetch("", 0))
+	commitSHA, err := client.LsRemote("HEAD")
+	require.NoError(t, err)
+	out, err := client.Checkout(commitSHA, false)
+	require.NoError(t, err, "error output: ", out)
+
+	promisor, err := outputCmd(ctx, root, "git", "config", "remote.origin.promisor")
+	require.NoError(t, err)
+	assert.Equal(t, "true", strings.TrimSpace(string(promisor)))
+	assert.FileExists(t, filepath.Join(root, "app/manifest.yaml"))
+	assert.FileExists(t, filepath.Join(root, "base/manifest.yaml"))
+	assert.NoFileExists(t, filepath.Join(root, "other/manifest.yaml"))
+	assert.NoFileExists(t, filepath.Join(root, "README.md"))
+
+	// checking out the same revision without sparse checkout restores all paths
+	client, err = NewClientExt("file://"+tempDir, root, NopCreds{}, true, false, "", "", WithPartialClone(true))
+	require.NoError(t, err)
+	out, err = client.Checkout(commitSHA, false)
+	require.NoError(t, err, "error output: ", out)
+	assert.FileExists(t, filepath.Join(root, "other/manifest.yaml"))
+	assert.FileExists(t, filepath.Join(root, "README.md"))
+}
+
+func Test_sparseCheckoutPatterns(t *testing.T) {
+	assert.Equal(t, []string{"/*"}, sparseCheckoutPatterns(nil))
+	assert.Equal(t, []string{"/app", "/base/overlays"}, sparseCheckoutPatterns([]string{"app/", "/base/overlays"}))
+	assert.Equal(t, []string{"/*"}, sparseCheckoutPatterns([]string{"app", "."}))
+}
@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -973,3 +974,73 @@ func (creds AzureWorkloadIdentityCreds) GetAzureDevOpsAccessToken() (string, err
 	accessToken, err := creds.getAccessToken(azureDevopsEntraResourceId) // wellknown resourceid of Azure DevOps
 	return accessToken, err
 }
+
+// ScopedConfig returns the git configuration which makes git access the repository with the given HTTP(S) URL with the
+// given credentials. Unlike the environment returned by the credentials, the configuration only applies to the URL of
+// the repository, so that a single git process, e.g. kustomize fetching remote bases, can access several repositories
+// with different credentials. The returned closer must be closed once git is done. SSH credentials cannot be scoped
+// to a repository.
+func ScopedConfig(repoURL string, creds Creds) (io.Closer, map[string]string, error) {
+	if !IsHTTPSURL(repoURL) && !IsHTTPURL(repoURL) {
+		return NopCloser{}, nil, fmt.Errorf("credentials of repository %s cannot be scoped to it: only HTTP(S) repositories are supported", repoURL)
+	}
+	closer, environ, err := creds.Environ()
+	if err != nil {
+		return NopCloser{}, nil, err
+	}
+
+	httpSettings := map[string]string{}
+	var askPass string
+	var askPassEnv []string
+	for _, e := range environ {
+		name, value, _ := strings.Cut(e, "=")
+		switch name {
+		case "GIT_SSL_NO_VERIFY":
+			httpSettings["sslVerify"] = "false"
+		case "GIT_SSL_CERT":
+			httpSettings["sslCert"] = value
+		case "GIT_SSL_KEY":
+			httpSettings["sslKey"] = value
+		case "GIT_SSL_CAINFO":
+			httpSettings["sslCAInfo"] = value
+		case forceBasicAuthHeaderEnv, bearerAuthHeaderEnv:
+			httpSettings["extraHeader"] = value
+		case "GIT_SSH_COMMAND":
+			utilio.Close(closer)
+			return NopCloser{}, nil, fmt.Errorf("SSH credentials of repository %s cannot be scoped to it", repoURL)
+		case "GIT_ASKPASS":
+			askPass = value
+		default:
+			// the rest of the environment is needed by the askpass command to look up the credentials
+			askPassEnv = append(askPassEnv, name+"="+shellQuote(value))
+		}
+	}
+	if _, ok := httpSettings["sslVerify"]; !ok && httpSettings["sslCAInfo"] == "" {
+		if parsedURL, err := url.Parse(repoURL); err == nil {
+			if caPath, err := certutil.GetCertBundlePathForRepository(parsedURL.Host); err == nil && caPath != "" {
+				httpSettings["sslCAInfo"] = caPath
+			}
+		}
+	}
+
+	config := map[string]string{}
+	// the credential configuration only matches the exact path of the repository, which may or may not be fetched with
+	// the .git suffix
+	baseURL := strings.TrimSuffix(strings.TrimSuffix(repoURL, "/"), ".git")
+	for _, u := range []string{baseURL, baseURL + ".git"} {
+		for name, value := range httpSettings {
+			config["http."+u+"."+name] = value
+		}
+		if askPass != "" {
+			// git invokes the credential helper with the "get" argument, which the askpass command answers to like a
+			// git credential helper
+			config["credential."+u+".helper"] = "!" + strings.Join(append(slices.Clone(askPassEnv), shellQuote(askPass)), " ")
+		}
+	}
+	return closer, config, nil
+}
+
+// shellQuote quotes the given value for a POSIX shell
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
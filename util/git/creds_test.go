@@ -637,3 +637,50 @@ func TestExtractOrgFromRepoURL(t *testing.T) {
 		})
 	}
 }
+
+type askPassCredsStore struct {
+	memoryCredsStore
+}
+
+func (s *askPassCredsStore) Environ(id string) []string {
+	return []string{"GIT_ASKPASS=argocd", "ASKPASS_NONCE=" + id, "ARGOCD_BINARY_NAME=argocd-git-ask-pass"}
+}
+
+func TestScopedConfig(t *testing.T) {
+	t.Run("HTTPS", func(t *testing.T) {
+		store := &askPassCredsStore{memoryCredsStore{creds: make(map[string]cred)}}
+		creds := NewHTTPSCreds("user", "pass", "", "", "", true, store, true)
+		closer, config, err := ScopedConfig("https://github.com/org/repo.git", creds)
+		require.NoError(t, err)
+		require.Len(t, store.creds, 1)
+		var nonce string
+		for id := range store.creds {
+			nonce = id
+		}
+		helper := "!ASKPASS_NONCE='" + nonce + "' ARGOCD_BINARY_NAME='argocd-git-ask-pass' 'argocd'"
+		assert.Equal(t, map[string]string{
+			"http.https://github.com/org/repo.sslVerify":        "false",
+			"http.https://github.com/org/repo.extraHeader":      creds.(HTTPSCreds).BasicAuthHeader(),
+			"credential.https://github.com/org/repo.helper":     helper,
+			"http.https://github.com/org/repo.git.sslVerify":    "false",
+			"http.https://github.com/org/repo.git.extraHeader":  creds.(HTTPSCreds).BasicAuthHeader(),
+			"credential.https://github.com/org/repo.git.helper": helper,
+		}, config)
+
+		utilio.Close(closer)
+		assert.Empty(t, store.creds)
+	})
+
+	t.Run("SSH", func(t *testing.T) {
+		_, _, err := ScopedConfig("git@github.com:org/repo.git", NopCreds{})
+		require.ErrorContains(t, err, "only HTTP(S) repositories are supported")
+
+		_, _, err = ScopedConfig("https://github.com/org/repo.git", NewSSHCreds("key", "", true, ""))
+		require.ErrorContains(t, err, "SSH credentials of repository https://github.com/org/repo.git cannot be scoped to it")
+	})
+}
+
+func Test_shellQuote(t *testing.T) {
+	assert.Equal(t, `'it'\''s'`, shellQuote("it's"))
+	assert.Equal(t, `''`, shellQuote(""))
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -413,14 +415,14 @@ func (c *Cmd) template(chartPath string, opts *TemplateOpts) (string, string, er
 	if opts.KubeVersion != "" {
 		args = append(args, "--kube-version", opts.KubeVersion)
 	}
-	for key, val := range opts.Set {
-		args = append(args, "--set", key+"="+cleanSetParameters(val))
+	for _, key := range slices.Sorted(maps.Keys(opts.Set)) {
+		args = append(args, "--set", key+"="+cleanSetParameters(opts.Set[key]))
 	}
-	for key, val := range opts.SetString {
-		args = append(args, "--set-string", key+"="+cleanSetParameters(val))
+	for _, key := range slices.Sorted(maps.Keys(opts.SetString)) {
+		args = append(args, "--set-string", key+"="+cleanSetParameters(opts.SetString[key]))
 	}
-	for key, val := range opts.SetFile {
-		args = append(args, "--set-file", key+"="+cleanSetParameters(string(val)))
+	for _, key := range slices.Sorted(maps.Keys(opts.SetFile)) {
+		args = append(args, "--set-file", key+"="+cleanSetParameters(string(opts.SetFile[key])))
 	}
 	for _, val := range opts.Values {
 		args = append(args, "--values", string(val))
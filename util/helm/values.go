@@ -0,0 +1,221 @@
+package helm
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// MergedValues returns the YAML of the values the chart is templated with, merged in the order Helm merges them: the
+// value files, the extra values, and then the parameters. Remote value files are not downloaded, so their values are
+// not included. The defaults of the chart are not included either.
+func MergedValues(opts *TemplateOpts) ([]byte, error) {
+	values := map[string]any{}
+	valueFiles := opts.Values
+	if opts.ExtraValues != "" {
+		valueFiles = append(valueFiles[:len(valueFiles):len(valueFiles)], opts.ExtraValues)
+	}
+	for _, valueFile := range valueFiles {
+		// Resolved value files are either absolute paths or URLs.
+		if !filepath.IsAbs(string(valueFile)) {
+			continue
+		}
+		data, err := os.ReadFile(string(valueFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read value file: %w", err)
+		}
+		fileValues := map[string]any{}
+		if err := yaml.Unmarshal(data, &fileValues); err != nil {
+			return nil, fmt.Errorf("failed to parse value file %s: %w", filepath.Base(string(valueFile)), err)
+		}
+		mergeValues(values, fileValues)
+	}
+
+	// The parameters are set in the order of their names, which is the order they are passed to Helm in, so that
+	// overlapping parameters, such as a and a.b, are set the same way.
+	for _, name := range slices.Sorted(maps.Keys(opts.Set)) {
+		if err := setValue(values, name, parseSetValue(opts.Set[name])); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(opts.SetString)) {
+		if err := setValue(values, name, opts.SetString[name]); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(opts.SetFile)) {
+		file := opts.SetFile[name]
+		data, err := os.ReadFile(string(file))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file of parameter %s: %w", name, err)
+		}
+		if err := setValue(values, name, string(data)); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return yaml.Marshal(values)
+}
+
+// mergeValues merges src into dst. Maps are merged recursively, other values of src replace the values of dst, and
+// null values of src delete the keys from dst, like Helm does.
+func mergeValues(dst, src map[string]any) {
+	for key, value := range src {
+		if value == nil {
+			delete(dst, key)
+			continue
+		}
+		srcMap, srcIsMap := value.(map[string]any)
+		dstMap, dstIsMap := dst[key].(map[string]any)
+		if srcIsMap && dstIsMap {
+			mergeValues(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// parseSetValue returns the typed value of a --set parameter, following the typing rules of Helm: null, booleans and
+// integers are typed, {a,b} is a list, and anything else is a string.
+func parseSetValue(value string) any {
+	switch value {
+	case "null":
+		return nil
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}") {
+		list := []any{}
+		if items := value[1 : len(value)-1]; items != "" {
+			for item := range strings.SplitSeq(items, ",") {
+				list = append(list, parseSetValue(item))
+			}
+		}
+		return list
+	}
+	// Helm keeps numbers with leading zeros as strings.
+	if value == "0" || !strings.HasPrefix(value, "0") {
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i
+		}
+	}
+	return value
+}
+
+// setValue sets the value at the path of a parameter name, such as a.b[0].c, creating the maps and lists on the path.
+// Dots escaped with a backslash are part of the keys.
+func setValue(values map[string]any, name string, value any) error {
+	var keys []string
+	var key strings.Builder
+	for i := 0; i < len(name); i++ {
+		switch {
+		case name[i] == '\\' && i+1 < len(name) && name[i+1] == '.':
+			key.WriteByte('.')
+			i++
+		case name[i] == '.':
+			keys = append(keys, key.String())
+			key.Reset()
+		default:
+			key.WriteByte(name[i])
+		}
+	}
+	keys = append(keys, key.String())
+
+	var current any = values
+	for i, k := range keys {
+		last := i == len(keys)-1
+		k, indexes, err := parseIndexes(k)
+		if err != nil {
+			return fmt.Errorf("invalid parameter name %q: %w", name, err)
+		}
+		m := current.(map[string]any)
+		if len(indexes) == 0 {
+			if last {
+				m[k] = value
+				return nil
+			}
+			next, ok := m[k].(map[string]any)
+			if !ok {
+				next = map[string]any{}
+				m[k] = next
+			}
+			current = next
+			continue
+		}
+		list, _ := m[k].([]any)
+		list = growList(list, indexes[0])
+		m[k] = list
+		// nested lists, such as a[0][1], are indexed one after the other
+		for j, index := range indexes[:len(indexes)-1] {
+			nextList, _ := list[index].([]any)
+			nextList = growList(nextList, indexes[j+1])
+			list[index] = nextList
+			list = nextList
+		}
+		index := indexes[len(indexes)-1]
+		if last {
+			list[index] = value
+			return nil
+		}
+		next, ok := list[index].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			list[index] = next
+		}
+		current = next
+	}
+	return nil
+}
+
+// growList appends null items to the given list until it has the given index
+func growList(list []any, index int) []any {
+	for len(list) <= index {
+		list = append(list, nil)
+	}
+	return list
+}
+
+// maxIndex is the maximum list index of a parameter name, the same as Helm's, so that a name such as a[1000000000]
+// cannot allocate a huge list
+const maxIndex = 65536
+
+// parseIndexes splits a key such as a[0] or a[0][1] into its name and list indexes. The indexes are empty if the key
+// has no index.
+func parseIndexes(key string) (string, []int, error) {
+	name, rest, found := strings.Cut(key, "[")
+	if !found {
+		return key, nil, nil
+	}
+	var indexes []int
+	for {
+		value, next, found := strings.Cut(rest, "]")
+		if !found {
+			return "", nil, fmt.Errorf("unterminated index in %q", key)
+		}
+		index, err := strconv.Atoi(value)
+		if err != nil || index < 0 {
+			return "", nil, fmt.Errorf("invalid index in %q", key)
+		}
+		if index > maxIndex {
+			return "", nil, fmt.Errorf("index of %d is greater than maximum supported index %d", index, maxIndex)
+		}
+		indexes = append(indexes, index)
+		if next == "" {
+			return name, indexes, nil
+		}
+		if rest, found = strings.CutPrefix(next, "["); !found {
+			return "", nil, fmt.Errorf("invalid index in %q", key)
+		}
+	}
+}
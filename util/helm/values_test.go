@@ -0,0 +1,106 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+)
+
+func writeValuesFile(t *testing.T, name, content string) pathutil.ResolvedFilePath {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	return pathutil.ResolvedFilePath(p)
+}
+
+func TestMergedValues(t *testing.T) {
+	t.Run("no values", func(t *testing.T) {
+		values, err := MergedValues(&TemplateOpts{})
+		require.NoError(t, err)
+		assert.Nil(t, values)
+	})
+
+	t.Run("merges the values in the order of Helm", func(t *testing.T) {
+		opts := &TemplateOpts{
+			Values: []pathutil.ResolvedFilePath{
+				writeValuesFile(t, "values.yaml", "image:\n  repository: nginx\n  tag: \"1.0\"\nreplicas: 1\nlabels:\n  team: a\n"),
+				"https://example.com/values.yaml",
+				writeValuesFile(t, "values-prod.yaml", "image:\n  tag: \"2.0\"\nlabels: null\n"),
+			},
+			ExtraValues: writeValuesFile(t, "extra.yaml", "replicas: 2\n"),
+			Set:         map[string]string{"replicas": "3", "ingress.enabled": "true", "hosts[1].name": "b.example.com", "tolerations": "{a,b}"},
+			SetString:   map[string]string{"podAnnotations.example\\.com/port": "8080"},
+			SetFile:     map[string]pathutil.ResolvedFilePath{"config": writeValuesFile(t, "config.txt", "key=value")},
+		}
+
+		values, err := MergedValues(opts)
+		require.NoError(t, err)
+		assert.Equal(t, `config: key=value
+hosts:
+- null
+- name: b.example.com
+image:
+  repository: nginx
+  tag: "2.0"
+ingress:
+  enabled: true
+podAnnotations:
+  example.com/port: "8080"
+replicas: 3
+tolerations:
+- a
+- b
+`, string(values))
+	})
+
+	t.Run("overlapping parameters are set in the order of their names", func(t *testing.T) {
+		for range 10 {
+			values, err := MergedValues(&TemplateOpts{Set: map[string]string{"image.tag": "1.0", "image": "nginx", "image.pullPolicy": "Always"}})
+			require.NoError(t, err)
+			assert.Equal(t, "image:\n  pullPolicy: Always\n  tag: \"1.0\"\n", string(values))
+		}
+	})
+
+	t.Run("nested list indexes", func(t *testing.T) {
+		values, err := MergedValues(&TemplateOpts{Set: map[string]string{"matrix[1][0]": "a", "matrix[0][1].name": "b", "matrix[1][1]": "c"}})
+		require.NoError(t, err)
+		assert.Equal(t, `matrix:
+- - null
+  - name: b
+- - a
+  - c
+`, string(values))
+	})
+
+	t.Run("invalid parameter name", func(t *testing.T) {
+		for _, name := range []string{"hosts[x]", "hosts[", "hosts[0", "hosts[0]x", "hosts[0][", "hosts[0]]"} {
+			_, err := MergedValues(&TemplateOpts{Set: map[string]string{name: "a"}})
+			assert.ErrorContains(t, err, `invalid parameter name "`+name+`"`)
+		}
+	})
+
+	t.Run("index greater than the maximum", func(t *testing.T) {
+		_, err := MergedValues(&TemplateOpts{Set: map[string]string{"hosts[65536]": "a"}})
+		require.NoError(t, err)
+		_, err = MergedValues(&TemplateOpts{Set: map[string]string{"hosts[1000000000].name": "a"}})
+		assert.ErrorContains(t, err, "index of 1000000000 is greater than maximum supported index 65536")
+		_, err = MergedValues(&TemplateOpts{Set: map[string]string{"hosts[0][1000000000]": "a"}})
+		assert.ErrorContains(t, err, "index of 1000000000 is greater than maximum supported index 65536")
+	})
+}
+
+func TestParseSetValue(t *testing.T) {
+	assert.Nil(t, parseSetValue("null"))
+	assert.Equal(t, true, parseSetValue("true"))
+	assert.Equal(t, int64(42), parseSetValue("42"))
+	assert.Equal(t, int64(0), parseSetValue("0"))
+	assert.Equal(t, "042", parseSetValue("042"))
+	assert.Equal(t, "1.5", parseSetValue("1.5"))
+	assert.Equal(t, []any{}, parseSetValue("{}"))
+	assert.Equal(t, []any{"a", int64(1)}, parseSetValue("{a,1}"))
+}
@@ -0,0 +1,293 @@
+package helmfile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+	"github.com/argoproj/argo-cd/v3/util/proxy"
+)
+
+const (
+	// FileName is the name of the helmfile state file helmfile loads by default
+	FileName = "helmfile.yaml"
+	// TemplateFileName is the name of the helmfile state file helmfile loads by default, which is rendered as a Go
+	// template
+	TemplateFileName = "helmfile.yaml.gotmpl"
+	// DirName is the name of the directory of state files helmfile loads by default if there is no state file
+	DirName = "helmfile.d"
+)
+
+// IsHelmfile returns whether the given file name is the name of a state file helmfile loads by default
+func IsHelmfile(name string) bool {
+	return name == FileName || name == TemplateFileName
+}
+
+// TemplateOpts are the options of a `helmfile template` command
+type TemplateOpts struct {
+	Environment      string
+	Selectors        []string
+	StateValuesFiles []pathutil.ResolvedFilePath
+	StateValues      map[string]string
+	Namespace        string
+	KubeVersion      string
+	APIVersions      []string
+	SkipTests        bool
+}
+
+// Repository is a Helm repository declared by a helmfile state
+type Repository struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	OCI  bool   `json:"oci,omitempty"`
+}
+
+type state struct {
+	Repositories    []Repository `json:"repositories,omitempty"`
+	HelmBinary      string       `json:"helmBinary,omitempty"`
+	KustomizeBinary string       `json:"kustomizeBinary,omitempty"`
+	HelmDefaults    struct {
+		Args         []string `json:"args,omitempty"`
+		PostRenderer string   `json:"postRenderer,omitempty"`
+	} `json:"helmDefaults"`
+	Hooks    []any `json:"hooks,omitempty"`
+	Releases []struct {
+		Name         string `json:"name"`
+		Hooks        []any  `json:"hooks,omitempty"`
+		PostRenderer string `json:"postRenderer,omitempty"`
+	} `json:"releases,omitempty"`
+}
+
+// validate returns an error if the state runs commands of its own when it is rendered, which is not allowed since the
+// state comes from the repository of the application
+func (s *state) validate() error {
+	if s.HelmBinary != "" || s.KustomizeBinary != "" {
+		return errors.New("helmfile states setting helmBinary or kustomizeBinary are not supported")
+	}
+	if len(s.Hooks) > 0 {
+		return errors.New("helmfile hooks are not supported")
+	}
+	if s.HelmDefaults.PostRenderer != "" || slices.ContainsFunc(s.HelmDefaults.Args, func(arg string) bool { return strings.HasPrefix(arg, "--post-renderer") }) {
+		return errors.New("helmfile post-renderers are not supported")
+	}
+	for _, release := range s.Releases {
+		if len(release.Hooks) > 0 {
+			return fmt.Errorf("helmfile hooks are not supported: release %s has hooks", release.Name)
+		}
+		if release.PostRenderer != "" {
+			return fmt.Errorf("helmfile post-renderers are not supported: release %s has a post-renderer", release.Name)
+		}
+	}
+	return nil
+}
+
+// Helmfile provides wrapper functionality around the `helmfile` command.
+type Helmfile interface {
+	// Repositories returns the Helm repositories declared by the helmfile state, as rendered by a `helmfile build`
+	// command. It returns an error if the state has hooks, post-renderers or custom binaries, which would run commands
+	// in the repo-server.
+	Repositories(opts *TemplateOpts) ([]Repository, error)
+	// Template returns the manifests of the releases of a `helmfile template` command, pulling the charts from the
+	// given Helm repositories with their credentials, along with the command. The state must have been checked by
+	// Repositories first.
+	Template(opts *TemplateOpts, repos []helm.HelmRepository) (string, string, error)
+	// Dispose deletes temp resources
+	Dispose()
+}
+
+// NewHelmfileApp create a new wrapper to run commands on the `helmfile` command-line tool.
+func NewHelmfileApp(workDir string, env []string, proxy string, noProxy string) (Helmfile, error) {
+	return newHelmfileApp(workDir, env, proxy, noProxy, executil.RunWithRedactor)
+}
+
+func newHelmfileApp(workDir string, env []string, proxy string, noProxy string, runWithRedactor func(cmd *exec.Cmd, redactor func(text string) string) (string, error)) (*helmfile, error) {
+	home, err := os.MkdirTemp("", "helmfile")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory for helmfile: %w", err)
+	}
+	return &helmfile{workDir: workDir, home: home, env: env, proxy: proxy, noProxy: noProxy, runWithRedactor: runWithRedactor}, nil
+}
+
+type helmfile struct {
+	workDir         string
+	home            string
+	env             []string
+	proxy           string
+	noProxy         string
+	runWithRedactor func(cmd *exec.Cmd, redactor func(text string) string) (string, error)
+}
+
+var _ Helmfile = &helmfile{}
+
+var apiVersionsRemover = regexp.MustCompile(`(--api-versions [^ ]+ )+`)
+
+// repositoryNameRegexp matches the names of the repositories whose credentials can be passed to helmfile as environment
+// variables. The names come from the state, so they must not be able to inject other environment variables.
+var repositoryNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func (h *helmfile) run(repos []helm.HelmRepository, args ...string) (string, string, error) {
+	cmd := exec.CommandContext(context.Background(), "helmfile", args...)
+	cmd.Dir = h.workDir
+	cmd.Env = append(os.Environ(), h.env...)
+	var passwords []string
+	for _, repo := range repos {
+		if repo.Name == "" {
+			continue
+		}
+		if !repositoryNameRegexp.MatchString(repo.Name) {
+			log.Warnf("Credentials of helm repository %s are not passed to helmfile: its name %q is not a valid environment variable prefix", repo.Repo, repo.Name)
+			continue
+		}
+		password, err := repo.GetPassword()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get password for helm repository %s: %w", repo.Repo, err)
+		}
+		cmd.Env = append(cmd.Env, credentialsEnv(repo.Name, repo.GetUsername(), password)...)
+		if password != "" {
+			passwords = append(passwords, password)
+		}
+	}
+	// helmfile runs helm, which must not share its repositories, registry logins and caches with other applications.
+	// These are set last, since the last value of a duplicated variable wins.
+	cmd.Env = append(cmd.Env,
+		fmt.Sprintf("XDG_CACHE_HOME=%s/cache", h.home),
+		fmt.Sprintf("XDG_CONFIG_HOME=%s/config", h.home),
+		fmt.Sprintf("XDG_DATA_HOME=%s/data", h.home),
+		fmt.Sprintf("HELM_CACHE_HOME=%s/cache/helm", h.home),
+		fmt.Sprintf("HELM_CONFIG_HOME=%s/config/helm", h.home),
+		fmt.Sprintf("HELM_DATA_HOME=%s/data/helm", h.home),
+		// disables the template functions running commands and reading files outside of the state, such as exec,
+		// and the remote states
+		"HELMFILE_DISABLE_INSECURE_FEATURES=true")
+	cmd.Env = proxy.UpsertEnv(cmd, h.proxy, h.noProxy)
+	fullCommand := executil.GetCommandArgsToLog(cmd)
+
+	out, err := h.runWithRedactor(cmd, executil.Redact(passwords))
+	if err != nil {
+		return out, fullCommand, fmt.Errorf("failed running helmfile: %w", err)
+	}
+	return out, fullCommand, nil
+}
+
+// credentialsEnv returns the environment variables helmfile reads the credentials of the repository with the given
+// name from, when the helmfile state does not set them
+func credentialsEnv(name string, username string, password string) []string {
+	var env []string
+	prefix := strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	if username != "" {
+		env = append(env, fmt.Sprintf("%s_USERNAME=%s", prefix, username))
+	}
+	if password != "" {
+		env = append(env, fmt.Sprintf("%s_PASSWORD=%s", prefix, password))
+	}
+	return env
+}
+
+// globalArgs returns the arguments of the helmfile command selecting the environment, the state values and the
+// releases
+func globalArgs(opts *TemplateOpts) []string {
+	args := []string{"--quiet", "--no-color"}
+	if opts.Environment != "" {
+		args = append(args, "--environment", opts.Environment)
+	}
+	for _, selector := range opts.Selectors {
+		args = append(args, "--selector", selector)
+	}
+	for _, file := range opts.StateValuesFiles {
+		args = append(args, "--state-values-file", string(file))
+	}
+	for _, key := range slices.Sorted(maps.Keys(opts.StateValues)) {
+		args = append(args, "--state-values-set", key+"="+opts.StateValues[key])
+	}
+	if opts.Namespace != "" {
+		args = append(args, "--namespace", opts.Namespace)
+	}
+	return args
+}
+
+func (h *helmfile) Repositories(opts *TemplateOpts) ([]Repository, error) {
+	args := append(globalArgs(opts), "build")
+	out, _, err := h.run(nil, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute helmfile build command: %w", err)
+	}
+	var repos []Repository
+	seen := map[string]bool{}
+	// helmfile build outputs a document for each state file
+	for _, doc := range strings.Split(out, "\n---") {
+		var s state
+		if err := yaml.Unmarshal([]byte(doc), &s); err != nil {
+			return nil, fmt.Errorf("failed to parse helmfile state: %w", err)
+		}
+		if err := s.validate(); err != nil {
+			return nil, err
+		}
+		for _, repo := range s.Repositories {
+			if !seen[repo.Name] {
+				seen[repo.Name] = true
+				repos = append(repos, repo)
+			}
+		}
+	}
+	return repos, nil
+}
+
+func (h *helmfile) Template(opts *TemplateOpts, repos []helm.HelmRepository) (string, string, error) {
+	args := append(globalArgs(opts), "template", "--include-crds")
+	if opts.KubeVersion != "" {
+		args = append(args, "--kube-version", opts.KubeVersion)
+	}
+	if opts.SkipTests {
+		args = append(args, "--skip-tests")
+	}
+	if len(opts.APIVersions) > 0 {
+		apiVersionArgs := make([]string, 0, len(opts.APIVersions))
+		for _, v := range opts.APIVersions {
+			apiVersionArgs = append(apiVersionArgs, "--api-versions "+v)
+		}
+		args = append(args, "--args", strings.Join(apiVersionArgs, " "))
+	}
+
+	out, command, err := h.run(repos, args...)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(msg, "--api-versions") {
+			log.Debug(msg)
+			msg = apiVersionsRemover.ReplaceAllString(msg+" ", "<api versions removed> ")
+		}
+		return "", command, fmt.Errorf("failed to execute helmfile template command: %s", strings.TrimSpace(msg))
+	}
+	return out, command, nil
+}
+
+func (h *helmfile) Dispose() {
+	if err := os.RemoveAll(h.home); err != nil {
+		log.Warnf("Failed to remove helmfile temporary directory %s: %v", h.home, err)
+	}
+}
+
+// StateDir returns the application directory of the given helmfile state file, if it is loaded by default
+func StateDir(path string) (string, bool) {
+	dir, name := filepath.Split(path)
+	dir = filepath.Clean(dir)
+	if IsHelmfile(name) {
+		return dir, true
+	}
+	if filepath.Base(dir) == DirName && (strings.HasSuffix(name, ".yaml") || strings.HasSuffix(name, ".yaml.gotmpl")) {
+		return filepath.Dir(dir), true
+	}
+	return "", false
+}
@@ -0,0 +1,155 @@
+package helmfile
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+)
+
+func newTestHelmfileApp(t *testing.T, out string, cmds *[]*exec.Cmd) *helmfile {
+	t.Helper()
+	h, err := newHelmfileApp(".", []string{"ARGOCD_APP_NAME=my-app"}, "", "", func(cmd *exec.Cmd, _ func(text string) string) (string, error) {
+		*cmds = append(*cmds, cmd)
+		return out, nil
+	})
+	require.NoError(t, err)
+	t.Cleanup(h.Dispose)
+	return h
+}
+
+func TestIsHelmfile(t *testing.T) {
+	assert.True(t, IsHelmfile("helmfile.yaml"))
+	assert.True(t, IsHelmfile("helmfile.yaml.gotmpl"))
+	assert.False(t, IsHelmfile("helmfile.yml"))
+	assert.False(t, IsHelmfile("Chart.yaml"))
+}
+
+func TestStateDir(t *testing.T) {
+	dir, ok := StateDir(filepath.Join("apps", "redis", "helmfile.yaml"))
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join("apps", "redis"), dir)
+
+	dir, ok = StateDir(filepath.Join("apps", "redis", "helmfile.d", "00-redis.yaml.gotmpl"))
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join("apps", "redis"), dir)
+
+	_, ok = StateDir(filepath.Join("apps", "redis", "helmfile.d", "README.md"))
+	assert.False(t, ok)
+
+	_, ok = StateDir(filepath.Join("apps", "redis", "values.yaml"))
+	assert.False(t, ok)
+}
+
+func TestHelmfile_Repositories(t *testing.T) {
+	var cmds []*exec.Cmd
+	h := newTestHelmfileApp(t, `---
+repositories:
+- name: bitnami
+  url: https://charts.bitnami.com/bitnami
+releases:
+- name: redis
+  chart: bitnami/redis
+---
+repositories:
+- name: bitnami
+  url: https://charts.bitnami.com/bitnami
+- name: registry
+  url: registry.example.com/charts
+  oci: true
+`, &cmds)
+
+	repos, err := h.Repositories(&TemplateOpts{Environment: "production"})
+	require.NoError(t, err)
+	assert.Equal(t, []Repository{
+		{Name: "bitnami", URL: "https://charts.bitnami.com/bitnami"},
+		{Name: "registry", URL: "registry.example.com/charts", OCI: true},
+	}, repos)
+	require.Len(t, cmds, 1)
+	assert.Equal(t, []string{"helmfile", "--quiet", "--no-color", "--environment", "production", "build"}, cmds[0].Args)
+}
+
+func TestHelmfile_Repositories_CommandsNotAllowed(t *testing.T) {
+	for name, doc := range map[string]string{
+		"hooks":            "hooks:\n- events: [prepare]\n  command: sh\n",
+		"release hooks":    "releases:\n- name: redis\n  hooks:\n  - events: [prepare]\n    command: sh\n",
+		"helm binary":      "helmBinary: ./helm.sh\n",
+		"kustomize binary": "kustomizeBinary: ./kustomize.sh\n",
+		"post-renderer":    "releases:\n- name: redis\n  postRenderer: ./render.sh\n",
+		"helm args":        "helmDefaults:\n  args:\n  - --post-renderer=./render.sh\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			var cmds []*exec.Cmd
+			h := newTestHelmfileApp(t, "---\nrepositories: []\n---\n"+doc, &cmds)
+			_, err := h.Repositories(&TemplateOpts{})
+			assert.ErrorContains(t, err, "are not supported")
+		})
+	}
+}
+
+func TestHelmfile_Template(t *testing.T) {
+	var cmds []*exec.Cmd
+	h := newTestHelmfileApp(t, "apiVersion: v1\nkind: ConfigMap\n", &cmds)
+
+	out, command, err := h.Template(&TemplateOpts{
+		Environment:      "production",
+		Selectors:        []string{"tier=frontend"},
+		StateValuesFiles: []pathutil.ResolvedFilePath{"/tmp/values.yaml"},
+		StateValues:      map[string]string{"replicas": "3", "image.tag": "v1"},
+		Namespace:        "guestbook",
+		KubeVersion:      "1.30.0",
+		APIVersions:      []string{"apps/v1/Deployment", "v1/ConfigMap"},
+		SkipTests:        true,
+	}, []helm.HelmRepository{
+		{Name: "private-charts", Repo: "https://charts.example.com", Creds: helm.HelmCreds{Username: "user", Password: "secret"}},
+		{Name: "public", Repo: "https://charts.bitnami.com/bitnami", Creds: helm.HelmCreds{}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1\nkind: ConfigMap\n", out)
+	require.Len(t, cmds, 1)
+	assert.Equal(t, []string{
+		"helmfile", "--quiet", "--no-color",
+		"--environment", "production",
+		"--selector", "tier=frontend",
+		"--state-values-file", "/tmp/values.yaml",
+		"--state-values-set", "image.tag=v1",
+		"--state-values-set", "replicas=3",
+		"--namespace", "guestbook",
+		"template", "--include-crds",
+		"--kube-version", "1.30.0",
+		"--skip-tests",
+		"--args", "--api-versions apps/v1/Deployment --api-versions v1/ConfigMap",
+	}, cmds[0].Args)
+	assert.Contains(t, command, "helmfile --quiet")
+	assert.NotContains(t, command, "secret")
+	assert.Contains(t, cmds[0].Env, "ARGOCD_APP_NAME=my-app")
+	assert.Contains(t, cmds[0].Env, "PRIVATE_CHARTS_USERNAME=user")
+	assert.Contains(t, cmds[0].Env, "PRIVATE_CHARTS_PASSWORD=secret")
+	assert.Contains(t, cmds[0].Env, "HELM_CONFIG_HOME="+h.home+"/config/helm")
+	assert.Contains(t, cmds[0].Env, "HELMFILE_DISABLE_INSECURE_FEATURES=true")
+	for _, env := range cmds[0].Env {
+		assert.NotContains(t, env, "PUBLIC_")
+	}
+}
+
+func TestHelmfile_Template_InvalidRepositoryName(t *testing.T) {
+	var cmds []*exec.Cmd
+	h := newTestHelmfileApp(t, "apiVersion: v1\nkind: ConfigMap\n", &cmds)
+
+	_, _, err := h.Template(&TemplateOpts{}, []helm.HelmRepository{
+		{Name: "evil=x\nHELMFILE_DISABLE_INSECURE_FEATURES=false\nFOO", Repo: "https://charts.example.com", Creds: helm.HelmCreds{Username: "user", Password: "secret"}},
+		{Name: "HELMFILE_DISABLE_INSECURE_FEATURES=false #", Repo: "https://charts.example.com", Creds: helm.HelmCreds{Username: "user", Password: "secret"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, cmds, 1)
+	for _, env := range cmds[0].Env {
+		assert.NotContains(t, env, "secret")
+		assert.NotContains(t, env, "HELMFILE_DISABLE_INSECURE_FEATURES=false")
+	}
+	assert.Equal(t, "HELMFILE_DISABLE_INSECURE_FEATURES=true", cmds[0].Env[len(cmds[0].Env)-1])
+}
@@ -24,6 +24,9 @@ type HydratorCommitMetadata struct {
 	// Known Argocd- trailers with valid values are removed, but all other trailers are kept.
 	Body       string                    `json:"body,omitempty"`
 	References []appv1.RevisionReference `json:"references,omitempty"`
+	// Files are the files holding the hydrated manifests of a path, relative to it, when the path has a layout other
+	// than the single manifest.yaml file.
+	Files []string `json:"files,omitempty"`
 }
 
 // GetCommitMetadata takes repo, drySha and commitMetadata and returns a HydratorCommitMetadata which is a
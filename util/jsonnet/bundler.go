@@ -0,0 +1,157 @@
+package jsonnet
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+const (
+	// FileName is the name of the jsonnet-bundler file, which declares the dependencies
+	FileName = "jsonnetfile.json"
+	// LockFileName is the name of the jsonnet-bundler lock file, which pins the versions of the dependencies
+	LockFileName = "jsonnetfile.lock.json"
+	// VendorDirName is the name of the directory jsonnet-bundler installs the dependencies into
+	VendorDirName = "vendor"
+)
+
+// LockFile is a jsonnet-bundler lock file
+type LockFile struct {
+	Version      int          `json:"version"`
+	Dependencies []Dependency `json:"dependencies"`
+	// LegacyImports enables the import of the dependencies by their legacy name, e.g. 'grafonnet/grafana.libsonnet'
+	// instead of 'github.com/grafana/grafonnet-lib/grafonnet/grafana.libsonnet'. It is enabled if unset.
+	LegacyImports *bool `json:"legacyImports,omitempty"`
+}
+
+// Dependency is a dependency pinned by a jsonnet-bundler lock file
+type Dependency struct {
+	Source  Source `json:"source"`
+	Version string `json:"version"`
+	Sum     string `json:"sum,omitempty"`
+	// LegacyName overrides the legacy import name of the dependency
+	LegacyName string `json:"name,omitempty"`
+}
+
+// Source is the source of a jsonnet-bundler dependency
+type Source struct {
+	Git   *GitSource   `json:"git,omitempty"`
+	Local *LocalSource `json:"local,omitempty"`
+}
+
+// GitSource is a dependency stored in a directory of a Git repository
+type GitSource struct {
+	Remote string `json:"remote"`
+	Subdir string `json:"subdir,omitempty"`
+}
+
+// LocalSource is a dependency stored in a local directory
+type LocalSource struct {
+	Directory string `json:"directory"`
+}
+
+// ReadLockFile reads the jsonnet-bundler lock file in the given directory. It returns nil if the directory has no
+// lock file, along with the SHA-256 hash of the lock file.
+func ReadLockFile(dir string) (*LockFile, string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, LockFileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, "", nil
+		}
+		return nil, "", fmt.Errorf("failed to read %s: %w", LockFileName, err)
+	}
+	var lockFile LockFile
+	if err := json.Unmarshal(data, &lockFile); err != nil {
+		return nil, "", fmt.Errorf("failed to parse %s: %w", LockFileName, err)
+	}
+	for _, dep := range lockFile.Dependencies {
+		if err := dep.validate(); err != nil {
+			return nil, "", fmt.Errorf("invalid %s: %w", LockFileName, err)
+		}
+	}
+	hash := sha256.Sum256(data)
+	return &lockFile, hex.EncodeToString(hash[:]), nil
+}
+
+// IsLegacyImportsEnabled returns whether the dependencies can be imported by their legacy name
+func (l *LockFile) IsLegacyImportsEnabled() bool {
+	return l.LegacyImports == nil || *l.LegacyImports
+}
+
+func (d Dependency) validate() error {
+	switch {
+	case d.Source.Git != nil:
+		if d.Source.Git.Remote == "" {
+			return errors.New("git dependency without remote")
+		}
+		if d.Version == "" {
+			return fmt.Errorf("git dependency %s without version", d.Source.Git.Remote)
+		}
+		importPath, err := d.ImportPath()
+		if err != nil {
+			return err
+		}
+		legacyImportPath, err := d.LegacyImportPath()
+		if err != nil {
+			return err
+		}
+		if d.Source.Git.Subdir != "" && !filepath.IsLocal(d.Source.Git.Subdir) {
+			return fmt.Errorf("git dependency %s: subdir %q must be within the repository", d.Source.Git.Remote, d.Source.Git.Subdir)
+		}
+		// the dependency must be vendored within the vendor directory
+		if !filepath.IsLocal(importPath) || !filepath.IsLocal(legacyImportPath) {
+			return fmt.Errorf("git dependency %s: import path must be within the vendor directory", d.Source.Git.Remote)
+		}
+	case d.Source.Local != nil:
+		if d.Source.Local.Directory == "" {
+			return errors.New("local dependency without directory")
+		}
+	default:
+		return errors.New("dependency without git or local source")
+	}
+	return nil
+}
+
+// ImportPath returns the path the dependency is imported with, relative to the vendor directory, e.g.
+// github.com/grafana/grafonnet-lib/grafonnet
+func (d Dependency) ImportPath() (string, error) {
+	if d.Source.Local != nil {
+		return path.Base(d.Source.Local.Directory), nil
+	}
+	remote := strings.TrimSpace(d.Source.Git.Remote)
+	if ok, _ := git.IsSSHURL(remote); ok && !strings.Contains(remote, "://") {
+		// git@github.com:grafana/grafonnet-lib.git
+		remote = "ssh://" + strings.Replace(remote, ":", "/", 1)
+	}
+	remoteURL, err := url.Parse(remote)
+	if err != nil {
+		return "", fmt.Errorf("invalid remote %q of git dependency: %w", d.Source.Git.Remote, err)
+	}
+	repoPath := strings.TrimSuffix(strings.Trim(remoteURL.Path, "/"), ".git")
+	if repoPath == "" {
+		return "", fmt.Errorf("invalid remote %q of git dependency: missing repository path", d.Source.Git.Remote)
+	}
+	return path.Join(remoteURL.Hostname(), repoPath, d.Source.Git.Subdir), nil
+}
+
+// LegacyImportPath returns the legacy path the dependency is imported with, relative to the vendor directory, e.g.
+// grafonnet
+func (d Dependency) LegacyImportPath() (string, error) {
+	if d.LegacyName != "" {
+		return d.LegacyName, nil
+	}
+	importPath, err := d.ImportPath()
+	if err != nil {
+		return "", err
+	}
+	return path.Base(importPath), nil
+}
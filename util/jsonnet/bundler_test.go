@@ -0,0 +1,100 @@
+package jsonnet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeLockFile(t *testing.T, content string) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, LockFileName), []byte(content), 0o644))
+	return dir
+}
+
+func TestReadLockFile(t *testing.T) {
+	t.Run("NoLockFile", func(t *testing.T) {
+		lockFile, hash, err := ReadLockFile(t.TempDir())
+		require.NoError(t, err)
+		assert.Nil(t, lockFile)
+		assert.Empty(t, hash)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		dir := writeLockFile(t, `{
+  "version": 1,
+  "dependencies": [
+    {
+      "source": {"git": {"remote": "https://github.com/grafana/grafonnet-lib.git", "subdir": "grafonnet"}},
+      "version": "3626fc4dc2326931c530861ac5bebe39444f6cbf",
+      "sum": "gF8foHByYcB25jcUOBqP6jxk0OPifQMjPvKY0HaCk6w="
+    }
+  ]
+}`)
+		lockFile, hash, err := ReadLockFile(dir)
+		require.NoError(t, err)
+		require.Len(t, lockFile.Dependencies, 1)
+		assert.Equal(t, "3626fc4dc2326931c530861ac5bebe39444f6cbf", lockFile.Dependencies[0].Version)
+		assert.True(t, lockFile.IsLegacyImportsEnabled())
+		assert.Len(t, hash, 64)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for name, content := range map[string]string{
+			"Malformed":       `{"dependencies": [`,
+			"NoSource":        `{"dependencies": [{"version": "master"}]}`,
+			"NoVersion":       `{"dependencies": [{"source": {"git": {"remote": "https://github.com/grafana/grafonnet-lib.git"}}}]}`,
+			"SubdirTraversal": `{"dependencies": [{"source": {"git": {"remote": "https://github.com/grafana/grafonnet-lib.git", "subdir": "../.."}}, "version": "master"}]}`,
+			"RemoteTraversal": `{"dependencies": [{"source": {"git": {"remote": "https://github.com/../../../grafonnet-lib.git"}}, "version": "master"}]}`,
+			"NameTraversal":   `{"dependencies": [{"source": {"git": {"remote": "https://github.com/grafana/grafonnet-lib.git"}}, "version": "master", "name": "../grafonnet"}]}`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := ReadLockFile(writeLockFile(t, content))
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestDependency_ImportPath(t *testing.T) {
+	tests := []struct {
+		name             string
+		dependency       Dependency
+		importPath       string
+		legacyImportPath string
+	}{{
+		name:             "HTTPS",
+		dependency:       Dependency{Source: Source{Git: &GitSource{Remote: "https://github.com/grafana/grafonnet-lib.git", Subdir: "grafonnet"}}},
+		importPath:       "github.com/grafana/grafonnet-lib/grafonnet",
+		legacyImportPath: "grafonnet",
+	}, {
+		name:             "SSH",
+		dependency:       Dependency{Source: Source{Git: &GitSource{Remote: "git@github.com:ksonnet/ksonnet-lib.git"}}},
+		importPath:       "github.com/ksonnet/ksonnet-lib",
+		legacyImportPath: "ksonnet-lib",
+	}, {
+		name:             "LegacyName",
+		dependency:       Dependency{Source: Source{Git: &GitSource{Remote: "https://github.com/grafana/jsonnet-libs.git", Subdir: "grafana-builder"}}, LegacyName: "builder"},
+		importPath:       "github.com/grafana/jsonnet-libs/grafana-builder",
+		legacyImportPath: "builder",
+	}, {
+		name:             "Local",
+		dependency:       Dependency{Source: Source{Local: &LocalSource{Directory: "lib/common"}}},
+		importPath:       "common",
+		legacyImportPath: "common",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			importPath, err := tt.dependency.ImportPath()
+			require.NoError(t, err)
+			assert.Equal(t, tt.importPath, importPath)
+			legacyImportPath, err := tt.dependency.LegacyImportPath()
+			require.NoError(t, err)
+			assert.Equal(t, tt.legacyImportPath, legacyImportPath)
+		})
+	}
+}
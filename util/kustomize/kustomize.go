@@ -36,6 +36,9 @@ type Image = string
 type BuildOpts struct {
 	KubeVersion string
 	APIVersions []string
+	// RemoteRepoCreds, if set, is called for each remote Git repository referenced by the kustomization, to check that it
+	// may be fetched and get the credentials kustomize fetches it with
+	RemoteRepoCreds RemoteRepoCreds
 }
 
 // Kustomize provides wrapper functionality around the `kustomize` command.
@@ -380,6 +383,19 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 		}
 	}
 
+	if buildOpts != nil && buildOpts.RemoteRepoCreds != nil {
+		repoURLs, err := GetRemoteRepositories(k.path)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		remoteEnv, closeRemote, err := remoteReposEnviron(env, repoURLs, buildOpts.RemoteRepoCreds)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		defer closeRemote()
+		env = append(env, remoteEnv...)
+	}
+
 	var cmd *exec.Cmd
 	if kustomizeOptions != nil && kustomizeOptions.BuildOptions != "" {
 		params := parseKustomizeBuildOptions(ctx, k, kustomizeOptions.BuildOptions, buildOpts)
@@ -479,6 +495,11 @@ func Version() (string, error) {
 	return versionWithBinaryPath(context.Background(), &kustomize{})
 }
 
+// VersionWithBinaryPath returns the version of the kustomize binary at the given path
+func VersionWithBinaryPath(binaryPath string) (string, error) {
+	return versionWithBinaryPath(context.Background(), &kustomize{binaryPath: binaryPath})
+}
+
 func versionWithBinaryPath(ctx context.Context, k *kustomize) (string, error) {
 	executable := k.getBinaryPath()
 	cmd := exec.CommandContext(ctx, executable, "version", "--short")
@@ -0,0 +1,265 @@
+package kustomize
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// RemoteRepoCreds returns the credentials to fetch the remote Git repository with the given URL, which is referenced
+// by a kustomization, or an error if the repository must not be fetched
+type RemoteRepoCreds func(repoURL string) (git.Creds, error)
+
+// unpermittedRemoteBaseURL is the URL git rewrites the URLs of the remote bases which are not permitted to, so that
+// kustomize fails to fetch them
+const unpermittedRemoteBaseURL = "argocd-unpermitted-remote-base::"
+
+// scpLikeRefRegexp matches the Git references using the scp-like syntax kustomize supports, e.g.
+// git@github.com:org/repo.git
+var scpLikeRefRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*@[^/]+:`)
+
+// kustomizationRefs are the fields of a kustomization which reference other kustomizations or remote resources
+type kustomizationRefs struct {
+	Resources    []string `json:"resources,omitempty"`
+	Bases        []string `json:"bases,omitempty"`
+	Components   []string `json:"components,omitempty"`
+	Generators   []string `json:"generators,omitempty"`
+	Transformers []string `json:"transformers,omitempty"`
+	Validators   []string `json:"validators,omitempty"`
+}
+
+// GetRemoteRepositories returns the URLs of the remote Git repositories referenced by the kustomization at the given
+// path, or by the local kustomizations it references. It fails if a kustomization references a remote base which is
+// not an HTTP(S) or SSH Git repository, e.g. a file:// URL, since it cannot be checked. The kustomizations of the
+// remote repositories themselves are not inspected, since they are only fetched by kustomize: the remote bases they
+// reference are denied by the git configuration returned by remoteReposEnviron instead.
+func GetRemoteRepositories(path string) ([]string, error) {
+	var repos []string
+	visited := map[string]bool{}
+	var visit func(dir string) error
+	visit = func(dir string) error {
+		if visited[dir] {
+			return nil
+		}
+		visited[dir] = true
+		kustFile := findKustomizeFile(dir)
+		if kustFile == "" {
+			return nil
+		}
+		data, err := os.ReadFile(filepath.Join(dir, kustFile))
+		if err != nil {
+			return fmt.Errorf("failed to read kustomization: %w", err)
+		}
+		var refs kustomizationRefs
+		if err := yaml.Unmarshal(data, &refs); err != nil {
+			return fmt.Errorf("failed to unmarshal kustomization %s: %w", filepath.Join(dir, kustFile), err)
+		}
+		for _, ref := range slices.Concat(refs.Resources, refs.Bases, refs.Components, refs.Generators, refs.Transformers, refs.Validators) {
+			if strings.Contains(ref, "\n") {
+				// an inline generator, transformer or validator configuration
+				continue
+			}
+			if repoURL, ok := parseRemoteRepoURL(ref); ok {
+				if !slices.Contains(repos, repoURL) {
+					repos = append(repos, repoURL)
+				}
+				continue
+			}
+			if strings.HasPrefix(ref, "https://") || strings.HasPrefix(ref, "http://") {
+				// a remote file rather than a Git repository
+				continue
+			}
+			if isRemoteRef(ref) {
+				return fmt.Errorf("kustomization %s references unsupported remote base %q: remote bases must be HTTP(S) or SSH Git repositories", filepath.Join(dir, kustFile), ref)
+			}
+			// the local kustomizations outside of the repository root are inspected as well, since kustomize builds
+			// them
+			refDir := filepath.Join(dir, ref)
+			if info, err := os.Stat(refDir); err != nil || !info.IsDir() {
+				continue
+			}
+			if err := visit(refDir); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := visit(path); err != nil {
+		return nil, err
+	}
+	return repos, nil
+}
+
+// parseRemoteRepoURL returns the URL of the Git repository the given kustomization reference points to, following
+// the formats supported by kustomize, e.g. https://github.com/org/repo//path?ref=v1, github.com/org/repo/path,
+// git@github.com:org/repo.git/path or ssh://git@example.com/org/repo.git//path. It returns false if the reference is a
+// local path or a remote file.
+func parseRemoteRepoURL(ref string) (string, bool) {
+	ref = strings.TrimPrefix(ref, "git::")
+	ref, _, _ = strings.Cut(ref, "?")
+
+	var scheme, rest string
+	switch {
+	case scpLikeRefRegexp.MatchString(ref):
+		// scp-like syntax, e.g. git@github.com:org/repo
+		host, p, _ := strings.Cut(ref, ":")
+		_, hostname, _ := strings.Cut(host, "@")
+		repoPath, ok := remoteRepoPath(hostname, p)
+		if !ok {
+			return "", false
+		}
+		return host + ":" + repoPath, true
+	case strings.Contains(ref, "://"):
+		scheme, rest, _ = strings.Cut(ref, "://")
+		if scheme != "https" && scheme != "http" && scheme != "ssh" {
+			return "", false
+		}
+	case strings.HasPrefix(ref, "github.com/") || strings.HasPrefix(ref, "gitlab.com/") || strings.HasPrefix(ref, "bitbucket.org/"):
+		scheme, rest = "https", ref
+	default:
+		return "", false
+	}
+	host, p, ok := strings.Cut(rest, "/")
+	if !ok {
+		return "", false
+	}
+	repoPath, ok := remoteRepoPath(host, p)
+	if !ok {
+		return "", false
+	}
+	return scheme + "://" + host + "/" + repoPath, true
+}
+
+// isRemoteRef returns whether the given kustomization reference is fetched by kustomize rather than read from the
+// local filesystem, i.e. it is a URL or a Git reference
+func isRemoteRef(ref string) bool {
+	return strings.Contains(ref, "://") || strings.HasPrefix(strings.ToLower(ref), "git::") || strings.HasPrefix(ref, "git@") || scpLikeRefRegexp.MatchString(ref)
+}
+
+// kustomizeCloneURLs returns the URLs kustomize may fetch the remote Git repository with the given URL with, which
+// differ from it for GitHub, since kustomize normalizes the URLs of GitHub repositories
+func kustomizeCloneURLs(repoURL string) []string {
+	cloneURLs := []string{repoURL}
+	var user, repoPath string
+	if rest, ok := strings.CutPrefix(repoURL, "ssh://"); ok {
+		host, p, _ := strings.Cut(rest, "/")
+		if u, h, ok := strings.Cut(host, "@"); ok && strings.EqualFold(h, "github.com") {
+			user, repoPath = u+"@", p
+		} else if strings.EqualFold(host, "github.com") {
+			repoPath = p
+		}
+		if repoPath != "" {
+			cloneURLs = append(cloneURLs, user+"github.com:"+repoPath)
+		}
+	} else if rest, ok := strings.CutPrefix(repoURL, "http://"); ok {
+		if host, p, _ := strings.Cut(rest, "/"); strings.EqualFold(host, "github.com") {
+			cloneURLs = append(cloneURLs, "https://github.com/"+p)
+		}
+	}
+	return cloneURLs
+}
+
+// remoteRepoPath returns the path of the repository within the given path of a remote reference to the given host,
+// which is delimited by //, the .git suffix or the _git path segment of Azure DevOps, or consists of the first two
+// segments for GitHub, GitLab and Bitbucket
+func remoteRepoPath(host string, p string) (string, bool) {
+	if repoPath, _, ok := strings.Cut(p, "//"); ok {
+		return strings.TrimSuffix(repoPath, "/"), repoPath != ""
+	}
+	if i := strings.Index(p+"/", ".git/"); i > 0 {
+		return p[:i+len(".git")], true
+	}
+	segments := strings.Split(p, "/")
+	if i := slices.Index(segments, "_git"); i >= 0 && i+1 < len(segments) {
+		return strings.Join(segments[:i+2], "/"), true
+	}
+	if (host == "github.com" || host == "gitlab.com" || host == "bitbucket.org") && len(segments) >= 2 {
+		return strings.Join(segments[:2], "/"), true
+	}
+	return "", false
+}
+
+// remoteReposEnviron returns the environment variables configuring git, which kustomize runs to fetch remote bases,
+// with the credentials of the given remote repositories, along with the function to call once kustomize is done. The
+// configuration is appended to the configuration which may already be set in the given environment.
+//
+// The URLs of every other repository are rewritten by git to an unsupported URL, so that kustomize fails to fetch the
+// remote bases which are only referenced by remote bases, or by the submodules of remote repositories, since they are
+// not checked. Since git rewrites URLs by prefix, the URLs starting with the URL of one of the given repositories,
+// e.g. https://github.com/org/repo-other for https://github.com/org/repo, are not rewritten.
+func remoteReposEnviron(env []string, repoURLs []string, getCreds RemoteRepoCreds) ([]string, func(), error) {
+	var closers []func()
+	closeAll := func() {
+		for _, c := range closers {
+			c()
+		}
+	}
+	config := map[string]string{}
+	// git rewrites a URL with the longest matching prefix, which the empty prefix of the rewrite of the unpermitted
+	// URLs is never
+	insteadOf := []string{""}
+	for _, repoURL := range repoURLs {
+		creds, err := getCreds(repoURL)
+		if err != nil {
+			closeAll()
+			return nil, nil, err
+		}
+		insteadOf = append(insteadOf, kustomizeCloneURLs(repoURL)...)
+		if creds == nil || creds == (git.NopCreds{}) {
+			continue
+		}
+		if !git.IsHTTPSURL(repoURL) && !git.IsHTTPURL(repoURL) {
+			log.Warnf("Credentials of remote base %s are not used by kustomize: only the credentials of HTTP(S) repositories are supported", repoURL)
+			continue
+		}
+		closer, repoConfig, err := git.ScopedConfig(repoURL, creds)
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("failed to configure credentials of remote base %s: %w", repoURL, err)
+		}
+		closers = append(closers, func() { _ = closer.Close() })
+		for k, v := range repoConfig {
+			config[k] = v
+		}
+	}
+
+	count := 0
+	for _, e := range env {
+		if value, ok := strings.CutPrefix(e, "GIT_CONFIG_COUNT="); ok {
+			count, _ = strconv.Atoi(value)
+		}
+	}
+	keys := make([]string, 0, len(config))
+	for k := range config {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	var environ []string
+	addConfig := func(key, value string) {
+		environ = append(environ, fmt.Sprintf("GIT_CONFIG_KEY_%d=%s", count, key), fmt.Sprintf("GIT_CONFIG_VALUE_%d=%s", count, value))
+		count++
+	}
+	for _, k := range keys {
+		addConfig(k, config[k])
+	}
+	for _, u := range insteadOf {
+		if u == "" {
+			addConfig("url."+unpermittedRemoteBaseURL+".insteadOf", u)
+		} else {
+			// the URLs of the permitted repositories are rewritten to themselves, which takes precedence over the rewrite
+			// of the unpermitted URLs
+			addConfig("url."+u+".insteadOf", u)
+		}
+	}
+	environ = append(environ, fmt.Sprintf("GIT_CONFIG_COUNT=%d", count))
+	return environ, closeAll, nil
+}
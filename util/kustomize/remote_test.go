@@ -0,0 +1,225 @@
+package kustomize
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+func Test_parseRemoteRepoURL(t *testing.T) {
+	for ref, expected := range map[string]string{
+		"https://github.com/org/repo//config/default?ref=v1.0.0":     "https://github.com/org/repo",
+		"https://github.com/org/repo/config/default?ref=v1.0.0":      "https://github.com/org/repo",
+		"github.com/org/repo/config/default?ref=main":                "https://github.com/org/repo",
+		"git::https://gitlab.example.com/group/repo.git/base?ref=v1": "https://gitlab.example.com/group/repo.git",
+		"https://example.com/repo.git":                               "https://example.com/repo.git",
+		"https://dev.azure.com/org/project/_git/repo/base?ref=main":  "https://dev.azure.com/org/project/_git/repo",
+		"git@github.com:org/repo.git//base":                          "git@github.com:org/repo.git",
+		"deploy@example.com:org/repo.git//base":                      "deploy@example.com:org/repo.git",
+		"ssh://git@example.com/org/repo.git/base?ref=v1":             "ssh://git@example.com/org/repo.git",
+		"https://example.com/group/subgroup/repo//base?timeout=120s": "https://example.com/group/subgroup/repo",
+	} {
+		repoURL, ok := parseRemoteRepoURL(ref)
+		if assert.True(t, ok, ref) {
+			assert.Equal(t, expected, repoURL, ref)
+		}
+	}
+
+	for _, ref := range []string{
+		"../base",
+		"deployment.yaml",
+		"https://raw.githubusercontent.com/org/repo/main/deployment.yaml",
+		"https://example.com/manifests/deployment.yaml",
+		"file:///tmp/repo//base",
+	} {
+		_, ok := parseRemoteRepoURL(ref)
+		assert.False(t, ok, ref)
+	}
+}
+
+func Test_kustomizeCloneURLs(t *testing.T) {
+	for repoURL, expected := range map[string][]string{
+		"https://github.com/org/repo":      {"https://github.com/org/repo"},
+		"http://github.com/org/repo":       {"http://github.com/org/repo", "https://github.com/org/repo"},
+		"ssh://git@github.com/org/repo":    {"ssh://git@github.com/org/repo", "git@github.com:org/repo"},
+		"ssh://github.com/org/repo.git":    {"ssh://github.com/org/repo.git", "github.com:org/repo.git"},
+		"ssh://git@example.com/org/repo":   {"ssh://git@example.com/org/repo"},
+		"git@github.com:org/repo.git":      {"git@github.com:org/repo.git"},
+		"https://gitlab.com/group/project": {"https://gitlab.com/group/project"},
+	} {
+		assert.Equal(t, expected, kustomizeCloneURLs(repoURL), repoURL)
+	}
+}
+
+func writeKustomization(t *testing.T, dir string, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(content), 0o644))
+}
+
+func TestGetRemoteRepositories(t *testing.T) {
+	repoRoot := t.TempDir()
+	writeKustomization(t, filepath.Join(repoRoot, "apps", "guestbook"), `
+resources:
+- deployment.yaml
+- ../../base
+- https://github.com/org/repo//config?ref=v1
+components:
+- ../../components/monitoring
+`)
+	writeKustomization(t, filepath.Join(repoRoot, "base"), `
+bases:
+- github.com/org/repo/base?ref=v2
+- https://gitlab.com/group/other.git//base
+- ../../outside
+`)
+	writeKustomization(t, filepath.Join(repoRoot, "components", "monitoring"), `
+kind: Component
+resources:
+- git@github.com:org/private.git//monitoring
+`)
+	writeKustomization(t, filepath.Join(repoRoot, "apps", "plugins"), `
+generators:
+- https://github.com/org/generators//secrets?ref=v1
+- |-
+  apiVersion: builtin
+  kind: ConfigMapGenerator
+  metadata:
+    name: config
+  literals:
+  - url=https://example.com
+transformers:
+- https://github.com/org/transformers//labels
+validators:
+- https://github.com/org/validators//policies
+- ../../local-validators
+`)
+	writeKustomization(t, filepath.Join(repoRoot, "local-validators"), `
+resources:
+- https://github.com/org/local-validators//policies
+`)
+	writeKustomization(t, filepath.Join(filepath.Dir(repoRoot), "outside"), `
+resources:
+- https://github.com/org/outside//base
+`)
+
+	repos, err := GetRemoteRepositories(filepath.Join(repoRoot, "apps", "guestbook"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://github.com/org/repo", "https://gitlab.com/group/other.git", "https://github.com/org/outside", "git@github.com:org/private.git"}, repos)
+
+	repos, err = GetRemoteRepositories(filepath.Join(repoRoot, "apps", "plugins"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://github.com/org/generators", "https://github.com/org/transformers", "https://github.com/org/validators", "https://github.com/org/local-validators"}, repos)
+
+	repos, err = GetRemoteRepositories(filepath.Join(repoRoot, "deployments"))
+	require.NoError(t, err)
+	assert.Empty(t, repos)
+}
+
+func TestGetRemoteRepositories_UnsupportedRemoteBase(t *testing.T) {
+	for _, ref := range []string{
+		"file:///tmp/repo//base",
+		"git::file:///tmp/repo//base",
+		"git::https://example.com/base",
+		"git://example.com/org/repo.git//base",
+		"git@example.com",
+		"deploy@example.com:repo",
+	} {
+		for _, field := range []string{"resources", "generators", "transformers", "validators"} {
+			appPath := filepath.Join(t.TempDir(), "app")
+			writeKustomization(t, appPath, field+":\n- https://raw.githubusercontent.com/org/repo/main/deployment.yaml\n- "+ref+"\n")
+			_, err := GetRemoteRepositories(appPath)
+			assert.ErrorContains(t, err, "unsupported remote base", field+": "+ref)
+		}
+	}
+}
+
+func Test_remoteReposEnviron(t *testing.T) {
+	repoCreds := map[string]git.Creds{
+		"https://github.com/org/repo":    git.NewHTTPSCreds("user", "pass", "", "", "", true, git.NoopCredsStore{}, false),
+		"git@github.com:org/private.git": git.NewSSHCreds("key", "", true, ""),
+	}
+	getCreds := func(repoURL string) (git.Creds, error) {
+		if repoURL == "https://github.com/org/denied" {
+			return nil, errors.New("not permitted")
+		}
+		if creds, ok := repoCreds[repoURL]; ok {
+			return creds, nil
+		}
+		return git.NopCreds{}, nil
+	}
+
+	t.Run("Credentials", func(t *testing.T) {
+		env, done, err := remoteReposEnviron([]string{"GIT_CONFIG_COUNT=1"}, []string{"https://github.com/org/repo", "https://github.com/org/public", "git@github.com:org/private.git"}, getCreds)
+		require.NoError(t, err)
+		defer done()
+		assert.Equal(t, []string{
+			"GIT_CONFIG_KEY_1=http.https://github.com/org/repo.git.sslVerify",
+			"GIT_CONFIG_VALUE_1=false",
+			"GIT_CONFIG_KEY_2=http.https://github.com/org/repo.sslVerify",
+			"GIT_CONFIG_VALUE_2=false",
+			"GIT_CONFIG_KEY_3=url.argocd-unpermitted-remote-base::.insteadOf",
+			"GIT_CONFIG_VALUE_3=",
+			"GIT_CONFIG_KEY_4=url.https://github.com/org/repo.insteadOf",
+			"GIT_CONFIG_VALUE_4=https://github.com/org/repo",
+			"GIT_CONFIG_KEY_5=url.https://github.com/org/public.insteadOf",
+			"GIT_CONFIG_VALUE_5=https://github.com/org/public",
+			"GIT_CONFIG_KEY_6=url.git@github.com:org/private.git.insteadOf",
+			"GIT_CONFIG_VALUE_6=git@github.com:org/private.git",
+			"GIT_CONFIG_COUNT=7",
+		}, env)
+	})
+
+	t.Run("NoCredentials", func(t *testing.T) {
+		env, done, err := remoteReposEnviron(nil, []string{"https://github.com/org/public"}, getCreds)
+		require.NoError(t, err)
+		defer done()
+		assert.Equal(t, []string{
+			"GIT_CONFIG_KEY_0=url.argocd-unpermitted-remote-base::.insteadOf",
+			"GIT_CONFIG_VALUE_0=",
+			"GIT_CONFIG_KEY_1=url.https://github.com/org/public.insteadOf",
+			"GIT_CONFIG_VALUE_1=https://github.com/org/public",
+			"GIT_CONFIG_COUNT=2",
+		}, env)
+	})
+
+	t.Run("DeniesNestedRemoteBases", func(t *testing.T) {
+		// the remote bases referenced by permitted remote bases are fetched by git with the same configuration
+		newRepo := func() string {
+			dir := t.TempDir()
+			cmd := exec.CommandContext(t.Context(), "git", "init", "--bare", dir)
+			require.NoError(t, cmd.Run())
+			return "file://" + dir
+		}
+		permitted, nested := newRepo(), newRepo()
+		env, done, err := remoteReposEnviron(nil, []string{permitted}, getCreds)
+		require.NoError(t, err)
+		defer done()
+		lsRemote := func(repoURL string) (string, error) {
+			cmd := exec.CommandContext(t.Context(), "git", "ls-remote", repoURL)
+			cmd.Env = append(os.Environ(), env...)
+			out, err := cmd.CombinedOutput()
+			return string(out), err
+		}
+
+		_, err = lsRemote(permitted)
+		require.NoError(t, err)
+		for _, repoURL := range []string{nested, "https://github.com/org/nested", "git@github.com:org/nested.git"} {
+			out, err := lsRemote(repoURL)
+			require.Error(t, err, repoURL)
+			assert.Contains(t, out, "argocd-unpermitted-remote-base", repoURL)
+		}
+	})
+
+	t.Run("NotPermitted", func(t *testing.T) {
+		_, _, err := remoteReposEnviron(nil, []string{"https://github.com/org/repo", "https://github.com/org/denied"}, getCreds)
+		require.EqualError(t, err, "not permitted")
+	})
+}
@@ -0,0 +1,191 @@
+package manifestpolicy
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// costLimit bounds the cost of evaluating an expression of a policy against a manifest, so that a policy cannot stall
+// manifest generation
+const costLimit = 1000000
+
+// Policy validates manifests with CEL expressions, in the style of Kubernetes ValidatingAdmissionPolicies. The
+// manifest is available to the expressions as the `object` variable.
+type Policy struct {
+	// MatchResources restricts the policy to the manifests matching any of the rules. The policy applies to all
+	// manifests if it is empty.
+	MatchResources []ResourceRule `json:"matchResources,omitempty"`
+	// MatchConditions restricts the policy to the manifests for which all the conditions evaluate to true
+	MatchConditions []MatchCondition `json:"matchConditions,omitempty"`
+	// Validations are the expressions which must all evaluate to true for the manifests the policy applies to
+	Validations []Validation `json:"validations"`
+}
+
+// ResourceRule matches manifests by API group and kind. `*` matches any API group or kind.
+type ResourceRule struct {
+	APIGroups []string `json:"apiGroups,omitempty"`
+	Kinds     []string `json:"kinds,omitempty"`
+}
+
+// MatchCondition is a CEL expression which must evaluate to true for the policy to apply to a manifest
+type MatchCondition struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+// Validation is a CEL expression which must evaluate to true for a manifest to comply with the policy
+type Validation struct {
+	Expression string `json:"expression"`
+	// Message is the message of the violation if the expression evaluates to false
+	Message string `json:"message,omitempty"`
+	// MessageExpression is a CEL expression evaluating to the message of the violation, which takes precedence over
+	// the message
+	MessageExpression string `json:"messageExpression,omitempty"`
+}
+
+// Program is a compiled policy
+type Program struct {
+	policy          *Policy
+	matchConditions []cel.Program
+	validations     []validationProgram
+}
+
+type validationProgram struct {
+	validation        Validation
+	expression        cel.Program
+	messageExpression cel.Program
+}
+
+var env *cel.Env
+
+func init() {
+	var err error
+	env, err = cel.NewEnv(cel.Variable("object", cel.DynType), ext.Strings(), ext.Sets(), ext.Lists())
+	if err != nil {
+		panic(fmt.Sprintf("failed to create CEL environment: %v", err))
+	}
+}
+
+// Compile parses and compiles the given YAML policy
+func Compile(data string) (*Program, error) {
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict([]byte(data), policy); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	if len(policy.Validations) == 0 {
+		return nil, errors.New("policy must have at least one validation")
+	}
+	prg := &Program{policy: policy}
+	for _, condition := range policy.MatchConditions {
+		p, err := compile(condition.Expression, cel.BoolType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid match condition %s: %w", condition.Name, err)
+		}
+		prg.matchConditions = append(prg.matchConditions, p)
+	}
+	for i, validation := range policy.Validations {
+		p, err := compile(validation.Expression, cel.BoolType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validation %d: %w", i, err)
+		}
+		vp := validationProgram{validation: validation, expression: p}
+		if validation.MessageExpression != "" {
+			vp.messageExpression, err = compile(validation.MessageExpression, cel.StringType)
+			if err != nil {
+				return nil, fmt.Errorf("invalid message expression of validation %d: %w", i, err)
+			}
+		}
+		prg.validations = append(prg.validations, vp)
+	}
+	return prg, nil
+}
+
+func compile(expression string, outputType *cel.Type) (cel.Program, error) {
+	ast, iss := env.Compile(expression)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if !ast.OutputType().IsExactType(outputType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression %q must evaluate to %s, not %s", expression, outputType, ast.OutputType())
+	}
+	return env.Program(ast, cel.CostLimit(costLimit))
+}
+
+// Validate returns the messages of the validations the given manifest violates. A manifest the policy does not apply
+// to violates none.
+func (p *Program) Validate(obj *unstructured.Unstructured) ([]string, error) {
+	if !p.matchesResources(obj) {
+		return nil, nil
+	}
+	activation := map[string]any{"object": obj.Object}
+	for i, condition := range p.matchConditions {
+		matches, err := evalBool(condition, activation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate match condition %s: %w", p.policy.MatchConditions[i].Name, err)
+		}
+		if !matches {
+			return nil, nil
+		}
+	}
+	var messages []string
+	for i, validation := range p.validations {
+		valid, err := evalBool(validation.expression, activation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate validation %d: %w", i, err)
+		}
+		if valid {
+			continue
+		}
+		messages = append(messages, validation.message(activation))
+	}
+	return messages, nil
+}
+
+func (p *Program) matchesResources(obj *unstructured.Unstructured) bool {
+	if len(p.policy.MatchResources) == 0 {
+		return true
+	}
+	gvk := obj.GroupVersionKind()
+	return slices.ContainsFunc(p.policy.MatchResources, func(rule ResourceRule) bool {
+		return matches(rule.APIGroups, gvk.Group) && matches(rule.Kinds, gvk.Kind)
+	})
+}
+
+func matches(values []string, value string) bool {
+	return len(values) == 0 || slices.Contains(values, "*") || slices.Contains(values, value)
+}
+
+// message returns the message of a violation of the validation, falling back to the message and then to the
+// expression if its message expression cannot be evaluated
+func (v validationProgram) message(activation map[string]any) string {
+	if v.messageExpression != nil {
+		out, _, err := v.messageExpression.Eval(activation)
+		if err == nil {
+			if message, ok := out.Value().(string); ok && message != "" {
+				return message
+			}
+		}
+	}
+	if v.validation.Message != "" {
+		return v.validation.Message
+	}
+	return fmt.Sprintf("failed expression: %s", v.validation.Expression)
+}
+
+func evalBool(prg cel.Program, activation map[string]any) (bool, error) {
+	out, _, err := prg.Eval(activation)
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %v instead of a boolean", out.Value())
+	}
+	return result, nil
+}
@@ -0,0 +1,94 @@
+package manifestpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newDeployment(name string, replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": name, "namespace": "default"},
+		"spec":       map[string]any{"replicas": replicas},
+	}}
+}
+
+const replicasPolicy = `
+matchResources:
+- apiGroups: [apps]
+  kinds: [Deployment, StatefulSet]
+matchConditions:
+- name: not-canary
+  expression: "!object.metadata.name.endsWith('-canary')"
+validations:
+- expression: "object.spec.replicas <= 5"
+  messageExpression: "'replicas must be at most 5, not ' + string(object.spec.replicas)"
+- expression: "has(object.metadata.labels) && 'team' in object.metadata.labels"
+  message: "team label is required"
+`
+
+func TestProgram_Validate(t *testing.T) {
+	prg, err := Compile(replicasPolicy)
+	require.NoError(t, err)
+
+	messages, err := prg.Validate(newDeployment("my-app", 10))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"replicas must be at most 5, not 10", "team label is required"}, messages)
+
+	obj := newDeployment("my-app", 3)
+	obj.SetLabels(map[string]string{"team": "platform"})
+	messages, err = prg.Validate(obj)
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+
+	// the match condition excludes canaries
+	messages, err = prg.Validate(newDeployment("my-app-canary", 10))
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+
+	// the policy does not match config maps
+	messages, err = prg.Validate(&unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]any{"name": "my-cm"}}})
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func TestProgram_Validate_DefaultMessage(t *testing.T) {
+	prg, err := Compile(`
+validations:
+- expression: "object.kind != 'Secret'"
+`)
+	require.NoError(t, err)
+	messages, err := prg.Validate(&unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "Secret"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"failed expression: object.kind != 'Secret'"}, messages)
+}
+
+func TestProgram_Validate_EvaluationError(t *testing.T) {
+	prg, err := Compile(`
+validations:
+- expression: "object.spec.template.spec.hostNetwork == false"
+`)
+	require.NoError(t, err)
+	_, err = prg.Validate(newDeployment("my-app", 1))
+	require.ErrorContains(t, err, "failed to evaluate validation 0")
+}
+
+func TestCompile_Invalid(t *testing.T) {
+	for name, policy := range map[string]string{
+		"NoValidations":       "matchResources: [{kinds: [Deployment]}]",
+		"UnknownField":        "validations: [{expression: 'true'}]\nparams: {}",
+		"SyntaxError":         "validations: [{expression: 'object.spec.replicas <'}]",
+		"NotBoolean":          "validations: [{expression: '1 + 1'}]",
+		"MessageNotString":    "validations: [{expression: 'true', messageExpression: '1'}]",
+		"MatchConditionError": "matchConditions: [{name: broken, expression: 'object.'}]\nvalidations: [{expression: 'true'}]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Compile(policy)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// HydratedManifestsArtifactType is the artifact type of the OCI artifacts holding hydrated manifests
+const HydratedManifestsArtifactType = "application/vnd.argoproj.argocd.hydrated-manifests.v1"
+
+// Pusher is an OCI client interface that provides methods for pushing directories as OCI artifacts to a repository.
+type Pusher interface {
+	// Push packs the contents of the directory as the single gzipped tar layer of an OCI artifact, pushes the artifact
+	// to the repository and tags it with the given tags. It returns the digest of the artifact.
+	Push(ctx context.Context, dir string, annotations map[string]string, tags ...string) (string, error)
+
+	// Resolve returns the digest of the artifact the tag refers to, or an empty string if the tag does not exist.
+	Resolve(ctx context.Context, tag string) (string, error)
+
+	// Tag tags the artifact the reference, a tag or a digest, refers to with the given tag.
+	Tag(ctx context.Context, reference string, tag string) error
+}
+
+var _ Pusher = &nativeOCIPusher{}
+
+// nativeOCIPusher implements Pusher interface using oras-go
+type nativeOCIPusher struct {
+	repo oras.Target
+}
+
+// NewPusher returns a Pusher for the OCI repository, authenticated with the given credentials.
+func NewPusher(repoURL string, creds Creds, proxyURL, noProxy string) (Pusher, error) {
+	repo, err := newRemoteRepository(repoURL, creds, proxyURL, noProxy)
+	if err != nil {
+		return nil, err
+	}
+	return &nativeOCIPusher{repo: repo}, nil
+}
+
+func (p *nativeOCIPusher) Push(ctx context.Context, dir string, annotations map[string]string, tags ...string) (string, error) {
+	if len(tags) == 0 {
+		return "", errors.New("at least one tag is required")
+	}
+	var buf bytes.Buffer
+	if _, err := files.Tgz(dir, nil, nil, &buf); err != nil {
+		return "", fmt.Errorf("failed to archive %s: %w", dir, err)
+	}
+	layer := content.NewDescriptorFromBytes(imagev1.MediaTypeImageLayerGzip, buf.Bytes())
+	if err := pushIfNotExists(ctx, p.repo, layer, buf.Bytes()); err != nil {
+		return "", fmt.Errorf("failed to push layer: %w", err)
+	}
+
+	manifest, err := oras.PackManifest(ctx, p.repo, oras.PackManifestVersion1_1, HydratedManifestsArtifactType, oras.PackManifestOptions{
+		Layers:              []imagev1.Descriptor{layer},
+		ManifestAnnotations: annotations,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to push manifest: %w", err)
+	}
+	for _, tag := range tags {
+		if err := p.repo.Tag(ctx, manifest, tag); err != nil {
+			return "", fmt.Errorf("failed to tag %s with %s: %w", manifest.Digest, tag, err)
+		}
+	}
+	return manifest.Digest.String(), nil
+}
+
+func (p *nativeOCIPusher) Resolve(ctx context.Context, tag string) (string, error) {
+	desc, err := p.repo.Resolve(ctx, tag)
+	if errors.Is(err, errdef.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", tag, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+func (p *nativeOCIPusher) Tag(ctx context.Context, reference string, tag string) error {
+	desc, err := p.repo.Resolve(ctx, reference)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", reference, err)
+	}
+	if err := p.repo.Tag(ctx, desc, tag); err != nil {
+		return fmt.Errorf("failed to tag %s with %s: %w", reference, tag, err)
+	}
+	return nil
+}
+
+func pushIfNotExists(ctx context.Context, target oras.Target, desc imagev1.Descriptor, data []byte) error {
+	exists, err := target.Exists(ctx, desc)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	return target.Push(ctx, desc, bytes.NewReader(data))
+}
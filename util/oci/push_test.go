@@ -0,0 +1,65 @@
+package oci
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content/memory"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+func Test_nativeOCIPusher(t *testing.T) {
+	store := memory.New()
+	p := &nativeOCIPusher{repo: store}
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "guestbook"), 0o755))
+	addFileToDirectory(t, filepath.Join(dir, "guestbook"), "manifest.yaml", "apiVersion: v1")
+	addFileToDirectory(t, dir, "hydrator.metadata", "{}")
+
+	digest, err := p.Push(t.Context(), dir, map[string]string{imagev1.AnnotationRevision: "abc123"}, "abc123", "env-prod")
+	require.NoError(t, err)
+
+	resolved, err := p.Resolve(t.Context(), "abc123")
+	require.NoError(t, err)
+	assert.Equal(t, digest, resolved)
+	resolved, err = p.Resolve(t.Context(), "env-prod")
+	require.NoError(t, err)
+	assert.Equal(t, digest, resolved)
+	resolved, err = p.Resolve(t.Context(), "missing")
+	require.NoError(t, err)
+	assert.Empty(t, resolved)
+
+	require.NoError(t, p.Tag(t.Context(), "abc123", "env-staging"))
+	resolved, err = p.Resolve(t.Context(), "env-staging")
+	require.NoError(t, err)
+	assert.Equal(t, digest, resolved)
+
+	// the pushed artifact can be extracted by the client, which resolves it by digest unlike the memory store
+	require.NoError(t, p.Tag(t.Context(), "abc123", digest))
+	c := newClientWithLock("example.com/hydrated", globalLock, store, nil, func(_ context.Context) error {
+		return nil
+	}, []string{imagev1.MediaTypeImageLayerGzip},
+		WithImagePaths(utilio.NewRandomizedTempPaths(t.TempDir())),
+		WithManifestMaxExtractedSize(10000),
+		WithEventHandlers(fakeEventHandlers(t, "example.com/hydrated")))
+	path, closer, err := c.Extract(t.Context(), digest)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	data, err := os.ReadFile(filepath.Join(path, "guestbook", "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1", string(data))
+	_, err = os.Stat(filepath.Join(path, "hydrator.metadata"))
+	require.NoError(t, err)
+
+	manifest, err := c.DigestMetadata(t.Context(), digest)
+	require.NoError(t, err)
+	assert.Equal(t, HydratedManifestsArtifactType, manifest.ArtifactType)
+	assert.Equal(t, "abc123", manifest.Annotations[imagev1.AnnotationRevision])
+}
@@ -467,6 +467,8 @@ const (
 	allowedNodeLabelsKey = "application.allowedNodeLabels"
 	// settingsInstallationID holds the key for the instance installation ID
 	settingsInstallationID = "installationID"
+	// settingsManifestProvenancePublicKey holds the key for the public key verifying the provenance of generated manifests
+	settingsManifestProvenancePublicKey = "manifests.provenance.publicKey"
 	// resourcesCustomizationsKey is the key to the map of resource overrides
 	resourceCustomizationsKey = "resource.customizations"
 	// resourceExclusions is the key to the list of excluded resources
@@ -570,6 +572,16 @@ var sourceTypeToEnableGenerationKey = map[v1alpha1.ApplicationSourceType]string{
 	v1alpha1.ApplicationSourceTypeKustomize: "kustomize.enable",
 	v1alpha1.ApplicationSourceTypeHelm:      "helm.enable",
 	v1alpha1.ApplicationSourceTypeDirectory: "jsonnet.enable",
+	v1alpha1.ApplicationSourceTypeHelmfile:  "helmfile.enable",
+	v1alpha1.ApplicationSourceTypeCue:       "cue.enable",
+}
+
+// sourceTypesDisabledByDefault are the source types which must be explicitly enabled: helmfile templates can run
+// arbitrary commands in the repo server, and enabling CUE would change the detected type of existing applications
+// containing CUE modules
+var sourceTypesDisabledByDefault = map[v1alpha1.ApplicationSourceType]bool{
+	v1alpha1.ApplicationSourceTypeHelmfile: true,
+	v1alpha1.ApplicationSourceTypeCue:      true,
 }
 
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
@@ -869,6 +881,15 @@ func (mgr *SettingsManager) GetInstallationID() (string, error) {
 	return argoCDCM.Data[settingsInstallationID], nil
 }
 
+// GetManifestProvenancePublicKey returns the PEM-encoded public key verifying the provenance of generated manifests
+func (mgr *SettingsManager) GetManifestProvenancePublicKey() (string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return "", err
+	}
+	return argoCDCM.Data[settingsManifestProvenancePublicKey], nil
+}
+
 func (mgr *SettingsManager) GetPasswordPattern() (string, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -929,7 +950,7 @@ func (mgr *SettingsManager) GetEnabledSourceTypes() (map[string]bool, error) {
 	}
 	res := map[string]bool{}
 	for sourceType := range sourceTypeToEnableGenerationKey {
-		res[string(sourceType)] = true
+		res[string(sourceType)] = !sourceTypesDisabledByDefault[sourceType]
 	}
 	for sourceType, key := range sourceTypeToEnableGenerationKey {
 		if val, ok := argoCDCM.Data[key]; ok && val != "" {
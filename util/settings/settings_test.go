@@ -1716,6 +1716,26 @@ func TestGetEnableManifestGeneration(t *testing.T) {
 		enabled: true,
 		data:    map[string]string{"kustomize.enable": `true`},
 		source:  string(v1alpha1.ApplicationSourceTypeKustomize),
+	}, {
+		name:    "helmfile disabled by default",
+		enabled: false,
+		data:    map[string]string{},
+		source:  string(v1alpha1.ApplicationSourceTypeHelmfile),
+	}, {
+		name:    "helmfile enabled",
+		enabled: true,
+		data:    map[string]string{"helmfile.enable": `true`},
+		source:  string(v1alpha1.ApplicationSourceTypeHelmfile),
+	}, {
+		name:    "cue disabled by default",
+		enabled: false,
+		data:    map[string]string{},
+		source:  string(v1alpha1.ApplicationSourceTypeCue),
+	}, {
+		name:    "cue enabled",
+		enabled: true,
+		data:    map[string]string{"cue.enable": `true`},
+		source:  string(v1alpha1.ApplicationSourceTypeCue),
 	}}
 	for i := range testCases {
 		tc := testCases[i]